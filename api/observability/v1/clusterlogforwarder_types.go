@@ -15,7 +15,10 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -72,6 +75,47 @@ type ClusterLogForwarderSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Forwarder Pipelines"
 	Pipelines []PipelineSpec `json:"pipelines"`
 
+	// PipelineTemplates are reusable sets of filters and outputs which pipelines reference by name (`pipeline.templateRef`).
+	//
+	// +kubebuilder:validation:Optional
+	// +listType:=map
+	// +listMapKey:=name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Forwarder Pipeline Templates"
+	PipelineTemplates []PipelineTemplateSpec `json:"pipelineTemplates,omitempty"`
+
+	// Policies restrict which logs may be forwarded to which outputs (e.g. data-residency requirements).
+	// Pipelines which may forward logs in violation of a policy are rejected during validation.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType:=map
+	// +listMapKey:=name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Forwarder Policies"
+	Policies []PolicySpec `json:"policies,omitempty"`
+
+	// InfrastructureNamespaces are additional namespaces whose container logs are classified as infrastructure
+	// logs in addition to the default, kube* and openshift* namespaces.  Glob patterns are supported (e.g. platform-*).
+	// Container logs from these namespaces are collected by infrastructure inputs and are excluded from application
+	// inputs unless explicitly included.  Entries can only contain alphanumeric characters, "-" and "*", and can not be "*".
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Infrastructure Namespaces"
+	InfrastructureNamespaces []string `json:"infrastructureNamespaces,omitempty"`
+
+	// NetworkPolicy defines the NetworkPolicy generated for the collector pods.  No NetworkPolicy is generated
+	// when not defined.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Network Policy"
+	NetworkPolicy *NetworkPolicy `json:"networkPolicy,omitempty"`
+
+	// Validation defines how the operator handles a spec with invalid inputs, outputs, filters or pipelines.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Validation"
+	Validation *ValidationSpec `json:"validation,omitempty"`
+
 	// ServiceAccount points to the ServiceAccount resource used by the collector pods.
 	//
 	// +kubebuilder:validation:Required
@@ -101,6 +145,10 @@ const (
 )
 
 // CollectorSpec is spec to define scheduling and resources for a collector
+//
+// +kubebuilder:validation:XValidation:rule="!(has(self.autoTune) && self.autoTune && has(self.verticalPodAutoscaler) && has(self.verticalPodAutoscaler.updateMode) && self.verticalPodAutoscaler.updateMode != 'Off')",message="autoTune can not be enabled when the verticalPodAutoscaler applies recommendations"
+// +kubebuilder:validation:XValidation:rule="!(has(self.rollout) && has(self.shards) && size(self.shards) > 0)",message="shards can not be combined with a canary rollout"
+// +kubebuilder:validation:XValidation:rule="!has(self.shards) || self.shards.all(s, s.inputTypes.all(t, self.shards.filter(o, t in o.inputTypes).size() == 1))",message="an input type can only be collected by one shard"
 type CollectorSpec struct {
 	// The resource requirements for the collector
 	//
@@ -122,9 +170,399 @@ type CollectorSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tolerations"
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// AutoTune enables applying the resource requirements recommended for the collector from its observed usage.
+	// The recommendation replaces the resources defined for the collector once it is available in the
+	// status of the forwarder.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Auto Tune Resources",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	AutoTune bool `json:"autoTune,omitempty"`
+
+	// VerticalPodAutoscaler configures a VerticalPodAutoscaler for the collector.  The Vertical Pod Autoscaler
+	// must be installed on the cluster.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Vertical Pod Autoscaler"
+	VerticalPodAutoscaler *VerticalPodAutoscalerSpec `json:"verticalPodAutoscaler,omitempty"`
+
+	// Rollout defines how changes to the collector config are rolled out to a collector deployed as a daemonset.
+	// Changes are rolled out to all collectors at once when not defined.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rollout"
+	Rollout *CollectorRolloutSpec `json:"rollout,omitempty"`
+
+	// Networks are secondary networks attached to the collector pods by Multus.  Traffic to an output is sent over
+	// an attached network when the routes of the network include the address of the output.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Networks"
+	Networks []NetworkAttachment `json:"networks,omitempty"`
+
+	// MaxRecordSize is the default maximum size of the message of records forwarded to any output.  Outputs may
+	// override the default with their own maxRecordSize.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Record Size"
+	MaxRecordSize *resource.Quantity `json:"maxRecordSize,omitempty"`
+
+	// Shards deploy separate collector daemonsets which collect the logs of the inputs of the given types, each with
+	// their own resources, placement and priority.  The logs of the inputs of other types are collected by the
+	// primary collector.  Shards are only deployed when the collector is deployed as a daemonset
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems:=3
+	// +listType:=map
+	// +listMapKey:=name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Shards"
+	Shards []CollectorShardSpec `json:"shards,omitempty"`
+
+	// Aggregator deploys an aggregator to which the collectors forward the collected logs.  The aggregator applies
+	// the filters of the pipelines, buffers the logs and forwards them to the outputs, centralizing the egress
+	// traffic to the outputs.  The aggregator is only deployed when the collector is deployed as a daemonset
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Aggregator"
+	Aggregator *AggregatorSpec `json:"aggregator,omitempty"`
+
+	// Mesh enables the compatibility of the collector with an Istio based service mesh.  The collector pods are
+	// annotated for the injection of the proxy sidecar of the mesh, so that the logs are sent to the outputs through
+	// the mesh
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Mesh"
+	Mesh *CollectorMeshSpec `json:"mesh,omitempty"`
+
+	// Metrics defines the endpoint on which the collector exposes its metrics
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Metrics"
+	Metrics *CollectorMetricsSpec `json:"metrics,omitempty"`
+}
+
+// CollectorMetricsSpec defines the endpoint on which the collector exposes its metrics
+type CollectorMetricsSpec struct {
+	// ServingCertSecretName is the name of a secret in the namespace of the forwarder holding the certificate (tls.crt)
+	// and key (tls.key) served by the metrics endpoint instead of the certificate issued by the service CA.  The CA
+	// (ca.crt) of the secret is used by the monitoring stack to verify the certificate
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Serving Certificate Secret Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ServingCertSecretName string `json:"servingCertSecretName,omitempty"`
+
+	// Monitoring is the monitoring stack which scrapes the metrics of the collector.  Defaults to Platform
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=Platform
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Monitoring"
+	Monitoring MetricsMonitoring `json:"monitoring,omitempty"`
+}
+
+// MetricsMonitoring is the monitoring stack which scrapes the metrics of the collector
+//
+// +kubebuilder:validation:Enum:=Platform;UserWorkload
+type MetricsMonitoring string
+
+const (
+	// MetricsMonitoringPlatform scrapes the metrics by the platform monitoring stack, which monitors the namespaces
+	// labeled with openshift.io/cluster-monitoring
+	MetricsMonitoringPlatform MetricsMonitoring = "Platform"
+
+	// MetricsMonitoringUserWorkload scrapes the metrics by the user workload monitoring stack, so that the users of
+	// the namespace of the forwarder can query the metrics of their forwarder
+	MetricsMonitoringUserWorkload MetricsMonitoring = "UserWorkload"
+)
+
+// CollectorMeshSpec defines the compatibility of the collector with a service mesh
+type CollectorMeshSpec struct {
+	// SkipHoldUntilProxyStarts starts the collector without waiting for the proxy sidecar to be ready.  By default,
+	// the collector is held until the proxy starts, since the connections opened before are rejected by the mesh
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Skip Hold Until Proxy Starts"
+	SkipHoldUntilProxyStarts bool `json:"skipHoldUntilProxyStarts,omitempty"`
+
+	// TLSOrigination defines whether the collector or the mesh secures the connections to the outputs.  Defaults
+	// to Collector
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=Collector
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS Origination"
+	TLSOrigination MeshTLSOrigination `json:"tlsOrigination,omitempty"`
+}
+
+// MeshTLSOrigination defines whether the collector or the mesh secures the connections to the outputs
+//
+// +kubebuilder:validation:Enum:=Collector;Mesh
+type MeshTLSOrigination string
+
+const (
+	// MeshTLSOriginationCollector secures the connections to the outputs by the collector as defined by the outputs.
+	// The mesh passes the encrypted traffic through
+	MeshTLSOriginationCollector MeshTLSOrigination = "Collector"
+
+	// MeshTLSOriginationMesh sends the logs to the outputs over plain connections which are secured by the mesh.
+	// The secure URLs of the outputs are rewritten to their plain scheme on the same port
+	MeshTLSOriginationMesh MeshTLSOrigination = "Mesh"
+)
+
+// AggregatorSpec defines the deployment of the aggregator to which the collectors forward the collected logs
+type AggregatorSpec struct {
+	// Replicas is the number of aggregator pods. Defaults to 2
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replicas",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:podCount"}
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// The resource requirements for the aggregator
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resource Requirements",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:resourceRequirements"}
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Define nodes for scheduling the aggregator pods.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Node Selector"
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Define the tolerations the aggregator pods will accept
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tolerations"
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// PersistentQueue queues the logs of the outputs on a persistent volume claimed by each aggregator pod, so that
+	// the logs are retained by the aggregator while an output is unavailable.  The aggregator is deployed as a
+	// statefulset when defined
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Persistent Queue"
+	PersistentQueue *AggregatorPersistentQueueSpec `json:"persistentQueue,omitempty"`
+}
+
+// AggregatorPersistentQueueSpec defines the persistent volume claims on which the aggregator queues the logs of the
+// outputs
+type AggregatorPersistentQueueSpec struct {
+	// Size is the size of the persistent volume claimed by each aggregator pod.  The size is shared by the queues of
+	// the outputs
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Size"
+	Size resource.Quantity `json:"size"`
+
+	// StorageClassName is the storage class of the persistent volume claims.  The default storage class of the
+	// cluster is used when not defined
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Storage Class Name"
+	StorageClassName string `json:"storageClassName,omitempty"`
+
+	// Retention is the policy applied to new logs when the queue of an output is full.  Defaults to Block
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=Block
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Retention"
+	Retention QueueRetentionPolicy `json:"retention,omitempty"`
+}
+
+// QueueRetentionPolicy is the policy applied to new logs when the queue of an output is full
+//
+// +kubebuilder:validation:Enum:=Block;DropNewest
+type QueueRetentionPolicy string
+
+const (
+	// QueueRetentionPolicyBlock retains the queued logs and stops accepting new logs until the output is available,
+	// applying back pressure to the collectors which buffer the logs on the nodes
+	QueueRetentionPolicyBlock QueueRetentionPolicy = "Block"
+
+	// QueueRetentionPolicyDropNewest retains the queued logs and drops new logs until the output is available
+	QueueRetentionPolicyDropNewest QueueRetentionPolicy = "DropNewest"
+)
+
+// CollectorShardSpec defines a collector daemonset dedicated to collecting the logs of the inputs of the given types.
+// Resources, node selector and tolerations default to those of the collector when not defined
+//
+// +kubebuilder:validation:XValidation:rule="self.inputTypes.all(t, t in ['application', 'infrastructure', 'audit'])",message="only application, infrastructure and audit inputs can be collected by a shard"
+// +kubebuilder:validation:XValidation:rule="self.name != 'aggregator'",message="aggregator is a reserved shard name"
+type CollectorShardSpec struct {
+	// Name of the shard.  The name is appended to the name of the collector resources of the shard
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:="^[a-z][a-z0-9-]{0,14}[a-z0-9]$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name"
+	Name string `json:"name"`
+
+	// InputTypes are the types of the inputs whose logs are collected by the shard instead of the primary collector
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:MaxItems:=3
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Input Types"
+	InputTypes []InputType `json:"inputTypes"`
+
+	// The resource requirements for the collectors of the shard
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resource Requirements",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:resourceRequirements"}
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Define nodes for scheduling the pods of the shard.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Node Selector"
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Define the tolerations the pods of the shard will accept
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tolerations"
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// PriorityClassName is the priority class of the pods of the shard.  Defaults to system-node-critical
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Priority Class Name"
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+}
+
+// NetworkPolicyRuleSetType is the set of rules of the NetworkPolicy generated for the collector pods
+//
+// +kubebuilder:validation:Enum:=AllowAllIngressEgress;RestrictIngressEgress
+type NetworkPolicyRuleSetType string
+
+const (
+	// NetworkPolicyRuleSetTypeAllowAllIngressEgress allows all ingress and egress traffic of the collector pods
+	NetworkPolicyRuleSetTypeAllowAllIngressEgress NetworkPolicyRuleSetType = "AllowAllIngressEgress"
+
+	// NetworkPolicyRuleSetTypeRestrictIngressEgress only allows ingress traffic to the metrics and receiver ports and
+	// egress traffic to the ports of the outputs, DNS and the API server
+	NetworkPolicyRuleSetTypeRestrictIngressEgress NetworkPolicyRuleSetType = "RestrictIngressEgress"
+)
+
+// NetworkPolicy defines the NetworkPolicy generated for the collector pods
+type NetworkPolicy struct {
+	// RuleSet is the set of rules of the NetworkPolicy
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rule Set"
+	RuleSet NetworkPolicyRuleSetType `json:"ruleSet"`
+}
+
+// ValidationMode is the handling of a spec with invalid inputs, outputs, filters or pipelines
+//
+// +kubebuilder:validation:Enum:=Strict;Permissive
+type ValidationMode string
+
+const (
+	// ValidationModeStrict rejects the spec when any of its inputs, outputs, filters or pipelines is invalid.
+	// No configuration is generated and the collector continues to run with its last valid configuration
+	ValidationModeStrict ValidationMode = "Strict"
+
+	// ValidationModePermissive removes the invalid inputs, outputs, filters and pipelines, and the pipelines
+	// which depend on them, and forwards the logs of the remaining pipelines
+	ValidationModePermissive ValidationMode = "Permissive"
+)
+
+// ValidationSpec defines how the operator handles a spec with invalid inputs, outputs, filters or pipelines
+type ValidationSpec struct {
+	// Mode is the handling of a spec with invalid inputs, outputs, filters or pipelines.  In Strict mode
+	// the forwarder is not ready until the spec is valid.  In Permissive mode the logs of the valid pipelines are
+	// forwarded and the forwarder is degraded.
+	//
+	// +kubebuilder:default:=Strict
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Validation Mode"
+	Mode ValidationMode `json:"mode,omitempty"`
+}
+
+// NetworkAttachment references a NetworkAttachmentDefinition to attach to the collector pods
+type NetworkAttachment struct {
+	// Name of the NetworkAttachmentDefinition
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name"
+	Name string `json:"name"`
+
+	// Namespace of the NetworkAttachmentDefinition.  Defaults to the namespace of the forwarder
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace"
+	Namespace string `json:"namespace,omitempty"`
+
+	// Interface is the name of the interface of the network in the collector pods
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^[a-zA-Z0-9_.-]{1,15}$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Interface"
+	Interface string `json:"interface,omitempty"`
+}
+
+// CollectorRolloutSpec defines a canary rollout of changes to the collector config.  Changes are rolled out to the
+// collectors on the canary nodes first and to the remaining collectors once the canary collectors remain healthy
+// for the bake time.  Changes are rolled back on the canary nodes when the canary collectors are unhealthy.
+type CollectorRolloutSpec struct {
+	// CanaryNodeSelector selects the nodes to which changes are rolled out first
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinProperties:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Canary Node Selector"
+	CanaryNodeSelector map[string]string `json:"canaryNodeSelector"`
+
+	// BakeTime is the time, in seconds, the canary collectors must remain healthy before changes are rolled out to the
+	// remaining collectors. Defaults to 600 seconds.
+	//
+	// +kubebuilder:validation:Minimum:=60
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Bake Time"
+	BakeTime *time.Duration `json:"bakeTime,omitempty"`
+}
+
+// VerticalPodAutoscalerUpdateMode is the mode in which the VerticalPodAutoscaler applies its recommendations
+//
+// +kubebuilder:validation:Enum:=Off;Initial;Auto
+type VerticalPodAutoscalerUpdateMode string
+
+const (
+	// VerticalPodAutoscalerUpdateModeOff only records recommendations in the status of the VerticalPodAutoscaler
+	VerticalPodAutoscalerUpdateModeOff VerticalPodAutoscalerUpdateMode = "Off"
+
+	// VerticalPodAutoscalerUpdateModeInitial applies recommendations when collector pods are created
+	VerticalPodAutoscalerUpdateModeInitial VerticalPodAutoscalerUpdateMode = "Initial"
+
+	// VerticalPodAutoscalerUpdateModeAuto applies recommendations by evicting and recreating collector pods
+	VerticalPodAutoscalerUpdateModeAuto VerticalPodAutoscalerUpdateMode = "Auto"
+)
+
+// VerticalPodAutoscalerSpec defines the VerticalPodAutoscaler for the collector
+type VerticalPodAutoscalerSpec struct {
+	// UpdateMode is the mode in which recommendations are applied to the collector pods
+	//
+	// +kubebuilder:default:=Off
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Update Mode"
+	UpdateMode VerticalPodAutoscalerUpdateMode `json:"updateMode,omitempty"`
 }
 
 // PipelineSpec links a set of inputs and transformations to a set of outputs.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.templateRef) || (has(self.outputRefs) && size(self.outputRefs) > 0)",message="outputRefs are required when the pipeline does not reference a template"
 type PipelineSpec struct {
 	// Name of the pipeline
 	//
@@ -149,10 +587,11 @@ type PipelineSpec struct {
 
 	// OutputRefs lists the names (`output.name`) of outputs from this pipeline.
 	//
-	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:MinItems:=1
+	// Required unless the pipeline references a template.
+	//
+	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Outputs"
-	OutputRefs []string `json:"outputRefs"`
+	OutputRefs []string `json:"outputRefs,omitempty"`
 
 	// Filters lists the names of filters to be applied to records going through this pipeline.
 	//
@@ -162,6 +601,140 @@ type PipelineSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Filters"
 	FilterRefs []string `json:"filterRefs,omitempty"`
+
+	// Ordering is the record ordering guarantee for this pipeline.
+	//
+	// When set to `strict`, records from the same container are delivered to each output in the order they were read.
+	// Outputs are limited to a single in-flight request and Kafka records are partitioned by container.
+	// Strict ordering reduces throughput and applies to all pipelines forwarding to the same outputs.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ordering"
+	Ordering OrderingMode `json:"ordering,omitempty"`
+
+	// MinLevel is the minimum level of the records forwarded by this pipeline.
+	//
+	// The level is evaluated after it is normalized by the collector.  Records with a lower or unknown level are dropped.
+	// Audit records do not have a level and are always forwarded.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Minimum Level"
+	MinLevel LogLevel `json:"minLevel,omitempty"`
+
+	// TemplateRef is the name (`pipelineTemplate.name`) of the template applied to this pipeline.
+	//
+	// The filters of the template are applied before the filters of the pipeline and the records are forwarded
+	// to the outputs of the template in addition to the outputs of the pipeline.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Template"
+	TemplateRef string `json:"templateRef,omitempty"`
+}
+
+// PipelineTemplateSpec is a reusable set of filters and outputs applied to each pipeline that references it
+type PipelineTemplateSpec struct {
+	// Name of the pipeline template
+	//
+	// +kubebuilder:validation:Pattern:="^[a-z][a-z0-9-]*[a-z0-9]$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Name string `json:"name"`
+
+	// OutputRefs lists the names (`output.name`) of outputs of the pipelines referencing this template.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Outputs"
+	OutputRefs []string `json:"outputRefs"`
+
+	// FilterRefs lists the names of filters applied, in order, to the records of the pipelines referencing this template.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Filters"
+	FilterRefs []string `json:"filterRefs,omitempty"`
+}
+
+// LogLevel is the normalized severity of a log record, in increasing order of severity.
+//
+// +kubebuilder:validation:Enum:=trace;debug;info;notice;warn;error;critical;alert;emergency
+type LogLevel string
+
+// LogLevels are the log levels in increasing order of severity
+var LogLevels = []LogLevel{
+	LogLevelTrace,
+	LogLevelDebug,
+	LogLevelInfo,
+	LogLevelNotice,
+	LogLevelWarn,
+	LogLevelError,
+	LogLevelCritical,
+	LogLevelAlert,
+	LogLevelEmergency,
+}
+
+const (
+	LogLevelTrace     LogLevel = "trace"
+	LogLevelDebug     LogLevel = "debug"
+	LogLevelInfo      LogLevel = "info"
+	LogLevelNotice    LogLevel = "notice"
+	LogLevelWarn      LogLevel = "warn"
+	LogLevelError     LogLevel = "error"
+	LogLevelCritical  LogLevel = "critical"
+	LogLevelAlert     LogLevel = "alert"
+	LogLevelEmergency LogLevel = "emergency"
+)
+
+// OrderingMode sets the record ordering guarantee of a pipeline.
+//
+// +kubebuilder:validation:Enum:=none;strict
+type OrderingMode string
+
+const (
+	// OrderingModeNone makes no guarantee about the order records are delivered. This is the default.
+	OrderingModeNone OrderingMode = "none"
+
+	// OrderingModeStrict delivers records from the same container in the order they were read, at the cost of throughput.
+	OrderingModeStrict OrderingMode = "strict"
+)
+
+// PolicySpec forbids forwarding the logs of selected sources to outputs with selected tags
+type PolicySpec struct {
+	// Name of the policy
+	//
+	// +kubebuilder:validation:Pattern:="^[a-z][a-z0-9-]*[a-z0-9]$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Name string `json:"name"`
+
+	// Sources selects the logs restricted by this policy.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Sources"
+	Sources PolicySources `json:"sources"`
+
+	// DenyOutputTags lists the tags (`output.tags`) of outputs which must not receive the selected logs.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Denied Output Tags"
+	DenyOutputTags []string `json:"denyOutputTags"`
+}
+
+// PolicySources selects logs by their type or namespace.
+// Logs matching any of the types or namespaces are selected.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.types) || has(self.namespaces)", message="At least one of types or namespaces is required"
+type PolicySources struct {
+	// Types of logs selected by the policy.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Types"
+	Types []InputType `json:"types,omitempty"`
+
+	// Namespaces of container logs selected by the policy.
+	// Supports glob patterns.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespaces"
+	Namespaces []string `json:"namespaces,omitempty"`
 }
 
 type LimitSpec struct {
@@ -175,10 +748,12 @@ type LimitSpec struct {
 	MaxRecordsPerSecond int64 `json:"maxRecordsPerSecond"`
 }
 
-// ValueReference encodes a reference to a single field in either a ConfigMap or Secret in the same namespace.
+// ValueReference encodes a reference to a single field in either a ConfigMap or Secret in the same namespace,
+// unless the namespace of the Secret is given.
 //
 // +kubebuilder:validation:XValidation:rule="has(self.configMapName) || has(self.secretName)", message="Either configMapName or secretName needs to be set"
 // +kubebuilder:validation:XValidation:rule="!(has(self.configMapName) && has(self.secretName))", message="Only one of configMapName and secretName can be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.secretNamespace) || has(self.secretName)", message="secretNamespace can only be set with secretName"
 type ValueReference struct {
 	// Name of the key used to get the value in either the referenced ConfigMap or Secret.
 	//
@@ -195,9 +770,19 @@ type ValueReference struct {
 	//
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	SecretName string `json:"secretName,omitempty"`
+
+	// SecretNamespace is the namespace of the Secret when it is not the namespace of the forwarder.
+	//
+	// The operator copies the secret into the namespace of the forwarder and keeps the copy in sync.  The service account
+	// of the forwarder must be permitted to get the secret.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret Namespace",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	SecretNamespace string `json:"secretNamespace,omitempty"`
 }
 
-// SecretReference encodes a reference to a single key in a Secret in the same namespace.
+// SecretReference encodes a reference to a single key in a Secret in the same namespace, unless the namespace
+// of the Secret is given.
 type SecretReference struct {
 	// Key contains the name of the key inside the referenced Secret.
 	//
@@ -210,6 +795,15 @@ type SecretReference struct {
 	// +kubebuilder:validation:Required
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	SecretName string `json:"secretName"`
+
+	// SecretNamespace is the namespace of the Secret when it is not the namespace of the forwarder.
+	//
+	// The operator copies the secret into the namespace of the forwarder and keeps the copy in sync.  The service account
+	// of the forwarder must be permitted to get the secret.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret Namespace",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	SecretNamespace string `json:"secretNamespace,omitempty"`
 }
 
 // BearerToken allows configuring the source of a bearer token used for authentication.
@@ -310,6 +904,104 @@ type ClusterLogForwarderStatus struct {
 	//
 	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Pipeline Conditions",xDescriptors={"urn:alm:descriptor:io.kubernetes.conditions"}
 	Pipelines []metav1.Condition `json:"pipelinesStatus,omitempty"`
+
+	// Collector is the observed state of the collector
+	//
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Collector Status"
+	Collector *CollectorStatus `json:"collectorStatus,omitempty"`
+
+	// History is a record of the most recent generations of the spec reconciled by the operator, oldest first.
+	//
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Configuration History"
+	History []ConfigurationRecord `json:"history,omitempty"`
+
+	// AppliedGeneration is the most recent generation of the spec that was successfully reconciled.
+	//
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Applied Generation"
+	AppliedGeneration int64 `json:"appliedGeneration,omitempty"`
+
+	// Effective is the spec the operator most recently acted upon after resolving composite inputs and pipeline
+	// templates, converting LokiStack outputs and applying recommended resources. It is only updated when the
+	// spec is valid.
+	//
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Effective Spec"
+	Effective *ClusterLogForwarderSpec `json:"effective,omitempty"`
+
+	// Inventory lists the types of the components active in the deployed configuration of each collector workload
+	// of the forwarder. It is only updated when the collector is reconciled.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Component Inventory"
+	Inventory []CollectorInventory `json:"inventory,omitempty"`
+}
+
+// ConfigurationRecord is a record of a generation of the spec reconciled by the operator
+type ConfigurationRecord struct {
+	// Generation of the spec
+	Generation int64 `json:"generation"`
+
+	// Time the generation was first reconciled
+	Time metav1.Time `json:"time"`
+
+	// Manager is the field manager that last modified the spec
+	//
+	// +optional
+	Manager string `json:"manager,omitempty"`
+
+	// Status of the Ready condition resulting from the most recent reconciliation of the generation
+	Status metav1.ConditionStatus `json:"status"`
+
+	// Reason of the Ready condition resulting from the most recent reconciliation of the generation
+	//
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message of the Ready condition resulting from the most recent reconciliation of the generation
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CollectorInventory is the inventory of the components of the configuration deployed to a collector workload
+type CollectorInventory struct {
+	// Name of the collector workload
+	Name string `json:"name"`
+
+	// Sources are the types of the sources of the collector, sorted and without duplicates
+	//
+	// +optional
+	Sources []string `json:"sources,omitempty"`
+
+	// Transforms are the types of the transforms of the collector, sorted and without duplicates
+	//
+	// +optional
+	Transforms []string `json:"transforms,omitempty"`
+
+	// Sinks are the types of the sinks of the collector, sorted and without duplicates
+	//
+	// +optional
+	Sinks []string `json:"sinks,omitempty"`
+}
+
+// CollectorStatus is the observed state of the collector
+type CollectorStatus struct {
+	// RecommendedResources are the resource requirements recommended for the collector from the peak usage
+	// observed across the collector pods.
+	//
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Recommended Resource Requirements",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:resourceRequirements"}
+	RecommendedResources *corev1.ResourceRequirements `json:"recommendedResources,omitempty"`
+
+	// LastObservedTime is the last time the usage of the collector pods was observed
+	//
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Last Observed Time"
+	LastObservedTime *metav1.Time `json:"lastObservedTime,omitempty"`
 }
 
 // ClusterLogForwarder is an API to configure forwarding logs.
@@ -32,6 +32,9 @@ const (
 
 	ConditionTypeLogLevel = GroupName + "/LogLevel"
 
+	// ConditionTypeDrifted identifies if resources managed for the service were modified outside of the operator
+	ConditionTypeDrifted = GroupName + "/Drifted"
+
 	// ConditionTypeReady indicates the service is ready.
 	//
 	// Ready=True means the operands are running and providing some service.
@@ -41,6 +44,18 @@ const (
 	// outside the operator's control.
 	ConditionTypeReady string = "Ready"
 
+	// ConditionTypeAvailable summarizes, following the conventions of the ClusterOperator status, whether collector
+	// pods are available to collect and forward logs
+	ConditionTypeAvailable string = "Available"
+
+	// ConditionTypeProgressing summarizes, following the conventions of the ClusterOperator status, whether changes to
+	// the spec are being rolled out to the collector
+	ConditionTypeProgressing string = "Progressing"
+
+	// ConditionTypeDegraded summarizes, following the conventions of the ClusterOperator status, whether the forwarder
+	// is not in its desired state because of a failure or of unavailable collector pods
+	ConditionTypeDegraded string = "Degraded"
+
 	// ConditionTypeValid identifies the state of validation for the service
 	ConditionTypeValid = GroupName + "/Valid"
 
@@ -53,18 +68,48 @@ const (
 	// ConditionTypeValidPipelinePrefix prefixes a named pipeline to identify its validation state
 	ConditionTypeValidPipelinePrefix = GroupName + "/ValidPipeline"
 
+	// ConditionTypePausedOutputPrefix prefixes a named output to identify its paused state
+	ConditionTypePausedOutputPrefix = GroupName + "/PausedOutput"
+
 	// ConditionTypeValidFilterPrefix prefixes a named filter to identify its validation state
 	ConditionTypeValidFilterPrefix = GroupName + "/ValidFilter"
 
+	// ReasonAsExpected means the summarized state of the forwarder is the desired state
+	ReasonAsExpected = "AsExpected"
+
+	// ReasonCanaryRolloutFailure means the collector config was rolled back on the canary nodes
+	ReasonCanaryRolloutFailure = "CanaryRolloutFailure"
+
+	// ReasonCanaryRolloutPending means the collector config is being evaluated on the canary nodes before it is rolled out
+	ReasonCanaryRolloutPending = "CanaryRolloutPending"
+
 	// ReasonClusterRolesExist means the collector serviceAccount is bound to all the cluster roles needed to collect a log_type
 	ReasonClusterRolesExist = "ClusterRolesExist"
 
 	// ReasonClusterRoleMissing means the collector serviceAccount is missing one or more clusterRoles needed to collect a log_type
 	ReasonClusterRoleMissing = "ClusterRoleMissing"
 
+	// ReasonCollectorAvailable means at least one collector pod is available
+	ReasonCollectorAvailable = "CollectorAvailable"
+
+	// ReasonCollectorPodsUnavailable means collector pods are unavailable once the rollout of the collector completed
+	ReasonCollectorPodsUnavailable = "CollectorPodsUnavailable"
+
+	// ReasonCollectorUnavailable means no collector pod is available
+	ReasonCollectorUnavailable = "CollectorUnavailable"
+
+	// ReasonConfigValidationFailure means the generated collector config failed validation and was not rolled out
+	ReasonConfigValidationFailure = "ConfigValidationFailure"
+
+	// ReasonConfigValidationPending means the generated collector config is being validated before it is rolled out
+	ReasonConfigValidationPending = "ConfigValidationPending"
+
 	// ReasonDeploymentError means an error occurred trying to deploy the collector or some related component
 	ReasonDeploymentError = "DeploymentError"
 
+	// ReasonDriftDetected means a managed resource was modified outside of the operator
+	ReasonDriftDetected = "DriftDetected"
+
 	// ReasonInitializationFailed indicates a failure initializing the reconciliation context
 	ReasonInitializationFailed = "InitializationFailed"
 
@@ -74,15 +119,34 @@ const (
 	// ReasonManagementStateUnmanaged is used when the workload is in an Unmanaged state
 	ReasonManagementStateUnmanaged = "ManagementStateUnmanaged"
 
+	// ReasonMaintenanceWindowPending applies when changes to the spec are deferred until the next maintenance window
+	ReasonMaintenanceWindowPending = "MaintenanceWindowPending"
+
 	// ReasonMissingSpec applies when a type is specified without a defined spec (e.g. type application without obs.Application)
 	ReasonMissingSpec = "MissingSpec"
 
 	// ReasonLogLevelSupported indicates the support for the log level annotation value
 	ReasonLogLevelSupported = "LogLevelSupported"
 
+	// ReasonNoDriftDetected means the managed resources match the state last applied by the operator
+	ReasonNoDriftDetected = "NoDriftDetected"
+
+	// ReasonOutputPaused means the delivery of records to an output is paused
+	ReasonOutputPaused = "OutputPaused"
+
+	// ReasonOutputResumed means the delivery of records to an output was resumed and the records spooled while it was
+	// paused are delivered
+	ReasonOutputResumed = "OutputResumed"
+
 	// ReasonReconciliationComplete when the operator has initialized, validated, and deployed the resources for the workload
 	ReasonReconciliationComplete = "ReconciliationComplete"
 
+	// ReasonRolloutComplete means all the collector pods run the current spec
+	ReasonRolloutComplete = "RolloutComplete"
+
+	// ReasonRolloutInProgress means the current spec is being rolled out to the collector pods
+	ReasonRolloutInProgress = "RolloutInProgress"
+
 	// ReasonServiceAccountDoesNotExist when the ServiceAccount is not found
 	ReasonServiceAccountDoesNotExist = "ServiceAccountDoesNotExist"
 
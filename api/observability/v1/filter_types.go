@@ -14,19 +14,37 @@ limitations under the License.
 
 package v1
 
+import (
+	"time"
+)
+
 // FilterType specifies the type of filter used in a pipeline
 //
-// +kubebuilder:validation:Enum:=openShiftLabels;detectMultilineException;drop;kubeAPIAudit;parse;prune
+// +kubebuilder:validation:Enum:=openShiftLabels;detectMultilineException;drop;kubeAPIAudit;parse;prune;schedule;encrypt;sanitize;timestamp;clockSkew;auditEnrichment;logMetrics;traceContext;namespaceParsers;grok;cel;vrl;rollup;redact
 type FilterType string
 
 // Filter type constants, must match JSON tags of FilterTypeSpec fields.
 const (
-	FilterTypeDetectMultiline FilterType = "detectMultilineException"
-	FilterTypeDrop            FilterType = "drop"
-	FilterTypeKubeAPIAudit    FilterType = "kubeAPIAudit"
-	FilterTypeOpenshiftLabels FilterType = "openShiftLabels"
-	FilterTypeParse           FilterType = "parse"
-	FilterTypePrune           FilterType = "prune"
+	FilterTypeDetectMultiline  FilterType = "detectMultilineException"
+	FilterTypeDrop             FilterType = "drop"
+	FilterTypeEncrypt          FilterType = "encrypt"
+	FilterTypeKubeAPIAudit     FilterType = "kubeAPIAudit"
+	FilterTypeOpenshiftLabels  FilterType = "openShiftLabels"
+	FilterTypeParse            FilterType = "parse"
+	FilterTypePrune            FilterType = "prune"
+	FilterTypeSanitize         FilterType = "sanitize"
+	FilterTypeTimestamp        FilterType = "timestamp"
+	FilterTypeClockSkew        FilterType = "clockSkew"
+	FilterTypeAuditEnrichment  FilterType = "auditEnrichment"
+	FilterTypeLogMetrics       FilterType = "logMetrics"
+	FilterTypeTraceContext     FilterType = "traceContext"
+	FilterTypeNamespaceParsers FilterType = "namespaceParsers"
+	FilterTypeGrok             FilterType = "grok"
+	FilterTypeCEL              FilterType = "cel"
+	FilterTypeVRL              FilterType = "vrl"
+	FilterTypeRollup           FilterType = "rollup"
+	FilterTypeRedact           FilterType = "redact"
+	FilterTypeSchedule         FilterType = "schedule"
 )
 
 var (
@@ -37,6 +55,20 @@ var (
 		FilterTypeKubeAPIAudit,
 		FilterTypeParse,
 		FilterTypePrune,
+		FilterTypeSchedule,
+		FilterTypeEncrypt,
+		FilterTypeSanitize,
+		FilterTypeTimestamp,
+		FilterTypeClockSkew,
+		FilterTypeAuditEnrichment,
+		FilterTypeLogMetrics,
+		FilterTypeTraceContext,
+		FilterTypeNamespaceParsers,
+		FilterTypeGrok,
+		FilterTypeCEL,
+		FilterTypeVRL,
+		FilterTypeRollup,
+		FilterTypeRedact,
 	}
 )
 
@@ -55,6 +87,16 @@ type FieldPath string
 // +kubebuilder:validation:XValidation:rule="self.type != 'drop' || has(self.drop)", message="Additional type specific spec is required for the filter type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'prune' || has(self.prune)", message="Additional type specific spec is required for the filter type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'openShiftLabels' || has(self.openShiftLabels)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'schedule' || has(self.schedule)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'encrypt' || has(self.encrypt)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'timestamp' || has(self.timestamp)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'logMetrics' || has(self.logMetrics)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'namespaceParsers' || has(self.namespaceParsers)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'grok' || has(self.grok)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'cel' || has(self.cel)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'vrl' || has(self.vrl)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'rollup' || has(self.rollup)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'redact' || has(self.redact)", message="Additional type specific spec is required for the filter type"
 type FilterSpec struct {
 	// Name used to refer to the filter from a "pipeline".
 	//
@@ -92,6 +134,90 @@ type FilterSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Labels"
 	OpenShiftLabels map[string]string `json:"openShiftLabels,omitempty"`
+
+	// A schedule filter keeps log records processed during any of its time windows and drops all others.
+	// Pipelines using schedule filters route logs to different outputs depending on the time of day (e.g. business hours and off-hours).
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Schedule Filter"
+	Schedule *ScheduleFilterSpec `json:"schedule,omitempty"`
+
+	// An encrypt filter replaces the values of fields with their ciphertext before records leave the cluster.
+	// Only parties holding the key can decrypt the values received by an output.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Encrypt Filter"
+	Encrypt *EncryptFilterSpec `json:"encrypt,omitempty"`
+
+	// A sanitize filter decodes the values of fields to valid UTF-8 so receivers do not reject records with
+	// invalid byte sequences.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Sanitize Filter"
+	Sanitize *SanitizeFilterSpec `json:"sanitize,omitempty"`
+
+	// A timestamp filter parses the time a log record was written from a field and sets it as the `@timestamp` of the record.
+	// Records of applications which log with a legacy time format or buffer their logs are indexed at the time they were written.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Timestamp Filter"
+	Timestamp *TimestampFilterSpec `json:"timestamp,omitempty"`
+
+	// A clockSkew filter detects records with a timestamp too far in the past or the future, usually written by nodes
+	// with a broken clock, and annotates or corrects them so they are not missing from time-bounded queries.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Clock Skew Filter"
+	ClockSkew *ClockSkewFilterSpec `json:"clockSkew,omitempty"`
+
+	// A logMetrics filter increments Prometheus counters for records matching patterns, exposed on the metrics port of the collector.
+	// Error signatures can be counted per namespace without a separate tool.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Metrics Filter"
+	LogMetrics *LogMetricsFilterSpec `json:"logMetrics,omitempty"`
+
+	// A namespaceParsers filter parses the messages of containers with the parsers registered for their namespace in a ConfigMap.
+	// Pods select a parser of their namespace with the `observability.openshift.io/log-parser` annotation.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace Parsers Filter"
+	NamespaceParsers *NamespaceParsersFilterSpec `json:"namespaceParsers,omitempty"`
+
+	// A grok filter parses a field of log records with grok patterns into the `structured` field of the records.
+	// Patterns can reference the shipped library of patterns for apache, nginx, haproxy and postgres logs.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Grok Filter"
+	Grok *GrokFilterSpec `json:"grok,omitempty"`
+
+	// A cel filter keeps or drops the log records matching a CEL expression over the record
+	// (e.g. `record.kubernetes.namespace_name.startsWith("team-a") && record.level == "error"`).
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="CEL Filter"
+	CEL *CELFilterSpec `json:"cel,omitempty"`
+
+	// A vrl filter transforms log records with a custom VRL program for transformations the declarative filters do not cover.
+	// The program is checked for syntax errors when the forwarder is reconciled.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="VRL Filter"
+	VRL *VRLFilterSpec `json:"vrl,omitempty"`
+
+	// A rollup filter replaces the log records of each time window with summary records counting the records of each
+	// group and summing their numeric fields, so high-volume logs can be forwarded as rollups instead of raw records.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rollup Filter"
+	Rollup *RollupFilterSpec `json:"rollup,omitempty"`
+
+	// A redact filter masks the parts of the values of fields matching regular expressions (e.g. credit card numbers)
+	// before records leave the cluster.  Whole fields are removed with a prune filter.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Redact Filter"
+	Redact *RedactFilterSpec `json:"redact,omitempty"`
 }
 
 type DropTest struct {
@@ -184,3 +310,414 @@ type PruneFilterSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fields to be kept"
 	NotIn []FieldPath `json:"notIn,omitempty"`
 }
+
+type ScheduleFilterSpec struct {
+	// TimeZone is the IANA name of the time zone used to evaluate the windows (e.g. `America/New_York`).
+	// The value when not specified is `UTC`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Time Zone",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Windows lists the time windows during which log records are kept.
+	// The time of a window is the time the record is processed by the collector.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Time Windows"
+	Windows []TimeWindow `json:"windows"`
+}
+
+// TimeWindow is a daily period of time.
+// A window which ends before it starts (e.g. 22:00 to 06:00) spans midnight.
+type TimeWindow struct {
+	// Days of the week the window applies to. The window applies to every day when not specified.
+	// A window spanning midnight starts on these days and ends on the following days.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Days"
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start of the window as `HH:MM` in 24-hour format, inclusive.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Start",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Start string `json:"start"`
+
+	// End of the window as `HH:MM` in 24-hour format, exclusive.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="End",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	End string `json:"end"`
+}
+
+// Weekday is a day of the week
+//
+// +kubebuilder:validation:Enum:=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+type Weekday string
+
+// EncryptAlgorithm is the cipher used to encrypt the values of fields
+//
+// +kubebuilder:validation:Enum:=XCHACHA20-POLY1305;AES-256-CBC-PKCS7
+type EncryptAlgorithm string
+
+const (
+	// EncryptAlgorithmXChaCha20Poly1305 is authenticated encryption using a 24 byte nonce
+	EncryptAlgorithmXChaCha20Poly1305 EncryptAlgorithm = "XCHACHA20-POLY1305"
+
+	// EncryptAlgorithmAES256CBC is AES-256 in CBC mode with PKCS#7 padding using a 16 byte IV
+	EncryptAlgorithmAES256CBC EncryptAlgorithm = "AES-256-CBC-PKCS7"
+)
+
+// EncryptFilterSpec defines the fields to encrypt and the key used to encrypt them.
+//
+// The value of each field is encrypted with a random nonce (IV) per record and replaced by the base64 encoding of the
+// nonce followed by the ciphertext.  Values which are not strings are encoded as JSON before they are encrypted.
+type EncryptFilterSpec struct {
+	// Fields is an array of dot-delimited field paths to encrypt.
+	//
+	// NOTE: Fields CANNOT contain `.log_type` or `.log_source` as those fields are required to route the records.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fields to encrypt"
+	Fields []FieldPath `json:"fields"`
+
+	// Algorithm is the cipher used to encrypt the values.
+	// The value when not specified is `XCHACHA20-POLY1305`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Algorithm"
+	Algorithm EncryptAlgorithm `json:"algorithm,omitempty"`
+
+	// Key is the secret key with the base64 encoded 32 byte symmetric key.
+	//
+	// The collector does not support public-key encryption.  The key must be shared out-of-band with
+	// the parties authorized to decrypt the values.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key"
+	Key *SecretReference `json:"key"`
+}
+
+// SanitizeFilterSpec defines the fields to decode to valid UTF-8.
+//
+// Invalid byte sequences are replaced with the Unicode replacement character (U+FFFD).  Values which are not
+// strings are not modified.
+type SanitizeFilterSpec struct {
+	// Fields is an array of dot-delimited field paths to sanitize.
+	// The value when not specified is `.message`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fields to sanitize"
+	Fields []FieldPath `json:"fields,omitempty"`
+
+	// Charset is the character encoding of the values as defined by the WHATWG Encoding Standard
+	// (e.g. `ISO-8859-1`, `Shift_JIS`).  Values are decoded from the charset to UTF-8.
+	// The value when not specified is `UTF-8`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Charset",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Charset string `json:"charset,omitempty"`
+
+	// RemoveControlCharacters removes the ASCII control characters, other than tab, line feed and carriage return,
+	// from the values.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Remove Control Characters",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	RemoveControlCharacters bool `json:"removeControlCharacters,omitempty"`
+}
+
+// TimestampFilterSpec defines how to parse the time a log record was written.
+//
+// The `@timestamp` of the record is not modified when the value does not match the pattern or any of the formats.
+type TimestampFilterSpec struct {
+	// Field is the dot-delimited path of the field containing the timestamp.
+	// The value when not specified is `.message`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Field FieldPath `json:"field,omitempty"`
+
+	// Pattern is a regular expression with a capture group named `timestamp` which extracts the timestamp embedded
+	// in the value of the field (e.g. `^(?P<timestamp>\S+ \S+) `).
+	// The entire value is parsed when not specified.  Patterns can not contain single quotes.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pattern",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Pattern string `json:"pattern,omitempty"`
+
+	// Formats are the strptime formats of the timestamp (e.g. `%Y-%m-%d %H:%M:%S`, `%d/%b/%Y:%H:%M:%S %z`).
+	// The formats are tried in order until one matches.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Formats"
+	Formats []string `json:"formats"`
+
+	// TimeZone is the IANA name of the time zone of timestamps which do not include an offset (e.g. `America/New_York`).
+	// The value when not specified is `UTC`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Time Zone",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// NamespaceTimeZones overrides the time zone for the records of application namespaces.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace Time Zones"
+	NamespaceTimeZones []NamespaceTimeZone `json:"namespaceTimeZones,omitempty"`
+}
+
+// NamespaceTimeZone is the time zone of the timestamps of the records from a namespace
+type NamespaceTimeZone struct {
+	// Namespace is the name of the namespace
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Namespace string `json:"namespace"`
+
+	// TimeZone is the IANA name of the time zone (e.g. `Europe/Berlin`)
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Time Zone",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	TimeZone string `json:"timeZone"`
+}
+
+// ClockSkewAction is the action taken for records with a skewed timestamp
+//
+// +kubebuilder:validation:Enum:=annotate;correct
+type ClockSkewAction string
+
+const (
+	// ClockSkewActionAnnotate adds the `clock_skew_seconds` field to the record
+	ClockSkewActionAnnotate ClockSkewAction = "annotate"
+
+	// ClockSkewActionCorrect adds the `clock_skew_seconds` field to the record, moves the `@timestamp` to the
+	// `original_timestamp` field and sets the `@timestamp` to the time the record is received by the collector
+	ClockSkewActionCorrect ClockSkewAction = "correct"
+)
+
+// ClockSkewFilterSpec defines the bounds of the timestamps of records and the action to take for records outside of them.
+//
+// The skew is the difference in seconds between the time the record is received by the collector and its `@timestamp`.
+// The `collector_clock_skewed_records_total` metric counts the skewed records.
+type ClockSkewFilterSpec struct {
+	// MaxPast is the maximum age, in seconds, of the timestamp of a record.
+	// The value when not specified is 86400 seconds.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maximum Past"
+	MaxPast *time.Duration `json:"maxPast,omitempty"`
+
+	// MaxFuture is the maximum time, in seconds, the timestamp of a record may be ahead of the collector.
+	// The value when not specified is 300 seconds.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maximum Future"
+	MaxFuture *time.Duration `json:"maxFuture,omitempty"`
+
+	// Action is the action taken for records with a skewed timestamp.
+	// The value when not specified is `annotate`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Action"
+	Action ClockSkewAction `json:"action,omitempty"`
+}
+
+// LogMetricsFilterSpec defines the counters incremented by the records matching a pattern.
+//
+// The counters are tagged with the `namespace` of container records and the `log_type` of the record.
+type LogMetricsFilterSpec struct {
+	// Metrics is the list of counters.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Metrics"
+	Metrics []LogMetric `json:"metrics"`
+}
+
+// LogMetric is a counter of the records with a field matching a pattern
+type LogMetric struct {
+	// Name of the Prometheus counter.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:="^[a-zA-Z_][a-zA-Z0-9_]*$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name"
+	Name string `json:"name"`
+
+	// Field is the path to the field matched against the pattern.
+	// The value when not specified is `.message`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field"
+	Field FieldPath `json:"field,omitempty"`
+
+	// Pattern is the regular expression the value of the field must match to increment the counter.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pattern"
+	Pattern string `json:"pattern"`
+}
+
+// NamespaceParsersFilterSpec references the ConfigMap registering the parsers of namespaces.
+//
+// Each key of the ConfigMap is the name of a parser and each value is the YAML definition of the parser with:
+// the `namespace` of the containers using the parser, its `type` (`regex`, `grok` or `jsonPointer`) and its `pattern`.
+type NamespaceParsersFilterSpec struct {
+	// ConfigMapName is the name of the ConfigMap in the namespace of the forwarder registering the parsers.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="ConfigMap Name"
+	ConfigMapName string `json:"configMapName"`
+}
+
+// GrokFilterSpec defines the grok patterns parsing a field into the `structured` field of log records.
+//
+// The shipped library provides the `apache_common`, `apache_combined`, `nginx_access`, `nginx_error`, `haproxy_http`,
+// `haproxy_tcp` and `postgres` patterns.
+type GrokFilterSpec struct {
+	// Field is the path to the field parsed by the patterns.
+	// The value when not specified is `.message`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field"
+	Field FieldPath `json:"field,omitempty"`
+
+	// Patterns are the grok patterns tried in order until one matches (e.g. `%{nginx_access}`, `%{WORD:level} %{GREEDYDATA:msg}`).
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Patterns"
+	Patterns []string `json:"patterns"`
+
+	// CustomPatterns are user-supplied patterns referenced by name (e.g. `%{my_prefix}`) from the patterns and other custom patterns.
+	// The names must be lower case and must not be the name of a pattern of the shipped library.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Custom Patterns"
+	CustomPatterns map[string]string `json:"customPatterns,omitempty"`
+}
+
+// CELFilterAction is the action taken for records matching the expression of a cel filter
+//
+// +kubebuilder:validation:Enum:=keep;drop
+type CELFilterAction string
+
+const (
+	// CELFilterActionKeep keeps the records matching the expression and drops all others
+	CELFilterActionKeep CELFilterAction = "keep"
+
+	// CELFilterActionDrop drops the records matching the expression and keeps all others
+	CELFilterActionDrop CELFilterAction = "drop"
+)
+
+// CELFilterSpec defines the CEL expression matching log records and the action taken for the matching records.
+//
+// The record is referenced by the `record` variable.  The expression may use the fields of the record, string, number,
+// boolean and null literals, lists of literals, the operators `&&`, `||`, `!`, `==`, `!=`, `<`, `<=`, `>`, `>=` and `in`,
+// the `has()` macro and the `size()`, `startsWith()`, `endsWith()`, `contains()` and `matches()` functions.
+// A record whose fields are missing or not of the type expected by an operator or function does not match the operator or function.
+type CELFilterSpec struct {
+	// Expression is the CEL expression evaluated for each record (e.g. `record.level == "error"`).
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Expression",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Expression string `json:"expression"`
+
+	// Action is the action taken for the records matching the expression.
+	// The value when not specified is `keep`.
+	//
+	// +kubebuilder:default:=keep
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Action"
+	Action CELFilterAction `json:"action,omitempty"`
+}
+
+// VRLFilterSpec defines a custom VRL program transforming log records.
+//
+// The program is run by a remap transform of the collector.  A program which can fail at runtime must handle its
+// errors (e.g. `parse_json(.message) ?? {}`), otherwise the collector does not start.  Records are dropped by
+// calling `abort`.
+type VRLFilterSpec struct {
+	// Source is the VRL program.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=4096
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Source string `json:"source"`
+}
+
+// RollupFilterSpec defines the windows and the groups of the summary records replacing log records.
+//
+// Each collector emits a summary record per group at the end of each window with: the fields of the group, the
+// `log_type`, `log_source`, `hostname` and `openshift` fields of the first record of the group, the `count` of records,
+// the sums, and the times of the first and last record in `@timestamp` and `@timestamp_end`.
+type RollupFilterSpec struct {
+	// Window is the duration, in seconds, of the windows.
+	// The value when not specified is 60 seconds.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Window"
+	Window *time.Duration `json:"window,omitempty"`
+
+	// GroupBy are the paths to the fields whose values group the records (e.g. `.kubernetes.namespace_name`, `.structured.status`).
+	// Records are grouped by `log_type` only when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Group By"
+	GroupBy []FieldPath `json:"groupBy,omitempty"`
+
+	// Sums are the numeric fields of the records summed per group.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Sums"
+	Sums []RollupSum `json:"sums,omitempty"`
+}
+
+// RollupSum is the sum of a numeric field of the records of a group
+type RollupSum struct {
+	// Name of the field of the summary record holding the sum.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:="^[a-zA-Z_][a-zA-Z0-9_]*$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name"
+	Name string `json:"name"`
+
+	// Field is the path to the summed field.  Values which are not numbers count as zero.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field"
+	Field FieldPath `json:"field"`
+}
+
+// RedactFilterSpec defines the values masked in the fields of log records.
+//
+// Each match of the patterns is replaced with the replacement.  Values which are not strings are not modified.
+type RedactFilterSpec struct {
+	// Fields is an array of dot-delimited field paths to redact.
+	// The value when not specified is `.message`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fields to redact"
+	Fields []FieldPath `json:"fields,omitempty"`
+
+	// Patterns are the regular expressions matching the values to mask (e.g. `\b(?:\d[ -]?){13,16}\b` for credit card numbers).
+	// Patterns can not contain single quotes.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Patterns"
+	Patterns []string `json:"patterns"`
+
+	// Replacement is the text replacing each match of the patterns.
+	// The value when not specified is `[REDACTED]`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replacement",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Replacement string `json:"replacement,omitempty"`
+}
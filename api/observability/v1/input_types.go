@@ -15,12 +15,15 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // InputType specifies the type of log input to create.
 //
-// +kubebuilder:validation:Enum:=audit;application;infrastructure;receiver
+// +kubebuilder:validation:Enum:=audit;application;infrastructure;receiver;composite
 type InputType string
 
 const (
@@ -32,6 +35,8 @@ const (
 	InputTypeAudit InputType = "audit"
 	// InputTypeReceiver defines a network receiver for receiving logs from non-cluster sources.
 	InputTypeReceiver InputType = "receiver"
+	// InputTypeComposite is the union of the logs of other inputs.
+	InputTypeComposite InputType = "composite"
 )
 
 var (
@@ -40,6 +45,7 @@ var (
 		InputTypeInfrastructure,
 		InputTypeAudit,
 		InputTypeReceiver,
+		InputTypeComposite,
 	}
 )
 
@@ -48,6 +54,7 @@ var (
 // +kubebuilder:validation:XValidation:rule="self.type != 'infrastructure' || has(self.infrastructure)", message="Additional type specific spec is required for the input type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'audit' || has(self.audit)", message="Additional type specific spec is required for the input type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'receiver' || has(self.receiver)", message="Additional type specific spec is required for the input type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'composite' || has(self.composite)", message="Additional type specific spec is required for the input type"
 type InputSpec struct {
 	// Name used to refer to the input of a `pipeline`.
 	//
@@ -86,6 +93,27 @@ type InputSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Receiver"
 	Receiver *ReceiverSpec `json:"receiver,omitempty"`
+
+	// Composite, named union of other inputs.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Composite Input"
+	Composite *Composite `json:"composite,omitempty"`
+}
+
+// Composite is an input which collects the logs of a set of other inputs so they can be referenced by pipelines
+// with a single name (e.g. `tenant-a` for the application logs of namespaces `a-*` and the audit logs of the
+// Kubernetes API server). Pipelines which reference a composite input are resolved to reference each of its inputs.
+type Composite struct {
+	// InputRefs lists the names (`input.name`) of the inputs which make up this input.
+	// Composite inputs may reference other composite inputs but must not form a cycle.
+	//
+	// The reserved input names `application`, `infrastructure` and `audit` may be referenced.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Input References"
+	InputRefs []string `json:"inputRefs"`
 }
 
 type ContainerInputTuningSpec struct {
@@ -96,6 +124,57 @@ type ContainerInputTuningSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Per-Container Rate Limit"
 	RateLimitPerContainer *LimitSpec `json:"rateLimitPerContainer,omitempty"`
+
+	// PartialLines defines the merging of the partial lines written by the container runtime for log lines
+	// longer than 16KiB.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Partial Lines"
+	PartialLines *PartialLinesSpec `json:"partialLines,omitempty"`
+
+	// CapturePreviousLogs guarantees the final lines written by a container before it terminates are forwarded
+	// when the pod restarts, even when its log file is rotated quickly (e.g. a crash-looping container).
+	// The log files of terminated containers and uncompressed rotated log files are collected, and new log files
+	// are discovered more frequently at the cost of additional collector CPU.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Capture Previous Logs",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	CapturePreviousLogs bool `json:"capturePreviousLogs,omitempty"`
+
+	// MaxReadBytes is the maximum number of bytes read from a log file before the collector moves on to the next
+	// file. Higher values let the collector keep up with high-throughput containers whose log files are rotated
+	// quickly by the kubelet, at the cost of fairness between files. Defaults to 3Mi.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Read Bytes"
+	MaxReadBytes *resource.Quantity `json:"maxReadBytes,omitempty"`
+
+	// RotateWait is the time, in seconds, a log file continues to be read after it is rotated by the kubelet
+	// before it is closed. Defaults to 5 seconds, or 60 seconds when CapturePreviousLogs is enabled.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rotate Wait"
+	RotateWait *time.Duration `json:"rotateWait,omitempty"`
+}
+
+// PartialLinesSpec defines the merging of the partial (`P`) lines the container runtime writes when a log line is
+// longer than 16KiB. Partial lines are merged into a single record by default.
+type PartialLinesSpec struct {
+	// DisableMerge forwards each partial line as a separate record instead of merging them.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Disable Merge"
+	DisableMerge bool `json:"disableMerge,omitempty"`
+
+	// MaxMergedSize is the maximum size of a merged line. Merged lines exceeding it are discarded.
+	// The value must be at least 16Ki. Merged lines are not limited when not specified.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Merged Size"
+	MaxMergedSize *resource.Quantity `json:"maxMergedSize,omitempty"`
 }
 
 // ApplicationSource defines the type of ApplicationSource log source to use.
@@ -165,7 +244,7 @@ type NamespaceContainerSpec struct {
 
 // InfrastructureSource defines the type of infrastructure log source to use.
 //
-// +kubebuilder:validation:Enum:=container;node
+// +kubebuilder:validation:Enum:=container;node;logging
 type InfrastructureSource string
 
 const (
@@ -175,6 +254,10 @@ const (
 	// InfrastructureSourceContainer are container logs from workloads deployed
 	// in any of the following namespaces: default, kube*, openshift*
 	InfrastructureSourceContainer InfrastructureSource = "container"
+
+	// InfrastructureSourceLogging are container logs from the logging stack itself: the collectors deployed to the
+	// namespace of the forwarder, the operator, and the Elasticsearch, Kibana and LokiStack pods
+	InfrastructureSourceLogging InfrastructureSource = "logging"
 )
 
 var (
@@ -188,9 +271,13 @@ var (
 // Sources of these logs:
 // * container workloads deployed to namespaces: default, kube*, openshift*
 // * journald logs from cluster nodes
+// * container workloads of the logging stack, when the `logging` source is selected
 type Infrastructure struct {
 	// Sources defines the list of infrastructure sources to collect.
-	// This field is optional and omission results in the collection of all infrastructure sources.
+	// This field is optional and omission results in the collection of the `container` and `node` sources.
+	//
+	// The `logging` source collects the logs of the logging stack separately so they can be routed independently.
+	// The `container` source of the same input does not collect them when it is selected.
 	//
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Sources"
@@ -255,6 +342,9 @@ var (
 type InputTLSSpec TLSSpec
 
 // ReceiverSpec is a union of input Receiver types.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.clientAuth) || !has(self.clientAuth.ca) || !has(self.tls) || !has(self.tls.ca)",message="clientAuth.ca and tls.ca can not both be defined"
+// +kubebuilder:validation:XValidation:rule="!has(self.syslog) || self.type == 'syslog'",message="syslog is only supported for receiver type syslog"
 type ReceiverSpec struct {
 	// Type of Receiver plugin.
 	//
@@ -283,15 +373,111 @@ type ReceiverSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="HTTP Receiver Configuration"
 	HTTP *HTTPReceiver `json:"http,omitempty"`
+
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Syslog Receiver Configuration"
+	Syslog *SyslogReceiver `json:"syslog,omitempty"`
+
+	// Expose defines how the receiver is exposed to clients.  The receiver is exposed by a ClusterIP service
+	// when not defined.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Expose"
+	Expose *ReceiverExposeSpec `json:"expose,omitempty"`
+
+	// ClientAuth defines the clients which are authorized to send logs to the receiver.  Any client which is able
+	// to reach the receiver is authorized when not defined.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Client Authentication"
+	ClientAuth *ReceiverClientAuthSpec `json:"clientAuth,omitempty"`
+}
+
+// ReceiverClientAuthSpec defines the authentication and authorization of receiver clients
+type ReceiverClientAuthSpec struct {
+	// CA is the bundle of certificate authorities used to verify client certificates.  Clients must present
+	// a certificate signed by one of these authorities when defined
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Client Certificate Authority Bundle"
+	CA *ValueReference `json:"ca,omitempty"`
+
+	// AllowedCIDRs are the source address ranges (e.g. 10.0.0.0/16) from which clients may send logs.  Clients
+	// from any address are allowed when empty
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems:=64
+	// +listType=set
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Allowed CIDRs"
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
+}
+
+// ReceiverExposeType is how a receiver is exposed to clients
+//
+// +kubebuilder:validation:Enum:=ClusterIP;NodePort;LoadBalancer;Route
+type ReceiverExposeType string
+
+const (
+	// ReceiverExposeTypeClusterIP exposes the receiver by a ClusterIP service
+	ReceiverExposeTypeClusterIP ReceiverExposeType = "ClusterIP"
+
+	// ReceiverExposeTypeNodePort exposes the receiver by a NodePort service
+	ReceiverExposeTypeNodePort ReceiverExposeType = "NodePort"
+
+	// ReceiverExposeTypeLoadBalancer exposes the receiver by a LoadBalancer service
+	ReceiverExposeTypeLoadBalancer ReceiverExposeType = "LoadBalancer"
+
+	// ReceiverExposeTypeRoute exposes the receiver by a ClusterIP service and a re-encrypt Route to the service.
+	// It is only supported for HTTP receivers
+	ReceiverExposeTypeRoute ReceiverExposeType = "Route"
+)
+
+// ReceiverExposeSpec defines how a receiver is exposed to clients
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.nodePort) || self.type == 'NodePort' || self.type == 'LoadBalancer'",message="nodePort is only supported for types NodePort and LoadBalancer"
+// +kubebuilder:validation:XValidation:rule="!has(self.host) || self.type == 'Route'",message="host is only supported for type Route"
+type ReceiverExposeSpec struct {
+	// Type of the exposure
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:default:=ClusterIP
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Type"
+	Type ReceiverExposeType `json:"type"`
+
+	// NodePort is the port on each node for types NodePort and LoadBalancer.  A port is allocated when not defined
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=30000
+	// +kubebuilder:validation:Maximum:=32767
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Node Port",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	NodePort int32 `json:"nodePort,omitempty"`
+
+	// Host of the Route for type Route.  A host is generated by the cluster ingress when not defined
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Host"
+	Host string `json:"host,omitempty"`
 }
 
 // HTTPReceiverFormat defines the type of log data incoming through the HTTP receiver.
 //
-// +kubebuilder:validation:Enum:=kubeAPIAudit
+// +kubebuilder:validation:Enum:=kubeAPIAudit;json;cef;leef
 type HTTPReceiverFormat string
 
 const (
 	HTTPReceiverFormatKubeAPIAudit HTTPReceiverFormat = "kubeAPIAudit"
+
+	// HTTPReceiverFormatJSON receives JSON objects, or arrays of them, which are parsed into the structured field
+	HTTPReceiverFormatJSON HTTPReceiverFormat = "json"
+
+	// HTTPReceiverFormatCEF receives newline delimited Common Event Format records which are parsed into the structured field
+	HTTPReceiverFormatCEF HTTPReceiverFormat = "cef"
+
+	// HTTPReceiverFormatLEEF receives newline delimited Log Event Extended Format records which are parsed into the structured field
+	HTTPReceiverFormatLEEF HTTPReceiverFormat = "leef"
 )
 
 // HTTPReceiver receives encoded logs as a HTTP endpoint.
@@ -302,3 +488,31 @@ type HTTPReceiver struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Data Format"
 	Format HTTPReceiverFormat `json:"format"`
 }
+
+// SyslogMessageFormat defines the format of the message of syslog records incoming through the syslog receiver.
+//
+// +kubebuilder:validation:Enum:=json;cef;leef
+type SyslogMessageFormat string
+
+const (
+	SyslogMessageFormatJSON SyslogMessageFormat = "json"
+	SyslogMessageFormatCEF  SyslogMessageFormat = "cef"
+	SyslogMessageFormatLEEF SyslogMessageFormat = "leef"
+)
+
+// SyslogReceiver receives logs using the syslog protocol.
+type SyslogReceiver struct {
+	// RFC is the format of incoming syslog records.  Records which do not conform to it are dropped.
+	// Records of either format are accepted when not defined
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Syslog RFC"
+	RFC SyslogRFCType `json:"rfc,omitempty"`
+
+	// MessageFormat is the format of the message of incoming syslog records.  The message is parsed into the
+	// structured field when it conforms to the format.  The message is forwarded unparsed when not defined
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Message Format"
+	MessageFormat SyslogMessageFormat `json:"messageFormat,omitempty"`
+}
@@ -84,12 +84,28 @@ type OutputSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Output Type"
 	Type OutputType `json:"type"`
 
+	// Tags are arbitrary labels used by policies to select outputs (e.g. `external`, `region-eu`).
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Output Tags"
+	Tags []string `json:"tags,omitempty"`
+
 	// TLS contains settings for controlling options on TLS client connections.
 	//
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS Options"
 	TLS *OutputTLSSpec `json:"tls,omitempty"`
 
+	// SecretProviderClass is the name of a Secrets Store CSI driver SecretProviderClass in the namespace of the forwarder.
+	//
+	// Secret references of this output that use the name of the SecretProviderClass as the secretName are read from
+	// the objects mounted by the driver instead of from a Kubernetes secret.  The key of such a reference is the file
+	// name of the mounted object.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Secret Provider Class"
+	SecretProviderClass string `json:"secretProviderClass,omitempty"`
+
 	// Limit imposes a limit in records-per-second on the total aggregate rate of logs forwarded
 	// to this output from any given collector container. The total log flow from an individual collector
 	// container to this output cannot exceed the limit.  Generally, one collector is deployed per cluster node
@@ -99,6 +115,31 @@ type OutputSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rate Limiting"
 	Limit *LimitSpec `json:"rateLimit,omitempty"`
 
+	// MaxRecordSize is the maximum size in bytes of the message of a record forwarded to the output.
+	// Larger messages are truncated and the record is marked with `truncated: true`.
+	// The value when not specified is the maxRecordSize of the collector, if any.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Record Size"
+	MaxRecordSize *resource.Quantity `json:"maxRecordSize,omitempty"`
+
+	// Paused stops the delivery of records to the output, e.g. while the destination undergoes maintenance.
+	// Records are handled according to the pausePolicy until the output is resumed.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Paused",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	Paused bool `json:"paused,omitempty"`
+
+	// PausePolicy is the handling of the records forwarded to the output while it is paused.
+	//
+	// Buffer (default) spools the records on the nodes and delivers them when the output is resumed.
+	// Drop discards the records.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pause Policy"
+	PausePolicy PausePolicy `json:"pausePolicy,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Azure Monitor"
 	AzureMonitor *AzureMonitor `json:"azureMonitor,omitempty"`
@@ -160,6 +201,26 @@ type OutputTLSSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS Security Profile"
 	TLSSecurityProfile *openshiftv1.TLSSecurityProfile `json:"securityProfile,omitempty"`
+
+	// ServerName is the name sent by the collector for Server Name Indication (SNI) and the hostname the server
+	// certificate is verified against instead of the host of the output URL.
+	//
+	// Use when the output terminates TLS behind a load balancer whose certificate does not match the connection address.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?(\.[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Server Name"
+	ServerName string `json:"serverName,omitempty"`
+
+	// CertManagerCertificate is the name of a cert-manager Certificate in the namespace of the forwarder.
+	//
+	// The operator resolves the secret issued for the certificate and uses its 'tls.crt', 'tls.key'
+	// and, when present, 'ca.crt' keys for any of certificate, key or ca that are not explicitly set.
+	// The collector is redeployed when the issued secret is renewed.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="cert-manager Certificate"
+	CertManagerCertificate string `json:"certManagerCertificate,omitempty"`
 }
 
 type URLSpec struct {
@@ -195,8 +256,92 @@ type BaseOutputTuningSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maximum Retry Duration"
 	MaxRetryDuration *time.Duration `json:"maxRetryDuration,omitempty"`
+
+	// Replay controls the delivery of the records held back while the output was unavailable once it recovers.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replay"
+	Replay *ReplaySpec `json:"replay,omitempty"`
+
+	// Concurrency controls the number of requests in flight to the output.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Concurrency"
+	Concurrency *ConcurrencySpec `json:"concurrency,omitempty"`
+}
+
+// ConcurrencyMode is the mode in which the number of requests in flight to an output is set
+//
+// +kubebuilder:validation:Enum:=Adaptive;Fixed
+type ConcurrencyMode string
+
+const (
+	// ConcurrencyModeAdaptive adapts the number of requests in flight to the responses of the output
+	ConcurrencyModeAdaptive ConcurrencyMode = "Adaptive"
+
+	// ConcurrencyModeFixed sends up to a fixed number of requests in flight
+	ConcurrencyModeFixed ConcurrencyMode = "Fixed"
+)
+
+// ConcurrencySpec defines the number of requests in flight to an output.
+//
+// In the `Adaptive` mode the collector increases the requests in flight while the response times of the output are
+// stable and decreases them when they rise or when the output rejects requests because it is rate limited or
+// overloaded (e.g. HTTP 429 or 503), so rate limits produce backoff instead of growing buffers.
+//
+// +kubebuilder:validation:XValidation:rule="self.mode != 'Fixed' || has(self.limit)",message="limit is required for the Fixed concurrency mode"
+type ConcurrencySpec struct {
+	// Mode is the mode in which the number of requests in flight is set.
+	// The value when not specified is `Adaptive`.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=Adaptive
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Mode"
+	Mode ConcurrencyMode `json:"mode,omitempty"`
+
+	// Limit is the number of requests in flight in the `Fixed` mode and the maximum in the `Adaptive` mode.
+	// The maximum in the `Adaptive` mode when not specified is 200.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Limit",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	Limit int64 `json:"limit,omitempty"`
+}
+
+// ReplaySpec bounds the replay of the records held back by the collector while an output was unavailable
+type ReplaySpec struct {
+	// MaxRequestsPerSecond is the maximum number of requests sent to the output each second. It caps the rate
+	// at which the records held back during an outage are replayed when the output recovers. The cap is not lifted
+	// once the backlog is replayed, so it must allow the steady-state rate of requests to the output.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maximum Requests per Second"
+	MaxRequestsPerSecond int64 `json:"maxRequestsPerSecond,omitempty"`
+
+	// MaxAge is the maximum age, in seconds, of the records sent to the output. Older records are dropped
+	// instead of being replayed.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maximum Age"
+	MaxAge *time.Duration `json:"maxAge,omitempty"`
 }
 
+// PausePolicy is the handling of the records forwarded to a paused output
+//
+// +kubebuilder:validation:Enum:=Buffer;Drop
+type PausePolicy string
+
+const (
+	// PausePolicyBuffer spools records on the nodes while the output is paused and delivers them when it is resumed
+	PausePolicyBuffer PausePolicy = "Buffer"
+
+	// PausePolicyDrop discards the records while the output is paused
+	PausePolicyDrop PausePolicy = "Drop"
+)
+
 // DeliveryMode sets the delivery mode for log forwarding.
 //
 // +kubebuilder:validation:Enum:=atLeastOnce;atMostOnce
@@ -526,6 +671,13 @@ type GoogleCloudLogging struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Stream ID",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	LogID string `json:"logId"`
 
+	// Resource is the monitored resource to which the log entries are attributed.
+	// The log entries are attributed to the node of the collector when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Monitored Resource"
+	Resource *GoogleCloudLoggingResource `json:"resource,omitempty"`
+
 	// Tuning specs tuning for the output
 	//
 	// +kubebuilder:validation:Optional
@@ -533,6 +685,41 @@ type GoogleCloudLogging struct {
 	Tuning *GoogleCloudLoggingTuningSpec `json:"tuning,omitempty"`
 }
 
+// GoogleCloudLoggingResourceType is the type of the monitored resource of the log entries.
+//
+// +kubebuilder:validation:Enum:=k8s_node;k8s_container
+type GoogleCloudLoggingResourceType string
+
+const (
+	// GoogleCloudLoggingResourceTypeNode attributes the log entries to the node of the collector
+	GoogleCloudLoggingResourceTypeNode GoogleCloudLoggingResourceType = "k8s_node"
+
+	// GoogleCloudLoggingResourceTypeContainer attributes the log entries to the container which wrote them
+	GoogleCloudLoggingResourceTypeContainer GoogleCloudLoggingResourceType = "k8s_container"
+)
+
+// GoogleCloudLoggingResource defines the monitored resource of the log entries.
+//
+// The labels of a `k8s_container` resource are derived from the Kubernetes metadata of the records: `namespace_name`, `pod_name`
+// and `container_name` from the metadata of the container and `cluster_name` from the ID of the cluster.
+//
+// +kubebuilder:validation:XValidation:rule="self.type != 'k8s_container' || has(self.location)",message="location is required for the k8s_container resource type"
+type GoogleCloudLoggingResource struct {
+	// Type is the type of the monitored resource.
+	// The value when not specified is `k8s_node`.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=k8s_node
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resource Type"
+	Type GoogleCloudLoggingResourceType `json:"type,omitempty"`
+
+	// Location is the zone or region of the cluster, set as the `location` label of a `k8s_container` resource.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Location",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Location string `json:"location,omitempty"`
+}
+
 type GoogleCloudLoggingID struct {
 	// Type is the ID type provided
 	// +kubebuilder:validation:Required
@@ -570,6 +757,9 @@ type HTTPTuningSpec struct {
 }
 
 // HTTP provided configuration for sending json encoded logs to a generic HTTP endpoint.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.format) || !has(self.integrity)",message="format and integrity can not both be defined"
+// +kubebuilder:validation:XValidation:rule="!has(self.format) || !has(self.envelope)",message="format and envelope can not both be defined"
 type HTTP struct {
 	URLSpec `json:",inline"`
 
@@ -604,6 +794,129 @@ type HTTP struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="HTTP Method"
 	Method string `json:"method,omitempty"`
+
+	// Format encodes records as newline delimited events of a SIEM event format instead of JSON.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="SIEM Event Format"
+	Format *SIEMFormat `json:"format,omitempty"`
+
+	// Integrity signs each record with an HMAC so consumers can verify records were not modified in transit.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Integrity"
+	Integrity *IntegritySpec `json:"integrity,omitempty"`
+
+	// Envelope is the JSON object wrapping the records of each request, with `{records}` as the placeholder of the
+	// comma separated records (e.g. `{"streams":[{records}]}`).
+	// The records are sent as a JSON array when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Envelope",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Envelope string `json:"envelope,omitempty"`
+}
+
+// IntegrityAlgorithm is the hash function of the HMAC used to sign records
+//
+// +kubebuilder:validation:Enum:=SHA-256;SHA-512
+type IntegrityAlgorithm string
+
+const (
+	IntegrityAlgorithmSHA256 IntegrityAlgorithm = "SHA-256"
+	IntegrityAlgorithmSHA512 IntegrityAlgorithm = "SHA-512"
+)
+
+// IntegritySpec defines the HMAC signature added to each record.
+//
+// The signature is the base64 encoded HMAC of the compact JSON encoding, with keys sorted, of the record without
+// its `signature` field.  It is added to the record as the `signature` field.
+type IntegritySpec struct {
+	// Algorithm is the hash function of the HMAC.
+	// The value when not specified is `SHA-256`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Algorithm"
+	Algorithm IntegrityAlgorithm `json:"algorithm,omitempty"`
+
+	// Key is the secret key with the key of the HMAC.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key"
+	Key *SecretReference `json:"key"`
+}
+
+// SIEMFormatType is the event format expected by a security information and event management (SIEM) system.
+//
+// +kubebuilder:validation:Enum:=CEF;LEEF
+type SIEMFormatType string
+
+const (
+	// SIEMFormatCEF is the Common Event Format (e.g. ArcSight)
+	SIEMFormatCEF SIEMFormatType = "CEF"
+
+	// SIEMFormatLEEF is the Log Event Extended Format version 2.0 (e.g. QRadar)
+	SIEMFormatLEEF SIEMFormatType = "LEEF"
+)
+
+// SIEMFormat defines how records are encoded as events of a SIEM event format.
+//
+// EventID, Name, Severity and the values of Fields support template syntax to allow dynamic per-event values
+// (e.g. {.kubernetes.namespace_name||"none"}).
+type SIEMFormat struct {
+	// Type of the event format
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Format Type"
+	Type SIEMFormatType `json:"type"`
+
+	// Vendor of the event header.  Defaults to "Red Hat"
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Vendor",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Vendor string `json:"vendor,omitempty"`
+
+	// Product of the event header.  Defaults to "OpenShift Logging"
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Product",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Product string `json:"product,omitempty"`
+
+	// ProductVersion of the event header.  Defaults to the version of the operator
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Product Version",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ProductVersion string `json:"productVersion,omitempty"`
+
+	// EventID of the event header (i.e. the CEF Signature ID or the LEEF Event ID).  Defaults to the log type
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Event ID",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	EventID string `json:"eventID,omitempty"`
+
+	// Name of the event header in CEF.  Defaults to the log source
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Name string `json:"name,omitempty"`
+
+	// Severity of the event, a value between 0 and 10.  Defaults to a severity mapped from the level of the record
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Severity",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Severity string `json:"severity,omitempty"`
+
+	// Fields maps the keys of the CEF extension or the LEEF attributes to the values of the event.  Defaults to the
+	// message, time and host of the record
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxProperties:=64
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fields"
+	Fields map[string]string `json:"fields,omitempty"`
 }
 
 type KafkaTuningSpec struct {
@@ -699,6 +1012,38 @@ type Kafka struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kafka Topic",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	Topic string `json:"topic,omitempty"`
 
+	// PartitionKey specifies the key used to assign records to a partition of the topic.
+	// Records with the same key are written to the same partition, preserving their order.
+	// When not specified, records are distributed across partitions.
+	//
+	// The PartitionKey can be a combination of static and dynamic values consisting of field paths followed by `||` followed by another field path or a static value.
+	//
+	// A dynamic value is encased in single curly brackets `{}` and MUST end with a static fallback value separated with `||`.
+	//
+	// Static values can only contain alphanumeric characters along with dashes, underscores, dots and forward slashes.
+	//
+	// Example:
+	//
+	//  1. {.kubernetes.namespace_name||"none"}
+	//
+	//  2. {.kubernetes.pod_uid||.hostname||"none"}
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Partition Key",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	PartitionKey string `json:"partitionKey,omitempty"`
+
+	// TopicCreation is the policy for creating topics which do not exist when logs are first written to them.
+	//
+	// When set to `deny`, the collector does not request the broker to create missing topics and records for those topics are not delivered.
+	// This is useful with templated topics to avoid creating a topic for every distinct value.
+	// Topics are only created when the brokers also allow it (`auto.create.topics.enable`).
+	// The value when not specified is `allow`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Topic Creation Policy"
+	TopicCreation KafkaTopicCreationPolicy `json:"topicCreation,omitempty"`
+
 	// Brokers specifies the list of broker endpoints of a Kafka cluster.
 	//
 	// The list represents only the initial set used by the collector's Kafka client for the
@@ -710,8 +1055,28 @@ type Kafka struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kafka Brokers"
 	Brokers []URL `json:"brokers,omitempty"`
+
+	// Integrity signs each record with an HMAC so consumers can verify records were not modified in transit.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Integrity"
+	Integrity *IntegritySpec `json:"integrity,omitempty"`
 }
 
+// KafkaTopicCreationPolicy is the policy for automatic creation of Kafka topics
+//
+// +kubebuilder:validation:Enum:=allow;deny
+type KafkaTopicCreationPolicy string
+
+const (
+	// KafkaTopicCreationAllow requests the broker to create topics which do not exist
+	KafkaTopicCreationAllow KafkaTopicCreationPolicy = "allow"
+
+	// KafkaTopicCreationDeny never requests the broker to create topics which do not exist
+	KafkaTopicCreationDeny KafkaTopicCreationPolicy = "deny"
+)
+
 // +kubebuilder:validation:XValidation:rule="isURL(self)", message="invalid URL"
 type URL string
 
@@ -797,6 +1162,33 @@ type LokiStack struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Stream Label Configuration"
 	LabelKeys *LokiStackLabelKeys `json:"labelKeys,omitempty"`
+
+	// Tenants maps inputs to custom tenants of the LokiStack.  The logs of a listed input are written to its tenant
+	// instead of the tenant of the log type of the input (application, infrastructure or audit).
+	//
+	// Custom tenants require a LokiStack with the static or dynamic tenancy mode which defines the tenants.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=inputRef
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Custom Tenants"
+	Tenants []LokiStackTenant `json:"tenants,omitempty"`
+}
+
+// LokiStackTenant maps an input to a custom tenant of a LokiStack
+type LokiStackTenant struct {
+	// InputRef is the name of the input whose logs are written to the tenant
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Input Reference",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	InputRef string `json:"inputRef"`
+
+	// Name is the name of the tenant
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tenant Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Name string `json:"name"`
 }
 
 // LokiStackLabelKeys contains the configuration that maps log record's keys to Loki labels used to identify streams.
@@ -992,6 +1384,22 @@ type Splunk struct {
 	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Index",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	Index string `json:"index,omitempty"`
+
+	// Source is the source of the events. This supports the template syntax of the Index, and can also contain colons (e.g. kube:container).
+	// The source of the events is set by Splunk when not defined.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/:])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Source string `json:"source,omitempty"`
+
+	// SourceType is the source type of the events. This supports the template syntax of the Index, and can also contain colons (e.g. kube:container).
+	// The source type of the events is set by Splunk when not defined.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.\/:])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source Type",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	SourceType string `json:"sourceType,omitempty"`
 }
 
 // SyslogRFCType sets which RFC the generated messages conform to.
@@ -1005,12 +1413,17 @@ const (
 )
 
 // Syslog provides optional extra properties for output type `syslog`
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.format) || !has(self.payloadKey)",message="format and payloadKey can not both be defined"
 type Syslog struct {
 
-	// An absolute URL, with a scheme. Valid schemes are: `tcp`, `tls`, `udp` and `udps`
+	// An absolute URL, with a scheme. Valid schemes are: `tcp`, `tls`, `udp`, `udps` and `unix`
 	// For example, to send syslog records using secure UDP:
 	//     url: udps://syslog.example.com:1234
 	//
+	// The `unix` scheme writes to a unix domain socket on the node, for example `unix:///var/run/siem/syslog.sock`.
+	// The directory of the socket, which must be below `/var/run/`, is mounted into the collector.
+	//
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:XValidation:rule="isURL(self)", message="invalid URL"
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Destination URL",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
@@ -1148,6 +1561,36 @@ type Syslog struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enrichment Type",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	Enrichment EnrichmentType `json:"enrichment,omitempty"`
+
+	// Tuning specs tuning for the connection to the syslog receiver
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tuning Options"
+	Tuning *SyslogTuningSpec `json:"tuning,omitempty"`
+
+	// Format encodes the message of syslog records as events of a SIEM event format.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="SIEM Event Format"
+	Format *SIEMFormat `json:"format,omitempty"`
+}
+
+// SyslogTuningSpec defines socket level tuning for the connection to a syslog receiver
+type SyslogTuningSpec struct {
+	// KeepAlive is the time, in seconds, a TCP connection may be idle before keepalive probes are sent.
+	// It is only supported for the `tcp` and `tls` URL schemes.
+	//
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TCP Keepalive"
+	KeepAlive *time.Duration `json:"keepAlive,omitempty"`
+
+	// SendBufferSize is the size of the socket send buffer.  The operating system default is used when not set.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Send Buffer Size"
+	SendBufferSize *resource.Quantity `json:"sendBufferSize,omitempty"`
 }
 
 // +kubebuilder:validation:Enum:=none;kubernetesMinimal
@@ -14,6 +14,61 @@ import (
 	timex "time"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AggregatorPersistentQueueSpec) DeepCopyInto(out *AggregatorPersistentQueueSpec) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AggregatorPersistentQueueSpec.
+func (in *AggregatorPersistentQueueSpec) DeepCopy() *AggregatorPersistentQueueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AggregatorPersistentQueueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AggregatorSpec) DeepCopyInto(out *AggregatorSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PersistentQueue != nil {
+		in, out := &in.PersistentQueue, &out.PersistentQueue
+		*out = new(AggregatorPersistentQueueSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AggregatorSpec.
+func (in *AggregatorSpec) DeepCopy() *AggregatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AggregatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Application) DeepCopyInto(out *Application) {
 	*out = *in
@@ -132,6 +187,16 @@ func (in *BaseOutputTuningSpec) DeepCopyInto(out *BaseOutputTuningSpec) {
 		*out = new(timex.Duration)
 		**out = **in
 	}
+	if in.Replay != nil {
+		in, out := &in.Replay, &out.Replay
+		*out = new(ReplaySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(ConcurrencySpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BaseOutputTuningSpec.
@@ -179,6 +244,46 @@ func (in *BearerTokenSecretKey) DeepCopy() *BearerTokenSecretKey {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CELFilterSpec) DeepCopyInto(out *CELFilterSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CELFilterSpec.
+func (in *CELFilterSpec) DeepCopy() *CELFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CELFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClockSkewFilterSpec) DeepCopyInto(out *ClockSkewFilterSpec) {
+	*out = *in
+	if in.MaxPast != nil {
+		in, out := &in.MaxPast, &out.MaxPast
+		*out = new(timex.Duration)
+		**out = **in
+	}
+	if in.MaxFuture != nil {
+		in, out := &in.MaxFuture, &out.MaxFuture
+		*out = new(timex.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClockSkewFilterSpec.
+func (in *ClockSkewFilterSpec) DeepCopy() *ClockSkewFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClockSkewFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cloudwatch) DeepCopyInto(out *Cloudwatch) {
 	*out = *in
@@ -374,6 +479,35 @@ func (in *ClusterLogForwarderSpec) DeepCopyInto(out *ClusterLogForwarderSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PipelineTemplates != nil {
+		in, out := &in.PipelineTemplates, &out.PipelineTemplates
+		*out = make([]PipelineTemplateSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]PolicySpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InfrastructureNamespaces != nil {
+		in, out := &in.InfrastructureNamespaces, &out.InfrastructureNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicy)
+		**out = **in
+	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(ValidationSpec)
+		**out = **in
+	}
 	out.ServiceAccount = in.ServiceAccount
 }
 
@@ -425,197 +559,569 @@ func (in *ClusterLogForwarderStatus) DeepCopyInto(out *ClusterLogForwarderStatus
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLogForwarderStatus.
-func (in *ClusterLogForwarderStatus) DeepCopy() *ClusterLogForwarderStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(ClusterLogForwarderStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CollectorSpec) DeepCopyInto(out *CollectorSpec) {
-	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(corev1.ResourceRequirements)
+	if in.Collector != nil {
+		in, out := &in.Collector, &out.Collector
+		*out = new(CollectorStatus)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]ConfigurationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]corev1.Toleration, len(*in))
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(ClusterLogForwarderSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = make([]CollectorInventory, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorSpec.
-func (in *CollectorSpec) DeepCopy() *CollectorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLogForwarderStatus.
+func (in *ClusterLogForwarderStatus) DeepCopy() *ClusterLogForwarderStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CollectorSpec)
+	out := new(ClusterLogForwarderStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerInputTuningSpec) DeepCopyInto(out *ContainerInputTuningSpec) {
+func (in *CollectorInventory) DeepCopyInto(out *CollectorInventory) {
 	*out = *in
-	if in.RateLimitPerContainer != nil {
-		in, out := &in.RateLimitPerContainer, &out.RateLimitPerContainer
-		*out = new(LimitSpec)
-		**out = **in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Transforms != nil {
+		in, out := &in.Transforms, &out.Transforms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerInputTuningSpec.
-func (in *ContainerInputTuningSpec) DeepCopy() *ContainerInputTuningSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorInventory.
+func (in *CollectorInventory) DeepCopy() *CollectorInventory {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerInputTuningSpec)
+	out := new(CollectorInventory)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DropCondition) DeepCopyInto(out *DropCondition) {
+func (in *CollectorMeshSpec) DeepCopyInto(out *CollectorMeshSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DropCondition.
-func (in *DropCondition) DeepCopy() *DropCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorMeshSpec.
+func (in *CollectorMeshSpec) DeepCopy() *CollectorMeshSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DropCondition)
+	out := new(CollectorMeshSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DropTest) DeepCopyInto(out *DropTest) {
+func (in *CollectorMetricsSpec) DeepCopyInto(out *CollectorMetricsSpec) {
 	*out = *in
-	if in.DropConditions != nil {
-		in, out := &in.DropConditions, &out.DropConditions
-		*out = make([]DropCondition, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DropTest.
-func (in *DropTest) DeepCopy() *DropTest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorMetricsSpec.
+func (in *CollectorMetricsSpec) DeepCopy() *CollectorMetricsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DropTest)
+	out := new(CollectorMetricsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Elasticsearch) DeepCopyInto(out *Elasticsearch) {
+func (in *CollectorRolloutSpec) DeepCopyInto(out *CollectorRolloutSpec) {
 	*out = *in
-	out.URLSpec = in.URLSpec
-	if in.Authentication != nil {
-		in, out := &in.Authentication, &out.Authentication
-		*out = new(HTTPAuthentication)
-		(*in).DeepCopyInto(*out)
+	if in.CanaryNodeSelector != nil {
+		in, out := &in.CanaryNodeSelector, &out.CanaryNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.Tuning != nil {
-		in, out := &in.Tuning, &out.Tuning
-		*out = new(ElasticsearchTuningSpec)
-		(*in).DeepCopyInto(*out)
+	if in.BakeTime != nil {
+		in, out := &in.BakeTime, &out.BakeTime
+		*out = new(timex.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Elasticsearch.
-func (in *Elasticsearch) DeepCopy() *Elasticsearch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorRolloutSpec.
+func (in *CollectorRolloutSpec) DeepCopy() *CollectorRolloutSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Elasticsearch)
+	out := new(CollectorRolloutSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ElasticsearchTuningSpec) DeepCopyInto(out *ElasticsearchTuningSpec) {
+func (in *CollectorShardSpec) DeepCopyInto(out *CollectorShardSpec) {
 	*out = *in
-	in.BaseOutputTuningSpec.DeepCopyInto(&out.BaseOutputTuningSpec)
+	if in.InputTypes != nil {
+		in, out := &in.InputTypes, &out.InputTypes
+		*out = make([]InputType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchTuningSpec.
-func (in *ElasticsearchTuningSpec) DeepCopy() *ElasticsearchTuningSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorShardSpec.
+func (in *CollectorShardSpec) DeepCopy() *CollectorShardSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ElasticsearchTuningSpec)
+	out := new(CollectorShardSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
+func (in *CollectorSpec) DeepCopyInto(out *CollectorSpec) {
 	*out = *in
-	if in.KubeAPIAudit != nil {
-		in, out := &in.KubeAPIAudit, &out.KubeAPIAudit
-		*out = new(KubeAPIAudit)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.DropTestsSpec != nil {
-		in, out := &in.DropTestsSpec, &out.DropTestsSpec
-		*out = make([]DropTest, len(*in))
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.PruneFilterSpec != nil {
-		in, out := &in.PruneFilterSpec, &out.PruneFilterSpec
-		*out = new(PruneFilterSpec)
+	if in.VerticalPodAutoscaler != nil {
+		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
+		*out = new(VerticalPodAutoscalerSpec)
+		**out = **in
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(CollectorRolloutSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.OpenShiftLabels != nil {
-		in, out := &in.OpenShiftLabels, &out.OpenShiftLabels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]NetworkAttachment, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxRecordSize != nil {
+		in, out := &in.MaxRecordSize, &out.MaxRecordSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Shards != nil {
+		in, out := &in.Shards, &out.Shards
+		*out = make([]CollectorShardSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Aggregator != nil {
+		in, out := &in.Aggregator, &out.Aggregator
+		*out = new(AggregatorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mesh != nil {
+		in, out := &in.Mesh, &out.Mesh
+		*out = new(CollectorMeshSpec)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(CollectorMetricsSpec)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterSpec.
-func (in *FilterSpec) DeepCopy() *FilterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorSpec.
+func (in *CollectorSpec) DeepCopy() *CollectorSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FilterSpec)
+	out := new(CollectorSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GoogleCloudLogging) DeepCopyInto(out *GoogleCloudLogging) {
+func (in *CollectorStatus) DeepCopyInto(out *CollectorStatus) {
 	*out = *in
-	if in.Authentication != nil {
+	if in.RecommendedResources != nil {
+		in, out := &in.RecommendedResources, &out.RecommendedResources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastObservedTime != nil {
+		in, out := &in.LastObservedTime, &out.LastObservedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorStatus.
+func (in *CollectorStatus) DeepCopy() *CollectorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Composite) DeepCopyInto(out *Composite) {
+	*out = *in
+	if in.InputRefs != nil {
+		in, out := &in.InputRefs, &out.InputRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Composite.
+func (in *Composite) DeepCopy() *Composite {
+	if in == nil {
+		return nil
+	}
+	out := new(Composite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConcurrencySpec) DeepCopyInto(out *ConcurrencySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConcurrencySpec.
+func (in *ConcurrencySpec) DeepCopy() *ConcurrencySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConcurrencySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationRecord) DeepCopyInto(out *ConfigurationRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationRecord.
+func (in *ConfigurationRecord) DeepCopy() *ConfigurationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerInputTuningSpec) DeepCopyInto(out *ContainerInputTuningSpec) {
+	*out = *in
+	if in.RateLimitPerContainer != nil {
+		in, out := &in.RateLimitPerContainer, &out.RateLimitPerContainer
+		*out = new(LimitSpec)
+		**out = **in
+	}
+	if in.PartialLines != nil {
+		in, out := &in.PartialLines, &out.PartialLines
+		*out = new(PartialLinesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxReadBytes != nil {
+		in, out := &in.MaxReadBytes, &out.MaxReadBytes
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.RotateWait != nil {
+		in, out := &in.RotateWait, &out.RotateWait
+		*out = new(timex.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerInputTuningSpec.
+func (in *ContainerInputTuningSpec) DeepCopy() *ContainerInputTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerInputTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DropCondition) DeepCopyInto(out *DropCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DropCondition.
+func (in *DropCondition) DeepCopy() *DropCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(DropCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DropTest) DeepCopyInto(out *DropTest) {
+	*out = *in
+	if in.DropConditions != nil {
+		in, out := &in.DropConditions, &out.DropConditions
+		*out = make([]DropCondition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DropTest.
+func (in *DropTest) DeepCopy() *DropTest {
+	if in == nil {
+		return nil
+	}
+	out := new(DropTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Elasticsearch) DeepCopyInto(out *Elasticsearch) {
+	*out = *in
+	out.URLSpec = in.URLSpec
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(HTTPAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(ElasticsearchTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Elasticsearch.
+func (in *Elasticsearch) DeepCopy() *Elasticsearch {
+	if in == nil {
+		return nil
+	}
+	out := new(Elasticsearch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchTuningSpec) DeepCopyInto(out *ElasticsearchTuningSpec) {
+	*out = *in
+	in.BaseOutputTuningSpec.DeepCopyInto(&out.BaseOutputTuningSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchTuningSpec.
+func (in *ElasticsearchTuningSpec) DeepCopy() *ElasticsearchTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptFilterSpec) DeepCopyInto(out *EncryptFilterSpec) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptFilterSpec.
+func (in *EncryptFilterSpec) DeepCopy() *EncryptFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
+	*out = *in
+	if in.KubeAPIAudit != nil {
+		in, out := &in.KubeAPIAudit, &out.KubeAPIAudit
+		*out = new(KubeAPIAudit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DropTestsSpec != nil {
+		in, out := &in.DropTestsSpec, &out.DropTestsSpec
+		*out = make([]DropTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PruneFilterSpec != nil {
+		in, out := &in.PruneFilterSpec, &out.PruneFilterSpec
+		*out = new(PruneFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenShiftLabels != nil {
+		in, out := &in.OpenShiftLabels, &out.OpenShiftLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(ScheduleFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Encrypt != nil {
+		in, out := &in.Encrypt, &out.Encrypt
+		*out = new(EncryptFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sanitize != nil {
+		in, out := &in.Sanitize, &out.Sanitize
+		*out = new(SanitizeFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = new(TimestampFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClockSkew != nil {
+		in, out := &in.ClockSkew, &out.ClockSkew
+		*out = new(ClockSkewFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogMetrics != nil {
+		in, out := &in.LogMetrics, &out.LogMetrics
+		*out = new(LogMetricsFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceParsers != nil {
+		in, out := &in.NamespaceParsers, &out.NamespaceParsers
+		*out = new(NamespaceParsersFilterSpec)
+		**out = **in
+	}
+	if in.Grok != nil {
+		in, out := &in.Grok, &out.Grok
+		*out = new(GrokFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CEL != nil {
+		in, out := &in.CEL, &out.CEL
+		*out = new(CELFilterSpec)
+		**out = **in
+	}
+	if in.VRL != nil {
+		in, out := &in.VRL, &out.VRL
+		*out = new(VRLFilterSpec)
+		**out = **in
+	}
+	if in.Rollup != nil {
+		in, out := &in.Rollup, &out.Rollup
+		*out = new(RollupFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redact != nil {
+		in, out := &in.Redact, &out.Redact
+		*out = new(RedactFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterSpec.
+func (in *FilterSpec) DeepCopy() *FilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudLogging) DeepCopyInto(out *GoogleCloudLogging) {
+	*out = *in
+	if in.Authentication != nil {
 		in, out := &in.Authentication, &out.Authentication
 		*out = new(GoogleCloudLoggingAuthentication)
 		(*in).DeepCopyInto(*out)
 	}
 	out.ID = in.ID
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(GoogleCloudLoggingResource)
+		**out = **in
+	}
 	if in.Tuning != nil {
 		in, out := &in.Tuning, &out.Tuning
 		*out = new(GoogleCloudLoggingTuningSpec)
@@ -668,6 +1174,21 @@ func (in *GoogleCloudLoggingID) DeepCopy() *GoogleCloudLoggingID {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudLoggingResource) DeepCopyInto(out *GoogleCloudLoggingResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudLoggingResource.
+func (in *GoogleCloudLoggingResource) DeepCopy() *GoogleCloudLoggingResource {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudLoggingResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GoogleCloudLoggingTuningSpec) DeepCopyInto(out *GoogleCloudLoggingTuningSpec) {
 	*out = *in
@@ -684,6 +1205,33 @@ func (in *GoogleCloudLoggingTuningSpec) DeepCopy() *GoogleCloudLoggingTuningSpec
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrokFilterSpec) DeepCopyInto(out *GrokFilterSpec) {
+	*out = *in
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomPatterns != nil {
+		in, out := &in.CustomPatterns, &out.CustomPatterns
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrokFilterSpec.
+func (in *GrokFilterSpec) DeepCopy() *GrokFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrokFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTP) DeepCopyInto(out *HTTP) {
 	*out = *in
@@ -705,6 +1253,16 @@ func (in *HTTP) DeepCopyInto(out *HTTP) {
 			(*out)[key] = val
 		}
 	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(SIEMFormat)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Integrity != nil {
+		in, out := &in.Integrity, &out.Integrity
+		*out = new(IntegritySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTP.
@@ -821,6 +1379,11 @@ func (in *InputSpec) DeepCopyInto(out *InputSpec) {
 		*out = new(ReceiverSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Composite != nil {
+		in, out := &in.Composite, &out.Composite
+		*out = new(Composite)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputSpec.
@@ -868,6 +1431,26 @@ func (in *InputTLSSpec) DeepCopy() *InputTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegritySpec) DeepCopyInto(out *IntegritySpec) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegritySpec.
+func (in *IntegritySpec) DeepCopy() *IntegritySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegritySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kafka) DeepCopyInto(out *Kafka) {
 	*out = *in
@@ -886,6 +1469,11 @@ func (in *Kafka) DeepCopyInto(out *Kafka) {
 		*out = make([]URL, len(*in))
 		copy(*out, *in)
 	}
+	if in.Integrity != nil {
+		in, out := &in.Integrity, &out.Integrity
+		*out = new(IntegritySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafka.
@@ -989,6 +1577,41 @@ func (in *LimitSpec) DeepCopy() *LimitSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogMetric) DeepCopyInto(out *LogMetric) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogMetric.
+func (in *LogMetric) DeepCopy() *LogMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(LogMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogMetricsFilterSpec) DeepCopyInto(out *LogMetricsFilterSpec) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]LogMetric, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogMetricsFilterSpec.
+func (in *LogMetricsFilterSpec) DeepCopy() *LogMetricsFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogMetricsFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Loki) DeepCopyInto(out *Loki) {
 	*out = *in
@@ -1039,6 +1662,11 @@ func (in *LokiStack) DeepCopyInto(out *LokiStack) {
 		*out = new(LokiStackLabelKeys)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tenants != nil {
+		in, out := &in.Tenants, &out.Tenants
+		*out = make([]LokiStackTenant, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStack.
@@ -1121,6 +1749,21 @@ func (in *LokiStackTarget) DeepCopy() *LokiStackTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiStackTenant) DeepCopyInto(out *LokiStackTenant) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackTenant.
+func (in *LokiStackTenant) DeepCopy() *LokiStackTenant {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiStackTenant)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LokiStackTenantLabelKeys) DeepCopyInto(out *LokiStackTenantLabelKeys) {
 	*out = *in
@@ -1172,6 +1815,66 @@ func (in *NamespaceContainerSpec) DeepCopy() *NamespaceContainerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceParsersFilterSpec) DeepCopyInto(out *NamespaceParsersFilterSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceParsersFilterSpec.
+func (in *NamespaceParsersFilterSpec) DeepCopy() *NamespaceParsersFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceParsersFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTimeZone) DeepCopyInto(out *NamespaceTimeZone) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTimeZone.
+func (in *NamespaceTimeZone) DeepCopy() *NamespaceTimeZone {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTimeZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkAttachment) DeepCopyInto(out *NetworkAttachment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkAttachment.
+func (in *NetworkAttachment) DeepCopy() *NetworkAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicy) DeepCopyInto(out *NetworkPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicy.
+func (in *NetworkPolicy) DeepCopy() *NetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OTLP) DeepCopyInto(out *OTLP) {
 	*out = *in
@@ -1216,6 +1919,11 @@ func (in *OTLPTuningSpec) DeepCopy() *OTLPTuningSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(OutputTLSSpec)
@@ -1226,6 +1934,11 @@ func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 		*out = new(LimitSpec)
 		**out = **in
 	}
+	if in.MaxRecordSize != nil {
+		in, out := &in.MaxRecordSize, &out.MaxRecordSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	if in.AzureMonitor != nil {
 		in, out := &in.AzureMonitor, &out.AzureMonitor
 		*out = new(AzureMonitor)
@@ -1251,145 +1964,381 @@ func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 		*out = new(HTTP)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Kafka != nil {
-		in, out := &in.Kafka, &out.Kafka
-		*out = new(Kafka)
-		(*in).DeepCopyInto(*out)
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(Kafka)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(Loki)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LokiStack != nil {
+		in, out := &in.LokiStack, &out.LokiStack
+		*out = new(LokiStack)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Splunk != nil {
+		in, out := &in.Splunk, &out.Splunk
+		*out = new(Splunk)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(Syslog)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLP)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputSpec.
+func (in *OutputSpec) DeepCopy() *OutputSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputTLSSpec) DeepCopyInto(out *OutputTLSSpec) {
+	*out = *in
+	in.TLSSpec.DeepCopyInto(&out.TLSSpec)
+	if in.TLSSecurityProfile != nil {
+		in, out := &in.TLSSecurityProfile, &out.TLSSecurityProfile
+		*out = new(configv1.TLSSecurityProfile)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputTLSSpec.
+func (in *OutputTLSSpec) DeepCopy() *OutputTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PartialLinesSpec) DeepCopyInto(out *PartialLinesSpec) {
+	*out = *in
+	if in.MaxMergedSize != nil {
+		in, out := &in.MaxMergedSize, &out.MaxMergedSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PartialLinesSpec.
+func (in *PartialLinesSpec) DeepCopy() *PartialLinesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PartialLinesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
+	*out = *in
+	if in.InputRefs != nil {
+		in, out := &in.InputRefs, &out.InputRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutputRefs != nil {
+		in, out := &in.OutputRefs, &out.OutputRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterRefs != nil {
+		in, out := &in.FilterRefs, &out.FilterRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSpec.
+func (in *PipelineSpec) DeepCopy() *PipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineTemplateSpec) DeepCopyInto(out *PipelineTemplateSpec) {
+	*out = *in
+	if in.OutputRefs != nil {
+		in, out := &in.OutputRefs, &out.OutputRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterRefs != nil {
+		in, out := &in.FilterRefs, &out.FilterRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineTemplateSpec.
+func (in *PipelineTemplateSpec) DeepCopy() *PipelineTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySources) DeepCopyInto(out *PolicySources) {
+	*out = *in
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]InputType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySources.
+func (in *PolicySources) DeepCopy() *PolicySources {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
+	*out = *in
+	in.Sources.DeepCopyInto(&out.Sources)
+	if in.DenyOutputTags != nil {
+		in, out := &in.DenyOutputTags, &out.DenyOutputTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySpec.
+func (in *PolicySpec) DeepCopy() *PolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PruneFilterSpec) DeepCopyInto(out *PruneFilterSpec) {
+	*out = *in
+	if in.In != nil {
+		in, out := &in.In, &out.In
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotIn != nil {
+		in, out := &in.NotIn, &out.NotIn
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PruneFilterSpec.
+func (in *PruneFilterSpec) DeepCopy() *PruneFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PruneFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverClientAuthSpec) DeepCopyInto(out *ReceiverClientAuthSpec) {
+	*out = *in
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(ValueReference)
+		**out = **in
+	}
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverClientAuthSpec.
+func (in *ReceiverClientAuthSpec) DeepCopy() *ReceiverClientAuthSpec {
+	if in == nil {
+		return nil
 	}
-	if in.Loki != nil {
-		in, out := &in.Loki, &out.Loki
-		*out = new(Loki)
-		(*in).DeepCopyInto(*out)
+	out := new(ReceiverClientAuthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverExposeSpec) DeepCopyInto(out *ReceiverExposeSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverExposeSpec.
+func (in *ReceiverExposeSpec) DeepCopy() *ReceiverExposeSpec {
+	if in == nil {
+		return nil
 	}
-	if in.LokiStack != nil {
-		in, out := &in.LokiStack, &out.LokiStack
-		*out = new(LokiStack)
+	out := new(ReceiverExposeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverSpec) DeepCopyInto(out *ReceiverSpec) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(InputTLSSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Splunk != nil {
-		in, out := &in.Splunk, &out.Splunk
-		*out = new(Splunk)
-		(*in).DeepCopyInto(*out)
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPReceiver)
+		**out = **in
 	}
 	if in.Syslog != nil {
 		in, out := &in.Syslog, &out.Syslog
-		*out = new(Syslog)
+		*out = new(SyslogReceiver)
 		**out = **in
 	}
-	if in.OTLP != nil {
-		in, out := &in.OTLP, &out.OTLP
-		*out = new(OTLP)
+	if in.Expose != nil {
+		in, out := &in.Expose, &out.Expose
+		*out = new(ReceiverExposeSpec)
+		**out = **in
+	}
+	if in.ClientAuth != nil {
+		in, out := &in.ClientAuth, &out.ClientAuth
+		*out = new(ReceiverClientAuthSpec)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputSpec.
-func (in *OutputSpec) DeepCopy() *OutputSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverSpec.
+func (in *ReceiverSpec) DeepCopy() *ReceiverSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OutputSpec)
+	out := new(ReceiverSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OutputTLSSpec) DeepCopyInto(out *OutputTLSSpec) {
+func (in *RedactFilterSpec) DeepCopyInto(out *RedactFilterSpec) {
 	*out = *in
-	in.TLSSpec.DeepCopyInto(&out.TLSSpec)
-	if in.TLSSecurityProfile != nil {
-		in, out := &in.TLSSecurityProfile, &out.TLSSecurityProfile
-		*out = new(configv1.TLSSecurityProfile)
-		(*in).DeepCopyInto(*out)
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputTLSSpec.
-func (in *OutputTLSSpec) DeepCopy() *OutputTLSSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedactFilterSpec.
+func (in *RedactFilterSpec) DeepCopy() *RedactFilterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OutputTLSSpec)
+	out := new(RedactFilterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
+func (in *ReplaySpec) DeepCopyInto(out *ReplaySpec) {
 	*out = *in
-	if in.InputRefs != nil {
-		in, out := &in.InputRefs, &out.InputRefs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.OutputRefs != nil {
-		in, out := &in.OutputRefs, &out.OutputRefs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.FilterRefs != nil {
-		in, out := &in.FilterRefs, &out.FilterRefs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(timex.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineSpec.
-func (in *PipelineSpec) DeepCopy() *PipelineSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplaySpec.
+func (in *ReplaySpec) DeepCopy() *ReplaySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PipelineSpec)
+	out := new(ReplaySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PruneFilterSpec) DeepCopyInto(out *PruneFilterSpec) {
+func (in *RollupFilterSpec) DeepCopyInto(out *RollupFilterSpec) {
 	*out = *in
-	if in.In != nil {
-		in, out := &in.In, &out.In
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(timex.Duration)
+		**out = **in
+	}
+	if in.GroupBy != nil {
+		in, out := &in.GroupBy, &out.GroupBy
 		*out = make([]FieldPath, len(*in))
 		copy(*out, *in)
 	}
-	if in.NotIn != nil {
-		in, out := &in.NotIn, &out.NotIn
-		*out = make([]FieldPath, len(*in))
+	if in.Sums != nil {
+		in, out := &in.Sums, &out.Sums
+		*out = make([]RollupSum, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PruneFilterSpec.
-func (in *PruneFilterSpec) DeepCopy() *PruneFilterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollupFilterSpec.
+func (in *RollupFilterSpec) DeepCopy() *RollupFilterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PruneFilterSpec)
+	out := new(RollupFilterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReceiverSpec) DeepCopyInto(out *ReceiverSpec) {
+func (in *RollupSum) DeepCopyInto(out *RollupSum) {
 	*out = *in
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(InputTLSSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.HTTP != nil {
-		in, out := &in.HTTP, &out.HTTP
-		*out = new(HTTPReceiver)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverSpec.
-func (in *ReceiverSpec) DeepCopy() *ReceiverSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollupSum.
+func (in *RollupSum) DeepCopy() *RollupSum {
 	if in == nil {
 		return nil
 	}
-	out := new(ReceiverSpec)
+	out := new(RollupSum)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1419,6 +2368,70 @@ func (in *SASLAuthentication) DeepCopy() *SASLAuthentication {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SIEMFormat) DeepCopyInto(out *SIEMFormat) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SIEMFormat.
+func (in *SIEMFormat) DeepCopy() *SIEMFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(SIEMFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SanitizeFilterSpec) DeepCopyInto(out *SanitizeFilterSpec) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SanitizeFilterSpec.
+func (in *SanitizeFilterSpec) DeepCopy() *SanitizeFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SanitizeFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleFilterSpec) DeepCopyInto(out *ScheduleFilterSpec) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]TimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleFilterSpec.
+func (in *ScheduleFilterSpec) DeepCopy() *ScheduleFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretReference) DeepCopyInto(out *SecretReference) {
 	*out = *in
@@ -1514,6 +2527,16 @@ func (in *SplunkTuningSpec) DeepCopy() *SplunkTuningSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Syslog) DeepCopyInto(out *Syslog) {
 	*out = *in
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(SyslogTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(SIEMFormat)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Syslog.
@@ -1526,6 +2549,46 @@ func (in *Syslog) DeepCopy() *Syslog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogReceiver) DeepCopyInto(out *SyslogReceiver) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogReceiver.
+func (in *SyslogReceiver) DeepCopy() *SyslogReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogTuningSpec) DeepCopyInto(out *SyslogTuningSpec) {
+	*out = *in
+	if in.KeepAlive != nil {
+		in, out := &in.KeepAlive, &out.KeepAlive
+		*out = new(timex.Duration)
+		**out = **in
+	}
+	if in.SendBufferSize != nil {
+		in, out := &in.SendBufferSize, &out.SendBufferSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogTuningSpec.
+func (in *SyslogTuningSpec) DeepCopy() *SyslogTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
 	*out = *in
@@ -1561,6 +2624,51 @@ func (in *TLSSpec) DeepCopy() *TLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindow) DeepCopyInto(out *TimeWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindow.
+func (in *TimeWindow) DeepCopy() *TimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampFilterSpec) DeepCopyInto(out *TimestampFilterSpec) {
+	*out = *in
+	if in.Formats != nil {
+		in, out := &in.Formats, &out.Formats
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceTimeZones != nil {
+		in, out := &in.NamespaceTimeZones, &out.NamespaceTimeZones
+		*out = make([]NamespaceTimeZone, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampFilterSpec.
+func (in *TimestampFilterSpec) DeepCopy() *TimestampFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *URLSpec) DeepCopyInto(out *URLSpec) {
 	*out = *in
@@ -1576,6 +2684,36 @@ func (in *URLSpec) DeepCopy() *URLSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VRLFilterSpec) DeepCopyInto(out *VRLFilterSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRLFilterSpec.
+func (in *VRLFilterSpec) DeepCopy() *VRLFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VRLFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidationSpec) DeepCopyInto(out *ValidationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationSpec.
+func (in *ValidationSpec) DeepCopy() *ValidationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValueReference) DeepCopyInto(out *ValueReference) {
 	*out = *in
@@ -1590,3 +2728,18 @@ func (in *ValueReference) DeepCopy() *ValueReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerticalPodAutoscalerSpec) DeepCopyInto(out *VerticalPodAutoscalerSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerticalPodAutoscalerSpec.
+func (in *VerticalPodAutoscalerSpec) DeepCopy() *VerticalPodAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalPodAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -22,6 +22,10 @@ import (
 
 	"github.com/openshift/cluster-logging-operator/api/logging/v1alpha1"
 	observabilityv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/collector"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/controller/autotune"
+	"github.com/openshift/cluster-logging-operator/internal/controller/backpressure"
 	observabilitycontroller "github.com/openshift/cluster-logging-operator/internal/controller/observability"
 
 	log "github.com/ViaQ/logerr/v2/log/static"
@@ -33,6 +37,7 @@ import (
 
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
@@ -164,10 +169,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		log.Error(err, "unable to create clientset")
+		os.Exit(1)
+	}
 	if err = (&observabilitycontroller.ClusterLogForwarderReconciler{
 		ForwarderContext: internalcontext.ForwarderContext{
 			Client:         mgr.GetClient(),
 			Reader:         mgr.GetAPIReader(),
+			Clientset:      clientset,
 			ClusterVersion: clusterVersion,
 			ClusterID:      clusterID,
 		},
@@ -177,6 +188,23 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = (&autotune.CollectorResourceReconciler{
+		Client: mgr.GetClient(),
+	}).SetupWithManager(mgr); err != nil {
+		log.Error(err, "unable to create controller", "controller", "observability.CollectorResourceAutoTune")
+		os.Exit(1)
+	}
+
+	if err = (&backpressure.NamespaceThrottleReconciler{
+		Client:   mgr.GetClient(),
+		Reader:   mgr.GetAPIReader(),
+		Recorder: mgr.GetEventRecorderFor(constants.ClusterLoggingOperator),
+		Scraper:  collector.PodProxyScraper{Client: clientset},
+	}).SetupWithManager(mgr); err != nil {
+		log.Error(err, "unable to create controller", "controller", "observability.NamespaceBackpressure")
+		os.Exit(1)
+	}
+
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -17,11 +17,12 @@ require (
 	github.com/pavel-v-chernykh/keystore-go/v4 v4.1.0
 	github.com/pkg/errors v0.9.1
 	github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring v0.55.1
-	github.com/prometheus/client_golang v1.18.0 // indirect
+	github.com/prometheus/client_golang v1.18.0
 	github.com/spf13/pflag v1.0.5
 	github.com/stretchr/testify v1.8.4
 	golang.org/x/net v0.24.0
 	golang.org/x/sync v0.6.0
+	golang.org/x/text v0.14.0
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 	k8s.io/api v0.29.1
 	k8s.io/apimachinery v0.29.1
@@ -33,6 +34,8 @@ require (
 require (
 	github.com/golang-collections/collections v0.0.0-20130729185459-604e922904d3
 	github.com/openshift/api v0.0.0-20240212125214-04ea3891d9cb
+	github.com/prometheus/client_model v0.5.0
+	github.com/prometheus/common v0.46.0
 	golang.org/x/sys v0.19.0
 	k8s.io/apiserver v0.29.1
 	k8s.io/utils v0.0.0-20240102154912-e7106e64919e
@@ -70,15 +73,12 @@ require (
 	github.com/nxadm/tail v1.4.8 // indirect
 	github.com/onsi/ginkgo/v2 v2.15.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/prometheus/client_model v0.5.0 // indirect
-	github.com/prometheus/common v0.46.0 // indirect
 	github.com/prometheus/procfs v0.12.0 // indirect
 	go.uber.org/multierr v1.11.0 // indirect
 	go.uber.org/zap v1.26.0 // indirect
 	golang.org/x/exp v0.0.0-20240205201215-2c58cdc269a3 // indirect
 	golang.org/x/oauth2 v0.17.0 // indirect
 	golang.org/x/term v0.19.0 // indirect
-	golang.org/x/text v0.14.0 // indirect
 	golang.org/x/time v0.5.0 // indirect
 	gomodules.xyz/jsonpatch/v2 v2.4.0 // indirect
 	google.golang.org/appengine v1.6.8 // indirect
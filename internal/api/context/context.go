@@ -4,6 +4,7 @@ import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	corev1 "k8s.io/api/core/v1"
+	clientset "k8s.io/client-go/kubernetes"
 	kubernetes "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -17,6 +18,9 @@ type ForwarderContext struct {
 	// client hits the API server directly, by-passing the controller cache
 	Reader kubernetes.Reader
 
+	// Clientset is a client for the pod proxy through which the metrics of the collector pods are scraped
+	Clientset clientset.Interface
+
 	// Forwarder is the ClusterLogForwarder to be reconciled
 	Forwarder *obs.ClusterLogForwarder
 
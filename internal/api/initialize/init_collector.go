@@ -0,0 +1,20 @@
+package initialize
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+// MigrateCollectorResources replaces the resources of the collector with those recommended in the status of the
+// forwarder when auto tuning is enabled
+func MigrateCollectorResources(spec obs.ClusterLogForwarder, options utils.Options) obs.ClusterLogForwarder {
+	if spec.Spec.Collector == nil || !spec.Spec.Collector.AutoTune {
+		return spec
+	}
+	if spec.Status.Collector == nil || spec.Status.Collector.RecommendedResources == nil {
+		return spec
+	}
+	spec.Spec.Collector = spec.Spec.Collector.DeepCopy()
+	spec.Spec.Collector.Resources = spec.Status.Collector.RecommendedResources.DeepCopy()
+	return spec
+}
@@ -0,0 +1,57 @@
+package initialize
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MigrateCollectorResources", func() {
+
+	var (
+		defined = &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+		}
+		recommended = &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+		}
+		forwarder obs.ClusterLogForwarder
+	)
+
+	BeforeEach(func() {
+		forwarder = obs.ClusterLogForwarder{
+			Spec: obs.ClusterLogForwarderSpec{
+				Collector: &obs.CollectorSpec{
+					Resources: defined,
+					AutoTune:  true,
+				},
+			},
+			Status: obs.ClusterLogForwarderStatus{
+				Collector: &obs.CollectorStatus{
+					RecommendedResources: recommended,
+				},
+			},
+		}
+	})
+
+	It("should apply the recommended resources when auto tuning is enabled", func() {
+		result := MigrateCollectorResources(forwarder, utils.Options{})
+		Expect(result.Spec.Collector.Resources).To(Equal(recommended))
+	})
+
+	It("should keep the defined resources when auto tuning is disabled", func() {
+		forwarder.Spec.Collector.AutoTune = false
+		result := MigrateCollectorResources(forwarder, utils.Options{})
+		Expect(result.Spec.Collector.Resources).To(Equal(defined))
+	})
+
+	It("should keep the defined resources when there is no recommendation", func() {
+		forwarder.Status.Collector = nil
+		result := MigrateCollectorResources(forwarder, utils.Options{})
+		Expect(result.Spec.Collector.Resources).To(Equal(defined))
+	})
+})
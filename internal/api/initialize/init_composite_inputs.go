@@ -0,0 +1,50 @@
+package initialize
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"k8s.io/utils/set"
+)
+
+// MigrateCompositeInputs replaces the references of pipelines to composite inputs with references to each of the
+// inputs that make up the composite input. Composite inputs which are missing their spec or form a cycle are not
+// resolved and are reported during validation
+func MigrateCompositeInputs(spec obs.ClusterLogForwarder, options utils.Options) obs.ClusterLogForwarder {
+	inputs := internalobs.Inputs(spec.Spec.Inputs).Map()
+	pipelines := make([]obs.PipelineSpec, len(spec.Spec.Pipelines))
+	for i, p := range spec.Spec.Pipelines {
+		refs := []string{}
+		seen := set.New[string]()
+		for _, ref := range p.InputRefs {
+			for _, resolved := range resolveInputRefs(ref, inputs, set.New[string]()) {
+				if !seen.Has(resolved) {
+					seen.Insert(resolved)
+					refs = append(refs, resolved)
+				}
+			}
+		}
+		p.InputRefs = refs
+		pipelines[i] = p
+	}
+	spec.Spec.Pipelines = pipelines
+	return spec
+}
+
+// resolveInputRefs returns the names of the inputs referenced by name, recursively resolving composite inputs
+func resolveInputRefs(name string, inputs map[string]obs.InputSpec, visiting set.Set[string]) []string {
+	input, found := inputs[name]
+	if !found || input.Type != obs.InputTypeComposite {
+		return []string{name}
+	}
+	if input.Composite == nil || visiting.Has(name) {
+		return nil
+	}
+	visiting.Insert(name)
+	defer visiting.Delete(name)
+	refs := []string{}
+	for _, ref := range input.Composite.InputRefs {
+		refs = append(refs, resolveInputRefs(ref, inputs, visiting)...)
+	}
+	return refs
+}
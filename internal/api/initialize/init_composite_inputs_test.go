@@ -0,0 +1,74 @@
+package initialize
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+var _ = Describe("#MigrateCompositeInputs", func() {
+
+	var (
+		forwarder obs.ClusterLogForwarder
+	)
+
+	BeforeEach(func() {
+		forwarder = obs.ClusterLogForwarder{
+			Spec: obs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{
+					{
+						Name: "a-apps",
+						Type: obs.InputTypeApplication,
+						Application: &obs.Application{
+							Includes: []obs.NamespaceContainerSpec{{Namespace: "a-*"}},
+						},
+					},
+					{
+						Name:  "kube-api",
+						Type:  obs.InputTypeAudit,
+						Audit: &obs.Audit{Sources: []obs.AuditSource{obs.AuditSourceKube}},
+					},
+					{
+						Name:      "tenant-a",
+						Type:      obs.InputTypeComposite,
+						Composite: &obs.Composite{InputRefs: []string{"a-apps", "kube-api"}},
+					},
+				},
+			},
+		}
+	})
+
+	It("should replace references to a composite input with its inputs", func() {
+		forwarder.Spec.Pipelines = []obs.PipelineSpec{
+			{Name: "pipeline", InputRefs: []string{"tenant-a"}},
+		}
+		result := MigrateCompositeInputs(forwarder, utils.NoOptions)
+		Expect(result.Spec.Pipelines[0].InputRefs).To(Equal([]string{"a-apps", "kube-api"}))
+	})
+
+	It("should resolve nested composite inputs and reserved input names only once", func() {
+		forwarder.Spec.Inputs = append(forwarder.Spec.Inputs, obs.InputSpec{
+			Name:      "everything",
+			Type:      obs.InputTypeComposite,
+			Composite: &obs.Composite{InputRefs: []string{"tenant-a", string(obs.InputTypeInfrastructure), "a-apps"}},
+		})
+		forwarder.Spec.Pipelines = []obs.PipelineSpec{
+			{Name: "pipeline", InputRefs: []string{"everything", "kube-api"}},
+		}
+		result := MigrateCompositeInputs(forwarder, utils.NoOptions)
+		Expect(result.Spec.Pipelines[0].InputRefs).To(Equal([]string{"a-apps", "kube-api", string(obs.InputTypeInfrastructure)}))
+	})
+
+	It("should not resolve composite inputs which form a cycle", func() {
+		forwarder.Spec.Inputs = append(forwarder.Spec.Inputs,
+			obs.InputSpec{Name: "x", Type: obs.InputTypeComposite, Composite: &obs.Composite{InputRefs: []string{"y", "a-apps"}}},
+			obs.InputSpec{Name: "y", Type: obs.InputTypeComposite, Composite: &obs.Composite{InputRefs: []string{"x"}}},
+		)
+		forwarder.Spec.Pipelines = []obs.PipelineSpec{
+			{Name: "pipeline", InputRefs: []string{"x"}},
+		}
+		result := MigrateCompositeInputs(forwarder, utils.NoOptions)
+		Expect(result.Spec.Pipelines[0].InputRefs).To(Equal([]string{"a-apps"}))
+	})
+})
@@ -28,7 +28,7 @@ func GenerateLokiOutput(outSpec obs.OutputSpec, input, tenant string) obs.Output
 		Type: obs.OutputTypeLoki,
 		Loki: &obs.Loki{
 			URLSpec: obs.URLSpec{
-				URL: lokiStackURL(outSpec.LokiStack, tenant),
+				URL: lokiStackURL(outSpec.LokiStack, input, tenant),
 			},
 			Authentication: &obs.HTTPAuthentication{
 				Token: outSpec.LokiStack.Authentication.Token,
@@ -41,14 +41,30 @@ func GenerateLokiOutput(outSpec obs.OutputSpec, input, tenant string) obs.Output
 	}
 }
 
-func lokiStackURL(lokiStackSpec *obs.LokiStack, tenant string) string {
+// lokiStackURL returns the URL of the tenant of an input.  The tenant is the custom tenant mapped to the input or
+// else the log type of the input
+func lokiStackURL(lokiStackSpec *obs.LokiStack, input, logType string) string {
 	service := lokiStackGatewayService(lokiStackSpec.Target.Name)
-	if !internalobs.ReservedInputTypes.Has(tenant) {
-		return ""
+	tenant := lokiStackCustomTenant(lokiStackSpec, input)
+	if tenant == "" {
+		if !internalobs.ReservedInputTypes.Has(logType) {
+			return ""
+		}
+		tenant = logType
 	}
 	return fmt.Sprintf("https://%s.%s.svc:8080/api/logs/v1/%s", service, lokiStackSpec.Target.Namespace, tenant)
 }
 
+// lokiStackCustomTenant returns the custom tenant mapped to an input or an empty string
+func lokiStackCustomTenant(lokiStackSpec *obs.LokiStack, input string) string {
+	for _, t := range lokiStackSpec.Tenants {
+		if t.InputRef == input {
+			return t.Name
+		}
+	}
+	return ""
+}
+
 func lokiStackGatewayService(lokiStackServiceName string) string {
 	return fmt.Sprintf("%s-gateway-http", lokiStackServiceName)
 }
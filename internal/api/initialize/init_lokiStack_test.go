@@ -94,6 +94,57 @@ var _ = Describe("MigrateLokiStack", func() {
 				}
 			},
 		),
+		Entry("named input mapped to a custom tenant",
+			obs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{
+					{
+						Name:        "team-a",
+						Type:        obs.InputTypeApplication,
+						Application: &obs.Application{Includes: []obs.NamespaceContainerSpec{{Namespace: "team-a"}}},
+					},
+				},
+				Pipelines: []obs.PipelineSpec{
+					{
+						Name:       lokistackPipeline,
+						InputRefs:  []string{"team-a"},
+						OutputRefs: []string{lokistackOut + "-team-a"},
+					},
+				},
+				Outputs: []obs.OutputSpec{
+					{
+						Name: lokistackOut + "-team-a",
+						Type: obs.OutputTypeLoki,
+						Loki: &obs.Loki{
+							URLSpec: obs.URLSpec{
+								URL: "https://test-lokistack-gateway-http.openshift-logging.svc:8080/api/logs/v1/team-a-logs",
+							},
+							Authentication: &obs.HTTPAuthentication{
+								Token: &obs.BearerToken{
+									From: obs.BearerTokenFromServiceAccount,
+								},
+							},
+						},
+					},
+				},
+			},
+			func(spec *obs.ClusterLogForwarderSpec) {
+				spec.Inputs = []obs.InputSpec{
+					{
+						Name:        "team-a",
+						Type:        obs.InputTypeApplication,
+						Application: &obs.Application{Includes: []obs.NamespaceContainerSpec{{Namespace: "team-a"}}},
+					},
+				}
+				spec.Outputs[0].LokiStack.Tenants = []obs.LokiStackTenant{{InputRef: "team-a", Name: "team-a-logs"}}
+				spec.Pipelines = []obs.PipelineSpec{
+					{
+						Name:       lokistackPipeline,
+						InputRefs:  []string{"team-a"},
+						OutputRefs: []string{lokistackOut},
+					},
+				}
+			},
+		),
 		Entry("multiple tenants, single lokistack output",
 			obs.ClusterLogForwarderSpec{
 				Pipelines: []obs.PipelineSpec{
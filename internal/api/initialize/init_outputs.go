@@ -0,0 +1,23 @@
+package initialize
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+// MigrateMaxRecordSize sets the max record size of the collector on each output which does not define its own
+func MigrateMaxRecordSize(spec obs.ClusterLogForwarder, options utils.Options) obs.ClusterLogForwarder {
+	if spec.Spec.Collector == nil || spec.Spec.Collector.MaxRecordSize == nil {
+		return spec
+	}
+	outputs := make([]obs.OutputSpec, len(spec.Spec.Outputs))
+	for i, o := range spec.Spec.Outputs {
+		outputs[i] = *o.DeepCopy()
+		if outputs[i].MaxRecordSize == nil {
+			size := spec.Spec.Collector.MaxRecordSize.DeepCopy()
+			outputs[i].MaxRecordSize = &size
+		}
+	}
+	spec.Spec.Outputs = outputs
+	return spec
+}
@@ -0,0 +1,46 @@
+package initialize
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MigrateMaxRecordSize", func() {
+
+	var (
+		collectorSize = resource.MustParse("64Ki")
+		outputSize    = resource.MustParse("1Mi")
+		forwarder     obs.ClusterLogForwarder
+	)
+
+	BeforeEach(func() {
+		forwarder = obs.ClusterLogForwarder{
+			Spec: obs.ClusterLogForwarderSpec{
+				Collector: &obs.CollectorSpec{
+					MaxRecordSize: &collectorSize,
+				},
+				Outputs: []obs.OutputSpec{
+					{Name: "default"},
+					{Name: "own", MaxRecordSize: &outputSize},
+				},
+			},
+		}
+	})
+
+	It("should default the max record size of outputs to the one of the collector", func() {
+		result := MigrateMaxRecordSize(forwarder, utils.Options{})
+		Expect(result.Spec.Outputs[0].MaxRecordSize.Value()).To(BeEquivalentTo(64 * 1024))
+		Expect(result.Spec.Outputs[1].MaxRecordSize.Value()).To(BeEquivalentTo(1024 * 1024))
+		Expect(forwarder.Spec.Outputs[0].MaxRecordSize).To(BeNil(), "should not modify the original spec")
+	})
+
+	It("should not modify outputs when the collector does not define a max record size", func() {
+		forwarder.Spec.Collector = nil
+		result := MigrateMaxRecordSize(forwarder, utils.Options{})
+		Expect(result.Spec.Outputs[0].MaxRecordSize).To(BeNil())
+	})
+})
@@ -0,0 +1,42 @@
+package initialize
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"k8s.io/utils/set"
+)
+
+// MigratePipelineTemplates expands the template referenced by each pipeline by prepending the filters of the
+// template to the filters of the pipeline and adding the outputs of the template to the outputs of the pipeline.
+// Pipelines referencing an undefined template are not modified and are reported during validation
+func MigratePipelineTemplates(spec obs.ClusterLogForwarder, options utils.Options) obs.ClusterLogForwarder {
+	if len(spec.Spec.PipelineTemplates) == 0 {
+		return spec
+	}
+	templates := map[string]obs.PipelineTemplateSpec{}
+	for _, t := range spec.Spec.PipelineTemplates {
+		templates[t.Name] = t
+	}
+	pipelines := make([]obs.PipelineSpec, len(spec.Spec.Pipelines))
+	for i, p := range spec.Spec.Pipelines {
+		if template, found := templates[p.TemplateRef]; found && p.TemplateRef != "" {
+			p.FilterRefs = append(append([]string{}, template.FilterRefs...), p.FilterRefs...)
+			p.OutputRefs = appendUnique(append([]string{}, template.OutputRefs...), p.OutputRefs...)
+		}
+		pipelines[i] = p
+	}
+	spec.Spec.Pipelines = pipelines
+	return spec
+}
+
+// appendUnique appends the values which are not already in the slice
+func appendUnique(values []string, add ...string) []string {
+	seen := set.New[string](values...)
+	for _, v := range add {
+		if !seen.Has(v) {
+			seen.Insert(v)
+			values = append(values, v)
+		}
+	}
+	return values
+}
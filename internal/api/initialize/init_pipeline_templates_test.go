@@ -0,0 +1,62 @@
+package initialize
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+var _ = Describe("#MigratePipelineTemplates", func() {
+
+	var (
+		forwarder obs.ClusterLogForwarder
+	)
+
+	BeforeEach(func() {
+		forwarder = obs.ClusterLogForwarder{
+			Spec: obs.ClusterLogForwarderSpec{
+				PipelineTemplates: []obs.PipelineTemplateSpec{
+					{
+						Name:       "compliant",
+						FilterRefs: []string{"redact"},
+						OutputRefs: []string{"archive"},
+					},
+				},
+			},
+		}
+	})
+
+	It("should apply the filters and outputs of the referenced template", func() {
+		forwarder.Spec.Pipelines = []obs.PipelineSpec{
+			{Name: "pipeline", InputRefs: []string{"application"}, TemplateRef: "compliant"},
+		}
+		result := MigratePipelineTemplates(forwarder, utils.NoOptions)
+		Expect(result.Spec.Pipelines[0].FilterRefs).To(Equal([]string{"redact"}))
+		Expect(result.Spec.Pipelines[0].OutputRefs).To(Equal([]string{"archive"}))
+	})
+
+	It("should apply the filters of the template before the filters of the pipeline and merge the outputs", func() {
+		forwarder.Spec.Pipelines = []obs.PipelineSpec{
+			{
+				Name:        "pipeline",
+				InputRefs:   []string{"application"},
+				FilterRefs:  []string{"parse"},
+				OutputRefs:  []string{"es", "archive"},
+				TemplateRef: "compliant",
+			},
+		}
+		result := MigratePipelineTemplates(forwarder, utils.NoOptions)
+		Expect(result.Spec.Pipelines[0].FilterRefs).To(Equal([]string{"redact", "parse"}))
+		Expect(result.Spec.Pipelines[0].OutputRefs).To(Equal([]string{"archive", "es"}))
+		Expect(forwarder.Spec.Pipelines[0].FilterRefs).To(Equal([]string{"parse"}), "exp the original spec to not be modified")
+	})
+
+	It("should not modify pipelines which reference an undefined template", func() {
+		forwarder.Spec.Pipelines = []obs.PipelineSpec{
+			{Name: "pipeline", InputRefs: []string{"application"}, TemplateRef: "missing"},
+		}
+		result := MigratePipelineTemplates(forwarder, utils.NoOptions)
+		Expect(result.Spec.Pipelines[0].OutputRefs).To(BeEmpty())
+	})
+})
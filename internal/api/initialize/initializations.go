@@ -14,8 +14,12 @@ const (
 
 // clfInitializers are the set of rules for initializing the ClusterLogForwarder spec
 var clfInitializers = []func(spec obs.ClusterLogForwarder, migrateContext utils.Options) obs.ClusterLogForwarder{
+	MigrateCompositeInputs,
+	MigratePipelineTemplates,
 	MigrateLokiStack,
 	MigrateInputs,
+	MigrateCollectorResources,
+	MigrateMaxRecordSize,
 }
 
 // ClusterLogForwarder initializes the forwarder for fields that must be set and are inferred from settings already defined.
@@ -97,7 +97,7 @@ func getInputTypeFromName(spec obs.ClusterLogForwarderSpec, inputName string) st
 			if input.Application != nil {
 				return string(obs.InputTypeApplication)
 			}
-			if input.Infrastructure != nil || input.Receiver.Type == obs.ReceiverTypeSyslog {
+			if input.Infrastructure != nil || input.Receiver.Type == obs.ReceiverTypeSyslog || (input.Receiver.Type == obs.ReceiverTypeHTTP && input.Receiver.HTTP != nil && input.Receiver.HTTP.Format != obs.HTTPReceiverFormatKubeAPIAudit) {
 				return string(obs.InputTypeInfrastructure)
 			}
 			if input.Audit != nil || (input.Receiver.Type == obs.ReceiverTypeHTTP && input.Receiver.HTTP != nil && input.Receiver.HTTP.Format == obs.HTTPReceiverFormatKubeAPIAudit) {
@@ -1,6 +1,9 @@
 package observability
 
-import obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"k8s.io/utils/set"
+)
 
 // FilterMap returns a map of filter names to FilterSpec.
 func FilterMap(spec obs.ClusterLogForwarderSpec) map[string]*obs.FilterSpec {
@@ -20,3 +23,25 @@ func (filters Filters) Names() (names []string) {
 	}
 	return names
 }
+
+// SecretNames returns a unique set of unordered secret names
+func (filters Filters) SecretNames() []string {
+	secrets := set.New[string]()
+	for _, f := range filters {
+		if f.Type == obs.FilterTypeEncrypt && f.Encrypt != nil && f.Encrypt.Key != nil {
+			secrets.Insert(f.Encrypt.Key.SecretName)
+		}
+	}
+	return secrets.UnsortedList()
+}
+
+// ConfigmapNames returns a unique set of unordered configmap names
+func (filters Filters) ConfigmapNames() []string {
+	configMaps := set.New[string]()
+	for _, f := range filters {
+		if f.Type == obs.FilterTypeNamespaceParsers && f.NamespaceParsers != nil {
+			configMaps.Insert(f.NamespaceParsers.ConfigMapName)
+		}
+	}
+	return configMaps.UnsortedList()
+}
@@ -4,6 +4,7 @@ import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/set"
 	"strings"
 )
 
@@ -34,16 +35,87 @@ func IsValid(forwarder obs.ClusterLogForwarder) bool {
 }
 
 func isValid(prefix string, conditions []metav1.Condition, expConditions int) bool {
-	if len(conditions) != expConditions {
-		return false
-	}
+	validations := 0
 	conditionTrue := 0
 	for _, cond := range conditions {
-		if strings.HasPrefix(cond.Type, prefix) && cond.Status == obs.ConditionTrue {
+		if !strings.HasPrefix(cond.Type, prefix) {
+			continue
+		}
+		validations++
+		if cond.Status == obs.ConditionTrue {
 			conditionTrue++
 		}
 	}
-	return conditionTrue == expConditions
+	return validations == expConditions && conditionTrue == expConditions
+}
+
+// PruneInvalid returns the spec of the forwarder without the inputs, outputs, filters and pipelines whose validation
+// failed, and the names of the pipelines which are removed.  References to invalid inputs and outputs are removed from
+// the pipelines.  Pipelines which reference an invalid filter, or which are left without inputs or outputs, are removed
+func PruneInvalid(forwarder obs.ClusterLogForwarder) (obs.ClusterLogForwarderSpec, []string) {
+	status := forwarder.Status
+	spec := *forwarder.Spec.DeepCopy()
+	validInputs := validNames(obs.ConditionTypeValidInputPrefix, status.Inputs)
+	validOutputs := validNames(obs.ConditionTypeValidOutputPrefix, status.Outputs)
+	validFilters := validNames(obs.ConditionTypeValidFilterPrefix, status.Filters)
+	validPipelines := validNames(obs.ConditionTypeValidPipelinePrefix, status.Pipelines)
+
+	var inputs []obs.InputSpec
+	for _, i := range spec.Inputs {
+		if validInputs.Has(i.Name) {
+			inputs = append(inputs, i)
+		}
+	}
+	var outputs []obs.OutputSpec
+	for _, o := range spec.Outputs {
+		if validOutputs.Has(o.Name) {
+			outputs = append(outputs, o)
+		}
+	}
+	var filters []obs.FilterSpec
+	for _, f := range spec.Filters {
+		if validFilters.Has(f.Name) {
+			filters = append(filters, f)
+		}
+	}
+	var pipelines []obs.PipelineSpec
+	var removed []string
+	for _, p := range spec.Pipelines {
+		p.InputRefs = intersect(p.InputRefs, validInputs)
+		p.OutputRefs = intersect(p.OutputRefs, validOutputs)
+		if !validPipelines.Has(p.Name) || len(p.InputRefs) == 0 || len(p.OutputRefs) == 0 || len(intersect(p.FilterRefs, validFilters)) != len(p.FilterRefs) {
+			removed = append(removed, p.Name)
+			continue
+		}
+		pipelines = append(pipelines, p)
+	}
+	spec.Inputs = inputs
+	spec.Outputs = outputs
+	spec.Filters = filters
+	spec.Pipelines = pipelines
+	return spec, removed
+}
+
+// validNames returns the names of the items whose validation condition is true
+func validNames(prefix string, conditions []metav1.Condition) set.Set[string] {
+	names := set.New[string]()
+	for _, cond := range conditions {
+		if strings.HasPrefix(cond.Type, prefix+"-") && cond.Status == obs.ConditionTrue {
+			names.Insert(strings.TrimPrefix(cond.Type, prefix+"-"))
+		}
+	}
+	return names
+}
+
+// intersect returns the names which are in the set, preserving their order
+func intersect(names []string, valid set.Set[string]) []string {
+	var result []string
+	for _, name := range names {
+		if valid.Has(name) {
+			result = append(result, name)
+		}
+	}
+	return result
 }
 
 func isAuthorized(conditions []metav1.Condition) bool {
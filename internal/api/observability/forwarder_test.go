@@ -54,6 +54,11 @@ var _ = Describe("[internal][api][observability]", func() {
 			}
 			Expect(IsValid(forwarder)).To(BeFalse())
 		})
+		It("should ignore conditions of outputs other than their validation", func() {
+			forwarder.Status.Outputs = append(forwarder.Status.Outputs,
+				NewConditionFromPrefix(obs.ConditionTypePausedOutputPrefix, "foo", false, obs.ReasonOutputResumed, ""))
+			Expect(IsValid(forwarder)).To(BeTrue())
+		})
 		It("should be false when outputs are invalid", func() {
 			forwarder.Status.Conditions = []metav1.Condition{
 				NewConditionFromPrefix(obs.ConditionTypeValidOutputPrefix, "foo", false, "", ""),
@@ -80,6 +85,55 @@ var _ = Describe("[internal][api][observability]", func() {
 		})
 	})
 
+	Context("#PruneInvalid", func() {
+
+		It("should remove the invalid items and the pipelines which depend on them", func() {
+			forwarder := obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					Inputs:  []obs.InputSpec{{Name: "app"}, {Name: "bad-input"}},
+					Outputs: []obs.OutputSpec{{Name: "es"}, {Name: "bad-output"}},
+					Filters: []obs.FilterSpec{{Name: "prune"}, {Name: "bad-filter"}},
+					Pipelines: []obs.PipelineSpec{
+						{Name: "mixed", InputRefs: []string{"app", "bad-input"}, OutputRefs: []string{"es", "bad-output"}, FilterRefs: []string{"prune"}},
+						{Name: "filtered", InputRefs: []string{"app"}, OutputRefs: []string{"es"}, FilterRefs: []string{"bad-filter"}},
+						{Name: "no-outputs", InputRefs: []string{"app"}, OutputRefs: []string{"bad-output"}},
+						{Name: "invalid", InputRefs: []string{"app"}, OutputRefs: []string{"es"}},
+					},
+				},
+				Status: obs.ClusterLogForwarderStatus{
+					Inputs: []metav1.Condition{
+						NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, "app", true, "", ""),
+						NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, "bad-input", false, "", ""),
+					},
+					Outputs: []metav1.Condition{
+						NewConditionFromPrefix(obs.ConditionTypeValidOutputPrefix, "es", true, "", ""),
+						NewConditionFromPrefix(obs.ConditionTypeValidOutputPrefix, "bad-output", false, "", ""),
+						NewConditionFromPrefix(obs.ConditionTypePausedOutputPrefix, "es", false, "", ""),
+					},
+					Filters: []metav1.Condition{
+						NewConditionFromPrefix(obs.ConditionTypeValidFilterPrefix, "prune", true, "", ""),
+						NewConditionFromPrefix(obs.ConditionTypeValidFilterPrefix, "bad-filter", false, "", ""),
+					},
+					Pipelines: []metav1.Condition{
+						NewConditionFromPrefix(obs.ConditionTypeValidPipelinePrefix, "mixed", true, "", ""),
+						NewConditionFromPrefix(obs.ConditionTypeValidPipelinePrefix, "filtered", true, "", ""),
+						NewConditionFromPrefix(obs.ConditionTypeValidPipelinePrefix, "no-outputs", true, "", ""),
+						NewConditionFromPrefix(obs.ConditionTypeValidPipelinePrefix, "invalid", false, "", ""),
+					},
+				},
+			}
+			spec, removed := PruneInvalid(forwarder)
+			Expect(spec.Inputs).To(Equal([]obs.InputSpec{{Name: "app"}}))
+			Expect(spec.Outputs).To(Equal([]obs.OutputSpec{{Name: "es"}}))
+			Expect(spec.Filters).To(Equal([]obs.FilterSpec{{Name: "prune"}}))
+			Expect(spec.Pipelines).To(Equal([]obs.PipelineSpec{
+				{Name: "mixed", InputRefs: []string{"app"}, OutputRefs: []string{"es"}, FilterRefs: []string{"prune"}},
+			}))
+			Expect(removed).To(Equal([]string{"filtered", "no-outputs", "invalid"}))
+			Expect(forwarder.Spec.Pipelines).To(HaveLen(4), "Exp. the spec of the forwarder to be unchanged")
+		})
+	})
+
 	Context("#DeployAsDeployment", func() {
 		var (
 			forwarder obs.ClusterLogForwarder
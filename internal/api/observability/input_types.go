@@ -1,6 +1,8 @@
 package observability
 
 import (
+	"path"
+
 	"k8s.io/utils/set"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
@@ -13,6 +15,20 @@ var ReservedInputTypes = sets.NewString(
 	string(obs.InputTypeInfrastructure),
 )
 
+// InfrastructureNamespaces are the globs of the namespaces whose container logs are infrastructure logs.  Application
+// inputs exclude them unless they are explicitly included
+var InfrastructureNamespaces = []string{"default", "openshift*", "kube*"}
+
+// IsInfrastructureNamespace evaluates if a namespace, or namespace glob, matches one of the infrastructure namespaces
+func IsInfrastructureNamespace(namespace string) bool {
+	for _, infraNS := range InfrastructureNamespaces {
+		if matched, _ := path.Match(infraNS, namespace); matched {
+			return true
+		}
+	}
+	return false
+}
+
 func MaxRecordsPerSecond(input obs.InputSpec) (int64, bool) {
 	if input.Application != nil &&
 		input.Application.Tuning != nil &&
@@ -39,10 +55,14 @@ func (inputs Inputs) Names() (names []string) {
 	return names
 }
 
-// InputTypes returns a unique set of input types
+// InputTypes returns a unique set of input types of the logs collected by the inputs.  Composite inputs
+// only combine other inputs and are not included
 func (inputs Inputs) InputTypes() []obs.InputType {
 	types := set.New[obs.InputType]()
 	for _, i := range inputs {
+		if i.Type == obs.InputTypeComposite {
+			continue
+		}
 		types.Insert(i.Type)
 	}
 	return types.UnsortedList()
@@ -61,8 +81,8 @@ func (inputs Inputs) Map() map[string]obs.InputSpec {
 func (inputs Inputs) ConfigmapNames() []string {
 	names := set.New[string]()
 	for _, i := range inputs {
-		if i.Receiver != nil && i.Receiver.TLS != nil {
-			names.Insert(ConfigmapsForTLS(obs.TLSSpec(*i.Receiver.TLS))...)
+		if i.Receiver != nil && (i.Receiver.TLS != nil || i.Receiver.ClientAuth != nil) {
+			names.Insert(ConfigmapsForTLS(ReceiverTLS(*i.Receiver))...)
 		}
 	}
 	return names.UnsortedList()
@@ -72,13 +92,26 @@ func (inputs Inputs) ConfigmapNames() []string {
 func (inputs Inputs) SecretNames() []string {
 	secrets := set.New[string]()
 	for _, i := range inputs {
-		if i.Receiver != nil && i.Receiver.TLS != nil {
-			secrets.Insert(SecretsForTLS(obs.TLSSpec(*i.Receiver.TLS))...)
+		if i.Receiver != nil && (i.Receiver.TLS != nil || i.Receiver.ClientAuth != nil) {
+			secrets.Insert(SecretsForTLS(ReceiverTLS(*i.Receiver))...)
 		}
 	}
 	return secrets.UnsortedList()
 }
 
+// ReceiverTLS returns the TLS spec of a receiver where the CA is the one used to verify client certificates
+// when client authentication defines it
+func ReceiverTLS(receiver obs.ReceiverSpec) obs.TLSSpec {
+	tlsSpec := obs.TLSSpec{}
+	if receiver.TLS != nil {
+		tlsSpec = obs.TLSSpec(*receiver.TLS)
+	}
+	if receiver.ClientAuth != nil && receiver.ClientAuth.CA != nil {
+		tlsSpec.CA = receiver.ClientAuth.CA
+	}
+	return tlsSpec
+}
+
 func (inputs Inputs) HasJournalSource() bool {
 	for _, i := range inputs {
 		if i.Type == obs.InputTypeInfrastructure && i.Infrastructure != nil && (len(i.Infrastructure.Sources) == 0 || set.New(i.Infrastructure.Sources...).Has(obs.InfrastructureSourceNode)) {
@@ -93,7 +126,7 @@ func (inputs Inputs) HasContainerSource() bool {
 		if i.Type == obs.InputTypeApplication {
 			return true
 		}
-		if i.Type == obs.InputTypeInfrastructure && i.Infrastructure != nil && (len(i.Infrastructure.Sources) == 0 || set.New(i.Infrastructure.Sources...).Has(obs.InfrastructureSourceContainer)) {
+		if i.Type == obs.InputTypeInfrastructure && i.Infrastructure != nil && (len(i.Infrastructure.Sources) == 0 || set.New(i.Infrastructure.Sources...).HasAny(obs.InfrastructureSourceContainer, obs.InfrastructureSourceLogging)) {
 			return true
 		}
 	}
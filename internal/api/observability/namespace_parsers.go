@@ -0,0 +1,62 @@
+package observability
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// NamespaceParserType is the type of a parser registered for the containers of a namespace
+type NamespaceParserType string
+
+const (
+	// NamespaceParserTypeRegex parses the message with a regular expression with named capture groups
+	NamespaceParserTypeRegex NamespaceParserType = "regex"
+
+	// NamespaceParserTypeGrok parses the message with a grok pattern
+	NamespaceParserTypeGrok NamespaceParserType = "grok"
+
+	// NamespaceParserTypeJSONPointer parses the message as JSON and selects the value of a JSON pointer (RFC 6901)
+	NamespaceParserTypeJSONPointer NamespaceParserType = "jsonPointer"
+)
+
+// NamespaceParser is a parser registered in the ConfigMap of a namespaceParsers filter
+type NamespaceParser struct {
+	// Name is the key of the parser in the ConfigMap
+	Name string `json:"-"`
+
+	// Namespace is the namespace of the containers using the parser
+	Namespace string `json:"namespace"`
+
+	// Type is the type of the parser
+	Type NamespaceParserType `json:"type"`
+
+	// Pattern is the regular expression, the grok pattern or the JSON pointer of the parser
+	Pattern string `json:"pattern"`
+}
+
+// NamespaceParsers returns the parsers registered in a ConfigMap ordered by name
+func NamespaceParsers(configMap *corev1.ConfigMap) (parsers []NamespaceParser, err error) {
+	for name, definition := range configMap.Data {
+		parser := NamespaceParser{}
+		if err = yaml.UnmarshalStrict([]byte(definition), &parser); err != nil {
+			return nil, fmt.Errorf("parser %q is not a valid definition: %v", name, err)
+		}
+		parser.Name = name
+		if parser.Namespace == "" || parser.Pattern == "" {
+			return nil, fmt.Errorf("parser %q must have a namespace and a pattern", name)
+		}
+		switch parser.Type {
+		case NamespaceParserTypeRegex, NamespaceParserTypeGrok, NamespaceParserTypeJSONPointer:
+		default:
+			return nil, fmt.Errorf("parser %q must have a type of %s, %s or %s", name, NamespaceParserTypeRegex, NamespaceParserTypeGrok, NamespaceParserTypeJSONPointer)
+		}
+		parsers = append(parsers, parser)
+	}
+	sort.Slice(parsers, func(i, j int) bool {
+		return parsers[i].Name < parsers[j].Name
+	})
+	return parsers, nil
+}
@@ -0,0 +1,38 @@
+package observability_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+)
+
+var _ = Describe("NamespaceParsers", func() {
+
+	It("should return the parsers of the configmap ordered by name", func() {
+		cm := runtime.NewConfigMap("openshift-logging", "parsers", map[string]string{
+			"nginx": `
+namespace: web
+type: regex
+pattern: '^(?P<client>\S+) (?P<request>.*)$'
+`,
+			"events": `{"namespace": "billing", "type": "jsonPointer", "pattern": "/event"}`,
+		})
+		Expect(internalobs.NamespaceParsers(cm)).To(Equal([]internalobs.NamespaceParser{
+			{Name: "events", Namespace: "billing", Type: internalobs.NamespaceParserTypeJSONPointer, Pattern: "/event"},
+			{Name: "nginx", Namespace: "web", Type: internalobs.NamespaceParserTypeRegex, Pattern: `^(?P<client>\S+) (?P<request>.*)$`},
+		}))
+	})
+
+	DescribeTable("should fail for invalid definitions", func(definition, errMsg string) {
+		cm := runtime.NewConfigMap("openshift-logging", "parsers", map[string]string{"myparser": definition})
+		_, err := internalobs.NamespaceParsers(cm)
+		Expect(err).To(MatchError(MatchRegexp(errMsg)))
+	},
+		Entry("with invalid YAML", "namespace: [web", `parser "myparser" is not a valid definition`),
+		Entry("with an unknown field", "{namespace: web, type: grok, pattern: x, regex: y}", `parser "myparser" is not a valid definition`),
+		Entry("without a namespace", "{type: grok, pattern: x}", "must have a namespace and a pattern"),
+		Entry("with an unknown type", "{namespace: web, type: xpath, pattern: x}", "must have a type of regex, grok or jsonPointer"),
+	)
+})
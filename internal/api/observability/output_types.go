@@ -4,10 +4,28 @@ import (
 	"fmt"
 	log "github.com/ViaQ/logerr/v2/log/static"
 	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"k8s.io/utils/set"
+	"net/url"
 	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
 )
 
+// SchemeUnix is the URL scheme of a syslog output writing to a unix domain socket on the node
+const SchemeUnix = "unix"
+
+// SocketDirPrefix is the node directory under which the directories of unix domain sockets are mounted into the collector
+const SocketDirPrefix = "/var/run/"
+
+// reservedSocketDirs are the directories under SocketDirPrefix which are already mounted into the collector
+var reservedSocketDirs = []string{
+	path.Dir(constants.CollectorSecretsDir),
+	"/var/run/secrets",
+}
+
 func OutputTypeUnknown(t obsv1.OutputType) error {
 	return fmt.Errorf("Unknown output type %q", t)
 }
@@ -83,6 +101,34 @@ func (outputs Outputs) SecretNames() []string {
 	return secrets.UnsortedList()
 }
 
+// SecretProviderClassKeys returns a map of SecretProviderClass names to the unique set of keys referenced
+// by the outputs which mount them
+func (outputs Outputs) SecretProviderClassKeys() map[string][]string {
+	classes := map[string]set.Set[string]{}
+	for _, o := range outputs {
+		if o.SecretProviderClass == "" {
+			continue
+		}
+		if _, found := classes[o.SecretProviderClass]; !found {
+			classes[o.SecretProviderClass] = set.New[string]()
+		}
+		configs := SecretReferencesAsValueReferences(o)
+		if o.TLS != nil {
+			configs = append(configs, ValueReferences(o.TLS.TLSSpec)...)
+		}
+		for _, c := range configs {
+			if c.SecretName == o.SecretProviderClass {
+				classes[o.SecretProviderClass].Insert(c.Key)
+			}
+		}
+	}
+	keys := map[string][]string{}
+	for name, s := range classes {
+		keys[name] = s.SortedList()
+	}
+	return keys
+}
+
 func SecretReferencesAsValueReferences(o obsv1.OutputSpec) (configs []*obsv1.ValueReference) {
 	for _, auth := range SecretReferences(o) {
 		if auth != nil {
@@ -118,17 +164,20 @@ func SecretReferences(o obsv1.OutputSpec) []*obsv1.SecretReference {
 			return []*obsv1.SecretReference{a.Credentials}
 		}
 	case obsv1.OutputTypeHTTP:
-		if o.HTTP != nil && o.HTTP.Authentication != nil {
-			return httpAuthKeys(o.HTTP.Authentication)
+		if o.HTTP != nil {
+			return append(httpAuthKeys(o.HTTP.Authentication), integrityKeys(o.HTTP.Integrity)...)
 		}
 	case obsv1.OutputTypeOTLP:
 		if o.OTLP != nil && o.OTLP.Authentication != nil {
 			return httpAuthKeys(o.OTLP.Authentication)
 		}
 	case obsv1.OutputTypeKafka:
-		if o.Kafka != nil && o.Kafka.Authentication != nil {
-			a := o.Kafka.Authentication
-			return []*obsv1.SecretReference{a.SASL.Password, a.SASL.Username}
+		if o.Kafka != nil {
+			keys := integrityKeys(o.Kafka.Integrity)
+			if a := o.Kafka.Authentication; a != nil && a.SASL != nil {
+				keys = append(keys, a.SASL.Password, a.SASL.Username)
+			}
+			return keys
 		}
 	case obsv1.OutputTypeLoki:
 		if o.Loki != nil {
@@ -167,6 +216,13 @@ func httpAuthKeys(auth *obsv1.HTTPAuthentication) []*obsv1.SecretReference {
 	return []*obsv1.SecretReference{}
 }
 
+func integrityKeys(spec *obsv1.IntegritySpec) []*obsv1.SecretReference {
+	if spec != nil && spec.Key != nil {
+		return []*obsv1.SecretReference{spec.Key}
+	}
+	return []*obsv1.SecretReference{}
+}
+
 func lokiStackKeys(auth *obsv1.LokiStackAuthentication) (keys []*obsv1.SecretReference) {
 	if auth != nil {
 		if auth.Token != nil && auth.Token.From == obsv1.BearerTokenFromSecret && auth.Token.Secret != nil {
@@ -196,3 +252,67 @@ func cloudwatchAuthKeys(auth *obsv1.CloudwatchAuthentication) (keys []*obsv1.Sec
 	}
 	return keys
 }
+
+// Duplicates returns a map of output names to the name of the first output with an identical spec.  Outputs which
+// differ only by name deliver to the same destination with the same settings
+func (outputs Outputs) Duplicates() map[string]string {
+	duplicates := map[string]string{}
+	var seen []obsv1.OutputSpec
+	for _, o := range outputs {
+		for _, first := range seen {
+			spec := o
+			spec.Name = first.Name
+			if reflect.DeepEqual(spec, first) {
+				duplicates[o.Name] = first.Name
+				break
+			}
+		}
+		if _, found := duplicates[o.Name]; !found {
+			seen = append(seen, o)
+		}
+	}
+	return duplicates
+}
+
+// SocketPath returns the path of the unix domain socket of a syslog output with a URL of the form
+// unix:///path/to/socket or an empty string for any other output
+func SocketPath(o obsv1.OutputSpec) string {
+	if o.Type != obsv1.OutputTypeSyslog || o.Syslog == nil {
+		return ""
+	}
+	u, err := url.Parse(o.Syslog.URL)
+	if err != nil || strings.ToLower(u.Scheme) != SchemeUnix {
+		return ""
+	}
+	return u.Path
+}
+
+// ValidateSocketDir returns an error when the node directory of a unix domain socket is not a clean path below
+// SocketDirPrefix or overlaps a directory already mounted into the collector
+func ValidateSocketDir(dir string) error {
+	if dir != path.Clean(dir) || !strings.HasPrefix(dir, SocketDirPrefix) {
+		return fmt.Errorf("the directory %q of the socket must be below %s", dir, SocketDirPrefix)
+	}
+	for _, reserved := range reservedSocketDirs {
+		if dir == reserved || strings.HasPrefix(dir, reserved+"/") || strings.HasPrefix(reserved, dir+"/") {
+			return fmt.Errorf("the directory %q of the socket overlaps the collector directory %s", dir, reserved)
+		}
+	}
+	return nil
+}
+
+// SocketDirs returns the sorted, unique set of the node directories holding the unix domain sockets of the outputs.
+// Directories which are not valid are skipped so they are never mounted into the collector
+func (outputs Outputs) SocketDirs() []string {
+	dirs := set.New[string]()
+	for _, o := range outputs {
+		if socket := SocketPath(o); socket != "" {
+			if dir := path.Dir(socket); ValidateSocketDir(dir) == nil {
+				dirs.Insert(dir)
+			}
+		}
+	}
+	list := dirs.UnsortedList()
+	sort.Strings(list)
+	return list
+}
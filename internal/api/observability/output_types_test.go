@@ -28,5 +28,110 @@ var _ = Describe("helpers for output types", func() {
 			}
 		})
 
+		It("should return the integrity key of an output", func() {
+			key := &obsv1.SecretReference{Key: "hmac-key", SecretName: "integrity"}
+			spec := obsv1.OutputSpec{
+				Type: obsv1.OutputTypeKafka,
+				Kafka: &obsv1.Kafka{
+					Integrity: &obsv1.IntegritySpec{Key: key},
+				},
+			}
+			Expect(SecretReferences(spec)).To(ConsistOf(key))
+		})
+
+	})
+
+	Context("#SecretProviderClassKeys", func() {
+
+		It("should return the keys referenced from each SecretProviderClass", func() {
+			outputs := Outputs{
+				{
+					Name:                "http",
+					Type:                obsv1.OutputTypeHTTP,
+					SecretProviderClass: "vault",
+					HTTP: &obsv1.HTTP{
+						Authentication: &obsv1.HTTPAuthentication{
+							Username: NewSecretReference("username", "vault"),
+							Password: NewSecretReference("password", "vault"),
+						},
+					},
+					TLS: &obsv1.OutputTLSSpec{
+						TLSSpec: obsv1.TLSSpec{
+							CA:  &obsv1.ValueReference{Key: "ca-bundle.crt", ConfigMapName: "vault"},
+							Key: NewSecretReference("tls.key", "other"),
+						},
+					},
+				},
+				{
+					Name: "nospc",
+					Type: obsv1.OutputTypeHTTP,
+					HTTP: &obsv1.HTTP{
+						Authentication: &obsv1.HTTPAuthentication{
+							Username: NewSecretReference("username", "vault"),
+						},
+					},
+				},
+			}
+			Expect(outputs.SecretProviderClassKeys()).To(Equal(map[string][]string{
+				"vault": {"password", "username"},
+			}))
+		})
+	})
+
+	Context("#Duplicates", func() {
+
+		It("should map outputs to the first output with an identical spec", func() {
+			newOutput := func(name, url string) obsv1.OutputSpec {
+				return obsv1.OutputSpec{
+					Name: name,
+					Type: obsv1.OutputTypeHTTP,
+					HTTP: &obsv1.HTTP{
+						URLSpec: obsv1.URLSpec{URL: url},
+						Authentication: &obsv1.HTTPAuthentication{
+							Username: NewSecretReference("username", "creds"),
+						},
+					},
+				}
+			}
+			outputs := Outputs{
+				newOutput("first", "https://my.receiver"),
+				newOutput("other", "https://other.receiver"),
+				newOutput("second", "https://my.receiver"),
+				newOutput("third", "https://my.receiver"),
+			}
+			Expect(outputs.Duplicates()).To(Equal(map[string]string{
+				"second": "first",
+				"third":  "first",
+			}))
+		})
+	})
+
+	Context("#SocketDirs", func() {
+
+		It("should return the unique directories of the unix domain sockets of syslog outputs", func() {
+			newOutput := func(name, url string) obsv1.OutputSpec {
+				return obsv1.OutputSpec{
+					Name:   name,
+					Type:   obsv1.OutputTypeSyslog,
+					Syslog: &obsv1.Syslog{URL: url},
+				}
+			}
+			outputs := Outputs{
+				newOutput("siem", "unix:///var/run/siem/syslog.sock"),
+				newOutput("audit", "unix:///var/run/siem/audit.sock"),
+				newOutput("agent", "unix:///var/run/agent/agent.sock"),
+				newOutput("remote", "tcp://syslog.example.com:514"),
+			}
+			Expect(outputs.SocketDirs()).To(Equal([]string{"/var/run/agent", "/var/run/siem"}))
+		})
+
+		It("should skip the directories which may not be mounted into the collector", func() {
+			outputs := Outputs{
+				{Name: "root", Type: obsv1.OutputTypeSyslog, Syslog: &obsv1.Syslog{URL: "unix:///syslog.sock"}},
+				{Name: "logs", Type: obsv1.OutputTypeSyslog, Syslog: &obsv1.Syslog{URL: "unix:///var/log/syslog.sock"}},
+				{Name: "secrets", Type: obsv1.OutputTypeSyslog, Syslog: &obsv1.Syslog{URL: "unix:///var/run/ocp-collector/syslog.sock"}},
+			}
+			Expect(outputs.SocketDirs()).To(BeEmpty())
+		})
 	})
 })
@@ -1,6 +1,11 @@
 package observability
 
-import obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+import (
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"k8s.io/utils/set"
+)
 
 type Pipelines []obs.PipelineSpec
 
@@ -20,3 +25,25 @@ func (pipeline Pipelines) Map() map[string]obs.PipelineSpec {
 	}
 	return m
 }
+
+// Duplicates returns a map of pipeline names to the name of the first pipeline with identical inputs, filters
+// and outputs.  Input and output references are compared as sets while the order of filters is significant
+func (pipelines Pipelines) Duplicates() map[string]string {
+	duplicates := map[string]string{}
+	seen := map[string]string{}
+	for _, p := range pipelines {
+		key := strings.Join([]string{
+			strings.Join(set.New(p.InputRefs...).SortedList(), ","),
+			strings.Join(p.FilterRefs, ","),
+			strings.Join(set.New(p.OutputRefs...).SortedList(), ","),
+			string(p.Ordering),
+			string(p.MinLevel),
+		}, "|")
+		if first, found := seen[key]; found {
+			duplicates[p.Name] = first
+		} else {
+			seen[key] = p.Name
+		}
+	}
+	return duplicates
+}
@@ -0,0 +1,36 @@
+package observability_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
+)
+
+var _ = Describe("helpers for pipelines", func() {
+
+	Context("#Duplicates", func() {
+
+		It("should map pipelines to the first identical pipeline regardless of the order of inputs and outputs", func() {
+			pipelines := Pipelines{
+				{Name: "first", InputRefs: []string{"application", "audit"}, OutputRefs: []string{"a", "b"}, FilterRefs: []string{"x", "y"}},
+				{Name: "second", InputRefs: []string{"audit", "application"}, OutputRefs: []string{"b", "a"}, FilterRefs: []string{"x", "y"}},
+				{Name: "third", InputRefs: []string{"application", "audit"}, OutputRefs: []string{"a", "b"}, FilterRefs: []string{"x", "y"}},
+			}
+			Expect(pipelines.Duplicates()).To(Equal(map[string]string{
+				"second": "first",
+				"third":  "first",
+			}))
+		})
+
+		It("should not consider pipelines with filters in a different order to be identical", func() {
+			pipelines := Pipelines{
+				{Name: "first", InputRefs: []string{"application"}, OutputRefs: []string{"a"}, FilterRefs: []string{"x", "y"}},
+				{Name: "second", InputRefs: []string{"application"}, OutputRefs: []string{"a"}, FilterRefs: []string{"y", "x"}},
+				{Name: "third", InputRefs: []string{"application"}, OutputRefs: []string{"a"}, FilterRefs: []string{"x", "y"}, Ordering: obsv1.OrderingModeStrict},
+				{Name: "fourth", InputRefs: []string{"application"}, OutputRefs: []string{"a"}, FilterRefs: []string{"x", "y"}, MinLevel: obsv1.LogLevelWarn},
+			}
+			Expect(pipelines.Duplicates()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package observability
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"k8s.io/utils/set"
+)
+
+// CollectorShards returns the shards of the collector of the forwarder, if any
+func CollectorShards(spec obs.ClusterLogForwarderSpec) []obs.CollectorShardSpec {
+	if spec.Collector == nil {
+		return nil
+	}
+	return spec.Collector.Shards
+}
+
+// ShardSpec returns the forwarder spec of the collector of a shard which collects the logs of the inputs of the
+// types of the shard
+func ShardSpec(spec obs.ClusterLogForwarderSpec, shard obs.CollectorShardSpec) obs.ClusterLogForwarderSpec {
+	types := set.New(shard.InputTypes...)
+	return collectedSpec(spec, func(t obs.InputType) bool {
+		return types.Has(t)
+	})
+}
+
+// PrimarySpec returns the forwarder spec of the primary collector which collects the logs of the inputs whose types
+// are not collected by a shard
+func PrimarySpec(spec obs.ClusterLogForwarderSpec) obs.ClusterLogForwarderSpec {
+	shards := CollectorShards(spec)
+	if len(shards) == 0 {
+		return spec
+	}
+	sharded := set.New[obs.InputType]()
+	for _, shard := range shards {
+		sharded.Insert(shard.InputTypes...)
+	}
+	return collectedSpec(spec, func(t obs.InputType) bool {
+		return !sharded.Has(t)
+	})
+}
+
+// collectedSpec returns a copy of the spec with pipelines limited to the inputs of the collected types.  Pipelines
+// which no longer reference an input are removed, along with the inputs and outputs no longer referenced by a pipeline
+func collectedSpec(spec obs.ClusterLogForwarderSpec, collect func(obs.InputType) bool) obs.ClusterLogForwarderSpec {
+	collected := set.New[string]()
+	for _, input := range spec.Inputs {
+		if collect(input.Type) {
+			collected.Insert(input.Name)
+		}
+	}
+	inputRefs := set.New[string]()
+	outputRefs := set.New[string]()
+	pipelines := []obs.PipelineSpec{}
+	for _, p := range spec.Pipelines {
+		refs := []string{}
+		for _, ref := range p.InputRefs {
+			if collected.Has(ref) {
+				refs = append(refs, ref)
+			}
+		}
+		if len(refs) == 0 {
+			continue
+		}
+		p.InputRefs = refs
+		pipelines = append(pipelines, p)
+		inputRefs.Insert(refs...)
+		outputRefs.Insert(p.OutputRefs...)
+	}
+	inputs := []obs.InputSpec{}
+	for _, input := range spec.Inputs {
+		if inputRefs.Has(input.Name) {
+			inputs = append(inputs, input)
+		}
+	}
+	outputs := []obs.OutputSpec{}
+	for _, output := range spec.Outputs {
+		if outputRefs.Has(output.Name) {
+			outputs = append(outputs, output)
+		}
+	}
+	spec.Inputs = inputs
+	spec.Outputs = outputs
+	spec.Pipelines = pipelines
+	return spec
+}
@@ -0,0 +1,76 @@
+package observability_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
+)
+
+var _ = Describe("helpers for collector shards", func() {
+
+	var (
+		auditShard = obsv1.CollectorShardSpec{Name: "audit", InputTypes: []obsv1.InputType{obsv1.InputTypeAudit}}
+		spec       obsv1.ClusterLogForwarderSpec
+	)
+
+	BeforeEach(func() {
+		spec = obsv1.ClusterLogForwarderSpec{
+			Collector: &obsv1.CollectorSpec{
+				Shards: []obsv1.CollectorShardSpec{auditShard},
+			},
+			Inputs: []obsv1.InputSpec{
+				{Name: "my-app", Type: obsv1.InputTypeApplication},
+				{Name: "my-audit", Type: obsv1.InputTypeAudit},
+				{Name: "my-receiver", Type: obsv1.InputTypeReceiver},
+			},
+			Outputs: []obsv1.OutputSpec{
+				{Name: "app-store"},
+				{Name: "audit-store"},
+				{Name: "shared-store"},
+			},
+			Pipelines: []obsv1.PipelineSpec{
+				{Name: "app", InputRefs: []string{"my-app", "my-receiver"}, OutputRefs: []string{"app-store"}},
+				{Name: "audit", InputRefs: []string{"my-audit"}, OutputRefs: []string{"audit-store"}},
+				{Name: "all", InputRefs: []string{"my-app", "my-audit"}, OutputRefs: []string{"shared-store"}},
+			},
+		}
+	})
+
+	Context("#ShardSpec", func() {
+		It("should only forward the logs of the inputs of the types of the shard", func() {
+			shard := ShardSpec(spec, auditShard)
+			Expect(shard.Inputs).To(Equal([]obsv1.InputSpec{{Name: "my-audit", Type: obsv1.InputTypeAudit}}))
+			Expect(shard.Outputs).To(Equal([]obsv1.OutputSpec{{Name: "audit-store"}, {Name: "shared-store"}}))
+			Expect(shard.Pipelines).To(Equal([]obsv1.PipelineSpec{
+				{Name: "audit", InputRefs: []string{"my-audit"}, OutputRefs: []string{"audit-store"}},
+				{Name: "all", InputRefs: []string{"my-audit"}, OutputRefs: []string{"shared-store"}},
+			}))
+		})
+
+		It("should not modify the pipelines of the forwarder", func() {
+			ShardSpec(spec, auditShard)
+			Expect(spec.Pipelines[2].InputRefs).To(Equal([]string{"my-app", "my-audit"}))
+		})
+	})
+
+	Context("#PrimarySpec", func() {
+		It("should forward the logs of the inputs not collected by a shard", func() {
+			primary := PrimarySpec(spec)
+			Expect(primary.Inputs).To(Equal([]obsv1.InputSpec{
+				{Name: "my-app", Type: obsv1.InputTypeApplication},
+				{Name: "my-receiver", Type: obsv1.InputTypeReceiver},
+			}))
+			Expect(primary.Outputs).To(Equal([]obsv1.OutputSpec{{Name: "app-store"}, {Name: "shared-store"}}))
+			Expect(primary.Pipelines).To(Equal([]obsv1.PipelineSpec{
+				{Name: "app", InputRefs: []string{"my-app", "my-receiver"}, OutputRefs: []string{"app-store"}},
+				{Name: "all", InputRefs: []string{"my-app"}, OutputRefs: []string{"shared-store"}},
+			}))
+		})
+
+		It("should return the spec unmodified when the collector has no shards", func() {
+			spec.Collector = nil
+			Expect(PrimarySpec(spec)).To(Equal(spec))
+		})
+	})
+})
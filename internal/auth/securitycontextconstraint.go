@@ -27,7 +27,7 @@ var (
 		"KILL",
 	}
 
-	DesiredSCCVolumes = []security.FSType{"configMap", "secret", "emptyDir", "projected"}
+	DesiredSCCVolumes = []security.FSType{"configMap", "secret", "emptyDir", "projected", "csi"}
 )
 
 func NewSCC() *security.SecurityContextConstraints {
@@ -5,8 +5,10 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	corev1 "k8s.io/api/core/v1"
@@ -25,6 +27,10 @@ func main() {
 	yamlFile := flag.String("file", "", "ClusterLogForwarder yaml file. - for stdin")
 	debugOutput := flag.Bool("debug-output", false, "Generate config normally, but replace output plugins with @stdout plugin, so that records can be printed in collector logs.")
 	secretsFlag := flag.String("secrets", "", "colon delimited list of secrets in the form of name=key1,key1")
+	listen := flag.String("listen", "", "Serve the generator over HTTP on this address (e.g. :8080) instead of generating the config of a file. Forwarders are posted to "+forwarder.GeneratePath)
+	tokenFile := flag.String("token-file", "", "File of the bearer token which requests to the generator service must present. Required with --listen")
+	tlsCertFile := flag.String("tls-cert-file", "", "Certificate with which the generator service is served over HTTPS")
+	tlsKeyFile := flag.String("tls-key-file", "", "Private key of the certificate with which the generator service is served over HTTPS")
 	help := flag.Bool("help", false, "This message")
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
 	pflag.Parse()
@@ -34,6 +40,35 @@ func main() {
 	}
 	log.V(1).Info("Forwarder Generator Main", "Args", os.Args)
 
+	if *listen != "" {
+		if *tokenFile == "" {
+			log.Error(nil, "The generator service requires a --token-file")
+			os.Exit(1)
+		}
+		token, err := os.ReadFile(*tokenFile)
+		if err != nil || len(strings.TrimSpace(string(token))) == 0 {
+			log.Error(err, "Error reading the bearer token", "file", *tokenFile)
+			os.Exit(1)
+		}
+		log.Info("Serving the forwarder generator", "address", *listen, "path", forwarder.GeneratePath)
+		server := &http.Server{
+			Addr:              *listen,
+			Handler:           forwarder.NewHandler(strings.TrimSpace(string(token))),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		if *tlsCertFile != "" || *tlsKeyFile != "" {
+			err = server.ListenAndServeTLS(*tlsCertFile, *tlsKeyFile)
+		} else {
+			log.Info("Serving the forwarder generator without TLS, the bearer token and posted secrets are sent in clear text")
+			err = server.ListenAndServe()
+		}
+		if err != nil {
+			log.Error(err, "Error serving the forwarder generator")
+			os.Exit(1)
+		}
+		return
+	}
+
 	var reader func() ([]byte, error)
 	switch *yamlFile {
 	case "-":
@@ -0,0 +1,134 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	configv1 "github.com/openshift/api/config/v1"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/collector/common"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/reconcile"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/tls"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const aggregatorSuffix = "-aggregator"
+
+// AggregatorResourceNames returns the names of the resources of the aggregator of a forwarder.  The aggregator is
+// deployed as a forwarder of its own whose name is suffixed with aggregator
+func AggregatorResourceNames(names *factory.ForwarderResourceNames) *factory.ForwarderResourceNames {
+	aggregatorNames := *names
+	aggregatorNames.CommonName += aggregatorSuffix
+	aggregatorNames.ConfigMap += aggregatorSuffix
+	aggregatorNames.SecretMetrics += aggregatorSuffix
+	aggregatorNames.ForwarderName += aggregatorSuffix
+	return &aggregatorNames
+}
+
+// ForAggregator returns the factory of the deployment of the aggregator to which the collectors forward the logs
+func (f *Factory) ForAggregator(confHash string) *Factory {
+	names := AggregatorResourceNames(f.ResourceNames)
+	aggregator := f.CollectorSpec.Aggregator
+	if aggregator == nil {
+		aggregator = &obs.AggregatorSpec{}
+	}
+	collectorSpec := obs.CollectorSpec{
+		Resources:     aggregator.Resources,
+		NodeSelector:  aggregator.NodeSelector,
+		Tolerations:   aggregator.Tolerations,
+		Networks:      f.CollectorSpec.Networks,
+		Mesh:          f.CollectorSpec.Mesh,
+		MaxRecordSize: f.CollectorSpec.MaxRecordSize,
+	}
+
+	af := *f
+	af.ConfigHash = confHash
+	af.CollectorSpec = collectorSpec
+	af.ResourceNames = names
+	af.CommonLabelInitializer = func(o runtime.Object) {
+		runtime.SetCommonLabels(o, constants.VectorName, names.ForwarderName, constants.CollectorName)
+	}
+	af.isDaemonset = false
+	af.canary = false
+	af.shard = ""
+	af.replicas = aggregator.Replicas
+	af.persistentQueue = aggregator.PersistentQueue
+	return &af
+}
+
+// NewStatefulSet returns the statefulset of an aggregator with a persistent queue.  The data directory of each pod,
+// which holds the queues of the outputs, is on the persistent volume claimed by the pod
+func (f *Factory) NewStatefulSet(namespace, name string, trustedCABundle *v1.ConfigMap, tlsProfileSpec configv1.TLSProfileSpec) *apps.StatefulSet {
+	podSpec := f.NewPodSpec(trustedCABundle, f.ForwarderSpec, f.ClusterID, tlsProfileSpec, namespace)
+	podSpec.Volumes = slices.DeleteFunc(podSpec.Volumes, func(v v1.Volume) bool {
+		return v.Name == common.DataDir
+	})
+	claim := v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: common.DataDir},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+		},
+	}
+	if queue := f.persistentQueue; queue != nil {
+		claim.Spec.Resources.Requests = v1.ResourceList{v1.ResourceStorage: queue.Size}
+		if queue.StorageClassName != "" {
+			claim.Spec.StorageClassName = utils.GetPtr(queue.StorageClassName)
+		}
+	}
+	sts := factory.NewStatefulSet(namespace, name, f.ResourceNames.CommonName, constants.CollectorName, constants.VectorName, f.deploymentReplicas(), *podSpec, []v1.PersistentVolumeClaim{claim}, f.CommonLabelInitializer, f.PodLabelVisitor)
+	f.attachNetworks(&sts.Spec.Template.ObjectMeta)
+	f.attachMesh(&sts.Spec.Template.ObjectMeta)
+	return sts
+}
+
+// ReconcileStatefulSet reconciles the statefulset of an aggregator with a persistent queue
+func (f *Factory) ReconcileStatefulSet(k8sClient client.Client, namespace string, trustedCABundle *v1.ConfigMap, owner metav1.OwnerReference) error {
+	tlsProfile, _ := tls.FetchAPIServerTlsProfile(k8sClient)
+	desired := f.NewStatefulSet(namespace, f.ResourceNames.DaemonSetName(), trustedCABundle, tls.GetClusterTLSProfileSpec(tlsProfile))
+	utils.AddOwnerRefToObject(desired, owner)
+	return reconcile.StatefulSet(k8sClient, desired, f.Drift)
+}
+
+// RemoveStatefulSet removes the statefulset of an aggregator.  The persistent volume claims of its pods are retained
+func RemoveStatefulSet(k8sClient client.Client, namespace, name string) error {
+	log.V(3).Info("Removing aggregator statefulset", "namespace", namespace, "name", name)
+	sts := runtime.NewStatefulSet(namespace, name)
+	if err := k8sClient.Delete(context.TODO(), sts); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failure deleting statefulset %s/%s: %v", namespace, name, err)
+	}
+	return nil
+}
+
+// RemoveAggregator removes the aggregator of the forwarder
+func RemoveAggregator(k8sClient client.Client, namespace string, names *factory.ForwarderResourceNames) error {
+	aggregatorNames := AggregatorResourceNames(names)
+	policy := &networkingv1.NetworkPolicy{}
+	runtime.Initialize(policy, namespace, aggregatorNames.CommonName)
+	for _, o := range []client.Object{
+		runtime.NewDeployment(namespace, aggregatorNames.DaemonSetName()),
+		runtime.NewStatefulSet(namespace, aggregatorNames.DaemonSetName()),
+		runtime.NewConfigMap(namespace, aggregatorNames.ConfigMap, nil),
+		runtime.NewService(namespace, aggregatorNames.CommonName),
+		runtime.NewServiceMonitor(namespace, aggregatorNames.CommonName),
+		policy,
+	} {
+		err := k8sClient.Delete(context.TODO(), o)
+		if err == nil {
+			log.V(3).Info("Removed aggregator resource", "namespace", namespace, "name", o.GetName())
+		} else if !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package collector
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/tls"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Factory#ForAggregator", func() {
+
+	const name = "my-forwarder"
+
+	var (
+		primary    *Factory
+		aggregator = &obs.AggregatorSpec{
+			Replicas:     3,
+			NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+		}
+	)
+
+	BeforeEach(func() {
+		forwarder := obs.ClusterLogForwarder{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.OpenshiftNS}}
+		collectorSpec := &obs.CollectorSpec{
+			Tolerations: []corev1.Toleration{{Key: "primary", Operator: corev1.TolerationOpExists}},
+			Aggregator:  aggregator,
+		}
+		primary = New("primaryhash", "clusterid", collectorSpec, nil, nil, forwarder.Spec, coreFactory.ResourceNames(forwarder), true, "")
+	})
+
+	It("should deploy the aggregator as a forwarder of its own", func() {
+		f := primary.ForAggregator("aggregatorhash")
+		Expect(f.ResourceNames.DaemonSetName()).To(Equal(name + "-aggregator"))
+		Expect(f.ResourceNames.ConfigMap).To(Equal(name + "-config-aggregator"))
+		Expect(f.ResourceNames.SecretMetrics).To(Equal(name + "-metrics-aggregator"))
+		Expect(primary.ResourceNames.DaemonSetName()).To(Equal(name), "Exp. the names of the collector to be unchanged")
+
+		dpl := f.NewDeployment(constants.OpenshiftNS, f.ResourceNames.DaemonSetName(), nil, tls.GetClusterTLSProfileSpec(nil))
+		Expect(*dpl.Spec.Replicas).To(BeEquivalentTo(3))
+		Expect(dpl.Spec.Selector.MatchLabels).To(HaveKeyWithValue(constants.LabelK8sInstance, name+"-aggregator"))
+		podSpec := dpl.Spec.Template.Spec
+		Expect(podSpec.NodeSelector).To(HaveKey("node-role.kubernetes.io/infra"))
+		Expect(podSpec.Tolerations).ToNot(ContainElement(corev1.Toleration{Key: "primary", Operator: corev1.TolerationOpExists}), "Exp. the tolerations of the collector not to apply to the aggregator")
+	})
+
+	It("should deploy two replicas of a collector deployment by default", func() {
+		dpl := primary.NewDeployment(constants.OpenshiftNS, name, nil, tls.GetClusterTLSProfileSpec(nil))
+		Expect(*dpl.Spec.Replicas).To(BeEquivalentTo(2))
+	})
+})
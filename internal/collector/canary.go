@@ -0,0 +1,347 @@
+package collector
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	"github.com/openshift/cluster-logging-operator/internal/collector/vector"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/reconcile"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/internal/utils/comparators"
+	"github.com/prometheus/common/expfmt"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationCanaryConfigHash is the hash of the collector config rolled out to the canary collectors
+	AnnotationCanaryConfigHash = "observability.openshift.io/canary-config-hash"
+
+	// AnnotationCanaryStarted is the time the collector config was rolled out to the canary collectors
+	AnnotationCanaryStarted = "observability.openshift.io/canary-started"
+
+	// AnnotationCanaryFailedHash is the hash of the collector config which was rolled back on the canary collectors
+	AnnotationCanaryFailedHash = "observability.openshift.io/canary-failed-hash"
+
+	// LabelCanary identifies the canary collector pods
+	LabelCanary = "observability.openshift.io/canary"
+
+	// DefaultCanaryBakeTime is the time the canary collectors must remain healthy when not specified
+	DefaultCanaryBakeTime = 10 * time.Minute
+
+	canarySuffix = "-canary"
+
+	// sinkErrorsMetric is the counter of the errors of the components of a collector
+	sinkErrorsMetric = "vector_component_errors_total"
+	// sinkSentEventsMetric is the counter of the records delivered by the components of a collector
+	sinkSentEventsMetric = "vector_component_sent_events_total"
+)
+
+// ErrCanaryRolloutPending is returned while the collector config is being evaluated on the canary nodes
+var ErrCanaryRolloutPending = errors.New("collector config is being rolled out to the canary nodes")
+
+// CanaryRolloutError is returned when the collector config was rolled back on the canary nodes
+type CanaryRolloutError struct {
+	Reason string
+}
+
+func (e *CanaryRolloutError) Error() string {
+	return fmt.Sprintf("collector config was rolled back on the canary nodes: %s", e.Reason)
+}
+
+// ReconcileCanaryRollout rolls out the collector config to the collectors on the canary nodes and, once they remain
+// healthy for the bake time, to the remaining collectors.  The config is rolled back on the canary nodes when the
+// canary collectors are unhealthy or, when a scraper is given, their sinks fail to deliver records.  It returns
+// ErrCanaryRolloutPending while the canary is evaluated and a CanaryRolloutError when the config was rolled back
+func (f *Factory) ReconcileCanaryRollout(k8sClient client.Client, reader client.Reader, scraper MetricsScraper, namespace, collectorConfig string, trustedCABundle *v1.ConfigMap, owner metav1.OwnerReference, now time.Time) error {
+	primary := &apps.DaemonSet{}
+	if err := reader.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: f.ResourceNames.DaemonSetName()}, primary); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		return f.promoteCanary(k8sClient, reader, namespace, collectorConfig, trustedCABundle, owner, now)
+	}
+	applied := appliedConfigHash(primary)
+	if applied == f.ConfigHash {
+		return f.promoteCanary(k8sClient, reader, namespace, collectorConfig, trustedCABundle, owner, now)
+	}
+
+	previous := &v1.ConfigMap{}
+	if err := reader.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: f.ResourceNames.ConfigMap}, previous); err != nil {
+		return fmt.Errorf("unable to fetch the collector config to roll back to: %v", err)
+	}
+	rollback := func(reason string) error {
+		log.V(1).Info("Rolling back collector config on the canary nodes", "hash", f.ConfigHash, "reason", reason)
+		previousFactory := *f
+		previousFactory.ConfigHash = applied
+		if err := previousFactory.reconcileCanary(k8sClient, reader, namespace, previous.Data[vector.ConfigFile], trustedCABundle, owner, now, f.ConfigHash); err != nil {
+			return err
+		}
+		return &CanaryRolloutError{Reason: reason}
+	}
+
+	current := &v1.ConfigMap{}
+	if err := reader.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: f.canaryNames().ConfigMap}, current); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if current.Annotations[AnnotationCanaryFailedHash] == f.ConfigHash {
+		return rollback("the canary collectors were unhealthy")
+	}
+	if err := f.excludeCanaryNodes(k8sClient, primary); err != nil {
+		return err
+	}
+	started := canaryStarted(current, f.ConfigHash, now)
+	if err := f.reconcileCanary(k8sClient, reader, namespace, collectorConfig, trustedCABundle, owner, started, ""); err != nil {
+		return err
+	}
+
+	ds, rolledOut, failure, err := f.canaryHealth(reader, scraper, namespace)
+	if err != nil {
+		return err
+	}
+	baked := !now.Before(started.Add(f.canaryBakeTime()))
+	switch {
+	case failure != "":
+		return rollback(failure)
+	case !rolledOut && baked && ds.Status.DesiredNumberScheduled == 0:
+		return rollback("no nodes match the canary node selector")
+	case !rolledOut && baked:
+		return rollback("the canary collectors did not become available within the bake time")
+	case rolledOut && baked:
+		return f.promoteCanary(k8sClient, reader, namespace, collectorConfig, trustedCABundle, owner, now)
+	}
+	return ErrCanaryRolloutPending
+}
+
+// RemoveCanary removes the canary collector
+func (f *Factory) RemoveCanary(k8sClient client.Client, namespace string) error {
+	names := f.canaryNames()
+	for _, o := range []client.Object{runtime.NewDaemonSet(namespace, names.DaemonSetName()), runtime.NewConfigMap(namespace, names.ConfigMap, nil)} {
+		if err := k8sClient.Delete(context.TODO(), o); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// promoteCanary rolls out the collector config to all collectors
+func (f *Factory) promoteCanary(k8sClient client.Client, reader client.Reader, namespace, collectorConfig string, trustedCABundle *v1.ConfigMap, owner metav1.OwnerReference, now time.Time) error {
+	current := &v1.ConfigMap{}
+	if err := reader.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: f.canaryNames().ConfigMap}, current); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err := f.reconcileCanary(k8sClient, reader, namespace, collectorConfig, trustedCABundle, owner, canaryStarted(current, f.ConfigHash, now), ""); err != nil {
+		return err
+	}
+	if err := f.ReconcileCollectorConfig(k8sClient, reader, namespace, collectorConfig, owner); err != nil {
+		return err
+	}
+	return f.ReconcileDaemonset(k8sClient, namespace, trustedCABundle, owner)
+}
+
+// reconcileCanary reconciles the config and daemonset of the canary collectors
+func (f *Factory) reconcileCanary(k8sClient client.Client, reader client.Reader, namespace, collectorConfig string, trustedCABundle *v1.ConfigMap, owner metav1.OwnerReference, started time.Time, failedHash string) error {
+	canary := *f
+	canary.ResourceNames = f.canaryNames()
+	canary.canary = true
+
+	configMap := canary.NewCollectorConfig(namespace, collectorConfig)
+	if configMap.Annotations == nil {
+		configMap.Annotations = map[string]string{}
+	}
+	configMap.Annotations[AnnotationCanaryConfigHash] = f.ConfigHash
+	configMap.Annotations[AnnotationCanaryStarted] = started.UTC().Format(time.RFC3339)
+	if failedHash != "" {
+		configMap.Annotations[AnnotationCanaryFailedHash] = failedHash
+	}
+	utils.AddOwnerRefToObject(configMap, owner)
+	canary.Provenance.keepSignature(reader, configMap)
+	if err := reconcile.Configmap(k8sClient, reader, configMap, f.Drift, comparators.CompareLabels, comparators.CompareAnnotations); err != nil {
+		return err
+	}
+	return canary.ReconcileDaemonset(k8sClient, namespace, trustedCABundle, owner)
+}
+
+// canaryHealth evaluates if the canary collectors are rolled out and the reason they are unhealthy, if any
+func (f *Factory) canaryHealth(reader client.Reader, scraper MetricsScraper, namespace string) (ds *apps.DaemonSet, rolledOut bool, failure string, err error) {
+	ds = &apps.DaemonSet{}
+	if err = reader.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: f.canaryNames().DaemonSetName()}, ds); err != nil {
+		return nil, false, "", err
+	}
+	status := ds.Status
+	if status.ObservedGeneration < ds.Generation || status.DesiredNumberScheduled == 0 ||
+		status.UpdatedNumberScheduled < status.DesiredNumberScheduled || status.NumberAvailable < status.DesiredNumberScheduled {
+		return ds, false, "", nil
+	}
+	pods := &v1.PodList{}
+	if err = reader.List(context.TODO(), pods, client.InNamespace(namespace), client.MatchingLabels(ds.Spec.Selector.MatchLabels)); err != nil {
+		return nil, false, "", err
+	}
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == constants.CollectorName && status.RestartCount > 0 {
+				return ds, true, fmt.Sprintf("the collector of pod %s restarted %d times", pod.Name, status.RestartCount), nil
+			}
+		}
+	}
+	if scraper == nil {
+		return ds, true, "", nil
+	}
+	for _, pod := range pods.Items {
+		metrics, err := scraper.Scrape(context.TODO(), pod)
+		if err != nil {
+			log.V(2).Info("unable to scrape the metrics of the canary collector", "pod", pod.Name, "error", err.Error())
+			continue
+		}
+		sinks, err := failingSinks(bytes.NewReader(metrics))
+		if err != nil {
+			log.V(2).Info("unable to parse the metrics of the canary collector", "pod", pod.Name, "error", err.Error())
+			continue
+		}
+		if len(sinks) > 0 {
+			return ds, true, fmt.Sprintf("the sinks %s of pod %s failed to deliver any records", strings.Join(sinks, ","), pod.Name), nil
+		}
+	}
+	return ds, true, "", nil
+}
+
+// failingSinks returns the sorted IDs of the sinks which reported errors without delivering any records, parsed from
+// the metrics exposed by a collector
+func failingSinks(metrics io.Reader) ([]string, error) {
+	parser := expfmt.TextParser{}
+	families, err := parser.TextToMetricFamilies(metrics)
+	if err != nil {
+		return nil, err
+	}
+	sinkCounts := func(name string) map[string]float64 {
+		counts := map[string]float64{}
+		for _, metric := range families[name].GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["component_kind"] == "sink" {
+				counts[labels["component_id"]] += metric.GetCounter().GetValue()
+			}
+		}
+		return counts
+	}
+	sent := sinkCounts(sinkSentEventsMetric)
+	sinks := []string{}
+	for id, errors := range sinkCounts(sinkErrorsMetric) {
+		if errors > 0 && sent[id] == 0 {
+			sinks = append(sinks, id)
+		}
+	}
+	sort.Strings(sinks)
+	return sinks, nil
+}
+
+func (f *Factory) canaryBakeTime() time.Duration {
+	if rollout := f.CollectorSpec.Rollout; rollout != nil && rollout.BakeTime != nil {
+		return *rollout.BakeTime * time.Second
+	}
+	return DefaultCanaryBakeTime
+}
+
+func (f *Factory) canaryNames() *factory.ForwarderResourceNames {
+	names := *f.ResourceNames
+	names.CommonName += canarySuffix
+	names.ConfigMap += canarySuffix
+	return &names
+}
+
+// placeCanary schedules the canary collectors on the canary nodes and the remaining collectors on all other nodes
+func (f *Factory) placeCanary(ds *apps.DaemonSet) {
+	rollout := f.CollectorSpec.Rollout
+	if rollout == nil || len(rollout.CanaryNodeSelector) == 0 {
+		return
+	}
+	podSpec := &ds.Spec.Template.Spec
+	if f.canary {
+		// The pods are labeled as instances of the forwarder and distinguished from the other collectors by the
+		// canary label
+		utils.AddLabels(&ds.Spec.Template.ObjectMeta, map[string]string{LabelCanary: "true"})
+		ds.Spec.Selector.MatchLabels[constants.LabelK8sInstance] = f.ResourceNames.ForwarderName
+		ds.Spec.Selector.MatchLabels[LabelCanary] = "true"
+		for key, value := range rollout.CanaryNodeSelector {
+			podSpec.NodeSelector[key] = value
+		}
+		return
+	}
+	podSpec.Affinity = canaryNodesExclusion(rollout.CanaryNodeSelector)
+}
+
+// excludeCanaryNodes removes the collectors of the primary daemonset from the canary nodes without changing their
+// config, so the logs of the canary nodes are not collected by both collectors while the canary is evaluated
+func (f *Factory) excludeCanaryNodes(k8sClient client.Client, primary *apps.DaemonSet) error {
+	affinity := canaryNodesExclusion(f.CollectorSpec.Rollout.CanaryNodeSelector)
+	if reflect.DeepEqual(primary.Spec.Template.Spec.Affinity, affinity) {
+		return nil
+	}
+	log.V(3).Info("Excluding the canary nodes from the collector daemonset", "name", primary.Name)
+	primary.Spec.Template.Spec.Affinity = affinity
+	return k8sClient.Update(context.TODO(), primary)
+}
+
+// canaryNodesExclusion is the affinity of the collectors which are not scheduled on the canary nodes
+func canaryNodesExclusion(canaryNodeSelector map[string]string) *v1.Affinity {
+	// A node is excluded when it matches every label of the canary node selector
+	keys := make([]string, 0, len(canaryNodeSelector))
+	for key := range canaryNodeSelector {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	terms := []v1.NodeSelectorTerm{}
+	for _, key := range keys {
+		terms = append(terms, v1.NodeSelectorTerm{
+			MatchExpressions: []v1.NodeSelectorRequirement{
+				{Key: key, Operator: v1.NodeSelectorOpNotIn, Values: []string{canaryNodeSelector[key]}},
+			},
+		})
+	}
+	return &v1.Affinity{
+		NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{NodeSelectorTerms: terms},
+		},
+	}
+}
+
+// canaryStarted is the time the config of the given hash was rolled out to the canary collectors
+func canaryStarted(configMap *v1.ConfigMap, hash string, now time.Time) time.Time {
+	if configMap.Annotations[AnnotationCanaryConfigHash] == hash {
+		if started, err := time.Parse(time.RFC3339, configMap.Annotations[AnnotationCanaryStarted]); err == nil {
+			return started
+		}
+	}
+	return now
+}
+
+// appliedConfigHash is the hash of the collector config deployed by a collector daemonset
+func appliedConfigHash(ds *apps.DaemonSet) string {
+	for _, container := range ds.Spec.Template.Spec.Containers {
+		if container.Name != constants.CollectorName {
+			continue
+		}
+		for _, env := range container.Env {
+			if env.Name == "COLLECTOR_CONF_HASH" {
+				return env.Value
+			}
+		}
+	}
+	return ""
+}
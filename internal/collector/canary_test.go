@@ -0,0 +1,228 @@
+package collector
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type fakeScraper map[string]string
+
+func (s fakeScraper) Scrape(_ context.Context, pod corev1.Pod) ([]byte, error) {
+	return []byte(s[pod.Name]), nil
+}
+
+var _ = Describe("Factory#ReconcileCanaryRollout", func() {
+
+	const (
+		name    = "my-forwarder"
+		canary  = name + "-canary"
+		initial = "[sources.initial]"
+		updated = "[sources.updated]"
+	)
+
+	var (
+		k8sClient client.Client
+		scraper   fakeScraper
+		owner     metav1.OwnerReference
+		now       time.Time
+
+		newFactory = func(hash string) *Factory {
+			forwarder := obs.ClusterLogForwarder{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.OpenshiftNS}}
+			collectorSpec := &obs.CollectorSpec{
+				Rollout: &obs.CollectorRolloutSpec{
+					CanaryNodeSelector: map[string]string{"canary": "true"},
+					BakeTime:           utils.GetPtr(time.Duration(300)),
+				},
+			}
+			return New(hash, "clusterid", collectorSpec, nil, nil, forwarder.Spec, coreFactory.ResourceNames(forwarder), true, "")
+		}
+		rollout = func(hash, config string, at time.Time) error {
+			return newFactory(hash).ReconcileCanaryRollout(k8sClient, k8sClient, scraper, constants.OpenshiftNS, config, nil, owner, at)
+		}
+		getDaemonSet = func(name string) *apps.DaemonSet {
+			ds := &apps.DaemonSet{}
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: name}, ds)).To(Succeed())
+			return ds
+		}
+		getConfig = func(name string) *corev1.ConfigMap {
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: name}, configMap)).To(Succeed())
+			return configMap
+		}
+		setCanaryStatus = func(desired, available int32) {
+			ds := getDaemonSet(canary)
+			ds.Status = apps.DaemonSetStatus{
+				ObservedGeneration:     ds.Generation,
+				DesiredNumberScheduled: desired,
+				UpdatedNumberScheduled: available,
+				NumberAvailable:        available,
+			}
+			Expect(k8sClient.Status().Update(context.TODO(), ds)).To(Succeed())
+		}
+	)
+
+	BeforeEach(func() {
+		k8sClient = fake.NewClientBuilder().WithStatusSubresource(&apps.DaemonSet{}).Build()
+		scraper = fakeScraper{}
+		owner = utils.AsOwner(&obs.ClusterLogForwarder{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.OpenshiftNS}})
+		now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		Expect(rollout("initial", initial, now)).To(Succeed())
+	})
+
+	It("should deploy the collectors when no collector is deployed", func() {
+		Expect(getConfig(name + "-config").Data).To(HaveKeyWithValue("vector.toml", initial))
+		Expect(getConfig(name + "-config-canary").Data).To(HaveKeyWithValue("vector.toml", initial))
+		Expect(appliedConfigHash(getDaemonSet(name))).To(Equal("initial"))
+		Expect(appliedConfigHash(getDaemonSet(canary))).To(Equal("initial"))
+	})
+
+	It("should place the canary collectors on the canary nodes only", func() {
+		canaryDS := getDaemonSet(canary)
+		Expect(canaryDS.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("canary", "true"))
+		Expect(canaryDS.Spec.Template.Labels).To(HaveKeyWithValue(LabelCanary, "true"))
+		Expect(canaryDS.Spec.Selector.MatchLabels).To(HaveKeyWithValue(LabelCanary, "true"))
+		for key, value := range canaryDS.Spec.Selector.MatchLabels {
+			Expect(canaryDS.Spec.Template.Labels).To(HaveKeyWithValue(key, value), "Exp. the selector to match the pod template")
+		}
+		configMaps := []string{}
+		for _, volume := range canaryDS.Spec.Template.Spec.Volumes {
+			if volume.ConfigMap != nil {
+				configMaps = append(configMaps, volume.ConfigMap.Name)
+			}
+		}
+		Expect(configMaps).To(ContainElement(name + "-config-canary"))
+
+		primary := getDaemonSet(name)
+		Expect(primary.Spec.Template.Spec.NodeSelector).ToNot(HaveKey("canary"))
+		Expect(primary.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(Equal([]corev1.NodeSelectorTerm{
+			{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "canary", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"true"}}}},
+		}))
+	})
+
+	It("should roll out a change to the canary collectors only until the bake time elapses", func() {
+		Expect(rollout("updated", updated, now)).To(MatchError(ErrCanaryRolloutPending))
+		setCanaryStatus(1, 1)
+		Expect(rollout("updated", updated, now.Add(time.Minute))).To(MatchError(ErrCanaryRolloutPending))
+
+		Expect(getConfig(name + "-config-canary").Data).To(HaveKeyWithValue("vector.toml", updated))
+		Expect(appliedConfigHash(getDaemonSet(canary))).To(Equal("updated"))
+		Expect(getConfig(name + "-config").Data).To(HaveKeyWithValue("vector.toml", initial))
+		Expect(appliedConfigHash(getDaemonSet(name))).To(Equal("initial"))
+	})
+
+	It("should exclude the canary nodes from the collectors deployed before the rollout was enabled", func() {
+		primary := getDaemonSet(name)
+		primary.Spec.Template.Spec.Affinity = nil
+		Expect(k8sClient.Update(context.TODO(), primary)).To(Succeed())
+
+		Expect(rollout("updated", updated, now)).To(MatchError(ErrCanaryRolloutPending))
+		primary = getDaemonSet(name)
+		Expect(appliedConfigHash(primary)).To(Equal("initial"))
+		Expect(primary.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(Equal([]corev1.NodeSelectorTerm{
+			{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "canary", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"true"}}}},
+		}))
+	})
+
+	It("should roll out a change to all collectors when the canary collectors remain healthy for the bake time", func() {
+		Expect(rollout("updated", updated, now)).To(MatchError(ErrCanaryRolloutPending))
+		setCanaryStatus(1, 1)
+		Expect(rollout("updated", updated, now.Add(5*time.Minute))).To(Succeed())
+
+		Expect(getConfig(name + "-config").Data).To(HaveKeyWithValue("vector.toml", updated))
+		Expect(appliedConfigHash(getDaemonSet(name))).To(Equal("updated"))
+	})
+
+	It("should roll back the canary collectors when a collector restarts", func() {
+		Expect(rollout("updated", updated, now)).To(MatchError(ErrCanaryRolloutPending))
+		setCanaryStatus(1, 1)
+		pod := runtime.NewPod(constants.OpenshiftNS, canary+"-abcde", corev1.Container{Name: constants.CollectorName})
+		pod.Labels = getDaemonSet(canary).Spec.Selector.MatchLabels
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: constants.CollectorName, RestartCount: 2}}
+		Expect(k8sClient.Create(context.TODO(), pod)).To(Succeed())
+
+		err := rollout("updated", updated, now.Add(time.Minute))
+		Expect(err).To(BeAssignableToTypeOf(&CanaryRolloutError{}))
+		Expect(err.Error()).To(ContainSubstring("restarted 2 times"))
+		Expect(getConfig(name + "-config-canary").Data).To(HaveKeyWithValue("vector.toml", initial))
+		Expect(getConfig(name + "-config-canary").Annotations).To(HaveKeyWithValue(AnnotationCanaryFailedHash, "updated"))
+		Expect(appliedConfigHash(getDaemonSet(canary))).To(Equal("initial"))
+
+		By("not retrying the change once rolled back")
+		Expect(rollout("updated", updated, now.Add(10*time.Minute))).To(BeAssignableToTypeOf(&CanaryRolloutError{}))
+		Expect(getConfig(name + "-config").Data).To(HaveKeyWithValue("vector.toml", initial))
+	})
+
+	It("should roll back the canary collectors when their sinks fail to deliver records", func() {
+		Expect(rollout("updated", updated, now)).To(MatchError(ErrCanaryRolloutPending))
+		setCanaryStatus(1, 1)
+		pod := runtime.NewPod(constants.OpenshiftNS, canary+"-abcde", corev1.Container{Name: constants.CollectorName})
+		pod.Labels = getDaemonSet(canary).Spec.Selector.MatchLabels
+		Expect(k8sClient.Create(context.TODO(), pod)).To(Succeed())
+		scraper[pod.Name] = `
+# TYPE vector_component_errors_total counter
+vector_component_errors_total{component_id="output_es",component_kind="sink",component_type="elasticsearch",error_type="request_failed"} 12
+`
+		err := rollout("updated", updated, now.Add(time.Minute))
+		Expect(err).To(BeAssignableToTypeOf(&CanaryRolloutError{}))
+		Expect(err.Error()).To(ContainSubstring("the sinks output_es of pod " + pod.Name + " failed to deliver any records"))
+		Expect(getConfig(name + "-config").Data).To(HaveKeyWithValue("vector.toml", initial))
+	})
+
+	It("should roll back the canary collectors when they are not available within the bake time", func() {
+		Expect(rollout("updated", updated, now)).To(MatchError(ErrCanaryRolloutPending))
+		setCanaryStatus(1, 0)
+		Expect(rollout("updated", updated, now.Add(time.Minute))).To(MatchError(ErrCanaryRolloutPending))
+		Expect(rollout("updated", updated, now.Add(5*time.Minute))).To(MatchError(ContainSubstring("did not become available")))
+	})
+
+	It("should roll back when no nodes match the canary node selector", func() {
+		Expect(rollout("updated", updated, now)).To(MatchError(ErrCanaryRolloutPending))
+		setCanaryStatus(0, 0)
+		Expect(rollout("updated", updated, now.Add(time.Minute))).To(MatchError(ErrCanaryRolloutPending))
+		Expect(rollout("updated", updated, now.Add(5*time.Minute))).To(MatchError(ContainSubstring("no nodes match the canary node selector")))
+	})
+
+	It("should remove the canary collector", func() {
+		Expect(newFactory("initial").RemoveCanary(k8sClient, constants.OpenshiftNS)).To(Succeed())
+		err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: canary}, &apps.DaemonSet{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+		err = k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: name + "-config-canary"}, &corev1.ConfigMap{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+})
+
+var _ = Describe("#failingSinks", func() {
+	It("should return the sinks which reported errors without delivering any records", func() {
+		metrics := `
+# TYPE vector_component_errors_total counter
+vector_component_errors_total{component_id="output_es",component_kind="sink",error_type="request_failed"} 3
+vector_component_errors_total{component_id="output_loki",component_kind="sink",error_type="request_failed"} 1
+vector_component_errors_total{component_id="input_app_container",component_kind="source",error_type="reader_failed"} 5
+# TYPE vector_component_sent_events_total counter
+vector_component_sent_events_total{component_id="output_loki",component_kind="sink"} 100
+vector_component_sent_events_total{component_id="output_http",component_kind="sink"} 100
+`
+		Expect(failingSinks(strings.NewReader(metrics))).To(Equal([]string{"output_es"}))
+	})
+
+	It("should fail for invalid metrics", func() {
+		_, err := failingSinks(strings.NewReader("vector_component_errors_total{ 1"))
+		Expect(err).ToNot(BeNil())
+	})
+})
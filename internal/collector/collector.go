@@ -7,6 +7,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/collector/common"
 	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"sort"
 	"strings"
 
 	configv1 "github.com/openshift/api/config/v1"
@@ -15,6 +16,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/collector/vector"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/reconcile"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -29,6 +31,7 @@ const (
 	metricsVolumePath               = "/etc/collector/metrics"
 	saTokenVolumeName               = "sa-token"
 	saTokenExpirationSecs           = 3600 //1 hour
+	secretsStoreCSIDriver           = "secrets-store.csi.k8s.io"
 	sourcePodsName                  = "varlogpods"
 	sourcePodsPath                  = "/var/log/pods"
 	sourceJournalName               = "varlogjournal"
@@ -43,6 +46,7 @@ const (
 	sourceOpenshiftAPIServerPath    = "/var/log/openshift-apiserver"
 	sourceKubeAPIServerName         = "varlogkubeapiserver"
 	sourceKubeAPIServerPath         = "/var/log/kube-apiserver"
+	outputSocketVolumePrefix        = "outputsocket"
 	tmpVolumeName                   = "tmp"
 	tmpPath                         = "/tmp"
 )
@@ -64,7 +68,16 @@ type Factory struct {
 	PodLabelVisitor        PodLabelVisitor
 	ResourceNames          *factory.ForwarderResourceNames
 	isDaemonset            bool
+	canary                 bool
+	shard                  string
+	priorityClassName      string
+	replicas               int32
+	persistentQueue        *obs.AggregatorPersistentQueueSpec
 	LogLevel               string
+	// Drift records modifications of the collector config and workload made outside of the operator
+	Drift *reconcile.DriftDetector
+	// Provenance identifies the forwarder which produced the collector config
+	Provenance *Provenance
 }
 
 // CollectorResourceRequirements returns the resource requirements for a given collector implementation
@@ -83,6 +96,14 @@ func (f *Factory) Tolerations() []v1.Toleration {
 	return f.CollectorSpec.Tolerations
 }
 
+// PriorityClassName returns the priority class of the collector pods
+func (f *Factory) PriorityClassName() string {
+	if f.priorityClassName != "" {
+		return f.priorityClassName
+	}
+	return clusterLoggingPriorityClassName
+}
+
 func New(confHash, clusterID string, collectorSpec *obs.CollectorSpec, secrets map[string]*v1.Secret, configMaps map[string]*v1.ConfigMap, forwarderSpec obs.ClusterLogForwarderSpec, resNames *factory.ForwarderResourceNames, isDaemonset bool, logLevel string) *Factory {
 	if collectorSpec == nil {
 		collectorSpec = &obs.CollectorSpec{}
@@ -110,12 +131,18 @@ func New(confHash, clusterID string, collectorSpec *obs.CollectorSpec, secrets m
 func (f *Factory) NewDaemonSet(namespace, name string, trustedCABundle *v1.ConfigMap, tlsProfileSpec configv1.TLSProfileSpec) *apps.DaemonSet {
 	podSpec := f.NewPodSpec(trustedCABundle, f.ForwarderSpec, f.ClusterID, tlsProfileSpec, namespace)
 	ds := factory.NewDaemonSet(namespace, name, name, constants.CollectorName, constants.VectorName, *podSpec, f.CommonLabelInitializer, f.PodLabelVisitor)
+	f.placeCanary(ds)
+	f.placeShard(ds)
+	f.attachNetworks(&ds.Spec.Template.ObjectMeta)
+	f.attachMesh(&ds.Spec.Template.ObjectMeta)
 	return ds
 }
 
 func (f *Factory) NewDeployment(namespace, name string, trustedCABundle *v1.ConfigMap, tlsProfileSpec configv1.TLSProfileSpec) *apps.Deployment {
 	podSpec := f.NewPodSpec(trustedCABundle, f.ForwarderSpec, f.ClusterID, tlsProfileSpec, namespace)
-	dpl := factory.NewDeployment(namespace, name, constants.CollectorName, constants.VectorName, 2, *podSpec, f.CommonLabelInitializer, f.PodLabelVisitor)
+	dpl := factory.NewDeployment(namespace, name, constants.CollectorName, constants.VectorName, f.deploymentReplicas(), *podSpec, f.CommonLabelInitializer, f.PodLabelVisitor)
+	f.attachNetworks(&dpl.Spec.Template.ObjectMeta)
+	f.attachMesh(&dpl.Spec.Template.ObjectMeta)
 	return dpl
 }
 
@@ -123,12 +150,12 @@ func (f *Factory) NewPodSpec(trustedCABundle *v1.ConfigMap, spec obs.ClusterLogF
 
 	podSpec := &v1.PodSpec{
 		NodeSelector:                  utils.EnsureLinuxNodeSelector(f.NodeSelector()),
-		PriorityClassName:             clusterLoggingPriorityClassName,
+		PriorityClassName:             f.PriorityClassName(),
 		ServiceAccountName:            f.ResourceNames.ServiceAccount,
 		TerminationGracePeriodSeconds: utils.GetPtr[int64](10),
 		Tolerations:                   append(constants.DefaultTolerations(), f.Tolerations()...),
 		Volumes: []v1.Volume{
-			{Name: metricsVolumeName, VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: f.ResourceNames.SecretMetrics}}},
+			{Name: metricsVolumeName, VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: f.metricsSecretName()}}},
 			{Name: tmpVolumeName, VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{Medium: v1.StorageMediumMemory}}},
 		},
 	}
@@ -143,9 +170,21 @@ func (f *Factory) NewPodSpec(trustedCABundle *v1.ConfigMap, spec obs.ClusterLogF
 			v1.Volume{Name: sourceOpenshiftAPIServerName, VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: sourceOpenshiftAPIServerPath}}},
 			v1.Volume{Name: sourceKubeAPIServerName, VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: sourceKubeAPIServerPath}}},
 		)
+		for i, dir := range internalobs.Outputs(spec.Outputs).SocketDirs() {
+			podSpec.Volumes = append(podSpec.Volumes,
+				v1.Volume{Name: outputSocketVolumeName(i), VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: dir}}})
+		}
 	}
 
-	secretVolumes := AddSecretVolumes(podSpec, f.Secrets)
+	providerClasses := internalobs.Outputs(spec.Outputs).SecretProviderClassKeys()
+	secrets := vectorhelpers.Secrets{}
+	for name, secret := range f.Secrets {
+		if _, found := providerClasses[name]; !found {
+			secrets[name] = secret
+		}
+	}
+	secretVolumes := AddSecretVolumes(podSpec, secrets)
+	secretVolumes = append(secretVolumes, AddSecretProviderClassVolumes(podSpec, providerClasses)...)
 	configmapVolumes := AddConfigmapVolumes(podSpec, f.ConfigMaps)
 	if internalobs.Outputs(spec.Outputs).NeedServiceAccountToken() {
 		AddServiceAccountProjectedVolume(podSpec, defaultAudience)
@@ -210,6 +249,10 @@ func (f *Factory) NewCollectorContainer(inputs internalobs.Inputs, outputs inter
 		if inputs.HasAuditSource(obs.AuditSourceOVN) {
 			collector.VolumeMounts = append(collector.VolumeMounts, v1.VolumeMount{Name: sourceAuditOVNName, ReadOnly: true, MountPath: sourceOVNPath})
 		}
+		// the sockets of node-local receivers are written to, so their directories are not mounted read-only
+		for i, dir := range outputs.SocketDirs() {
+			collector.VolumeMounts = append(collector.VolumeMounts, v1.VolumeMount{Name: outputSocketVolumeName(i), MountPath: dir})
+		}
 		AddSecurityContextTo(collector)
 	}
 
@@ -228,6 +271,11 @@ func (f *Factory) NewCollectorContainer(inputs internalobs.Inputs, outputs inter
 	return collector
 }
 
+// outputSocketVolumeName is the name of the volume of the node directory holding the unix domain socket of an output
+func outputSocketVolumeName(index int) string {
+	return fmt.Sprintf("%s%d", outputSocketVolumePrefix, index)
+}
+
 func sanitizeVolumeName(input string) string {
 	return strings.ReplaceAll(input, ".", "")
 }
@@ -264,6 +312,28 @@ func AddSecretVolumes(podSpec *v1.PodSpec, secrets vectorhelpers.Secrets) []stri
 	return names
 }
 
+// AddSecretProviderClassVolumes adds Secrets Store CSI driver volumes to the pod spec for the given SecretProviderClasses
+// and returns the list of the names
+func AddSecretProviderClassVolumes(podSpec *v1.PodSpec, providerClasses map[string][]string) (names []string) {
+	for name := range providerClasses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		podSpec.Volumes = append(podSpec.Volumes, v1.Volume{
+			Name: sanitizeVolumeName(name),
+			VolumeSource: v1.VolumeSource{
+				CSI: &v1.CSIVolumeSource{
+					Driver:           secretsStoreCSIDriver,
+					ReadOnly:         utils.GetPtr(true),
+					VolumeAttributes: map[string]string{"secretProviderClass": name},
+				},
+			},
+		})
+	}
+	return names
+}
+
 // AddConfigmapVolumes adds configmap volumes to the pod spec for the unique set of configmaps and returns the list of
 // the named volumes where the names are of the format 'config-<ConfigMap.Name>'
 func AddConfigmapVolumes(podSpec *v1.PodSpec, configMaps internalobs.ConfigMaps) (results []string) {
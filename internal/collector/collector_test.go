@@ -323,6 +323,19 @@ var _ = Describe("Factory#Daemonset#NewPodSpec", func() {
 						},
 					}))
 			})
+			It("should mount the node directory of the unix domain socket of an output", func() {
+				podSpec = *factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
+					Outputs: []obs.OutputSpec{
+						{
+							Name:   "siem",
+							Type:   obs.OutputTypeSyslog,
+							Syslog: &obs.Syslog{URL: "unix:///var/run/siem/syslog.sock"},
+						},
+					},
+				}, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+				Expect(podSpec.Volumes).To(IncludeVolume(v1.Volume{Name: "outputsocket0", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/var/run/siem"}}}))
+				Expect(podSpec.Containers[0].VolumeMounts).To(IncludeVolumeMount(v1.VolumeMount{Name: "outputsocket0", MountPath: "/var/run/siem"}))
+			})
 		})
 	})
 
@@ -727,3 +740,51 @@ var _ = Describe("Factory#NewPodSpec Add Cloudwatch STS Resources", func() {
 		})
 	})
 })
+
+var _ = Describe("Factory#NewPodSpec Add SecretProviderClass volumes", func() {
+
+	const spcName = "vault-creds"
+
+	It("should mount the objects of the SecretProviderClass instead of a secret", func() {
+		factory := &Factory{
+			ImageName:     constants.VectorName,
+			Visit:         vector.CollectorVisitor,
+			ResourceNames: coreFactory.ResourceNames(*obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, constants.SingletonName, runtime.Initialize)),
+			Secrets: map[string]*v1.Secret{
+				spcName: {Data: map[string][]byte{"password": {}}},
+			},
+		}
+		podSpec := *factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
+			Outputs: []obs.OutputSpec{
+				{
+					Name:                "http",
+					Type:                obs.OutputTypeHTTP,
+					SecretProviderClass: spcName,
+					HTTP: &obs.HTTP{
+						Authentication: &obs.HTTPAuthentication{
+							Password: &obs.SecretReference{Key: "password", SecretName: spcName},
+						},
+					},
+				},
+			},
+		}, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+		Expect(podSpec.Volumes).To(IncludeVolume(
+			v1.Volume{
+				Name: spcName,
+				VolumeSource: v1.VolumeSource{
+					CSI: &v1.CSIVolumeSource{
+						Driver:           secretsStoreCSIDriver,
+						ReadOnly:         utils.GetPtr(true),
+						VolumeAttributes: map[string]string{"secretProviderClass": spcName},
+					},
+				},
+			}))
+		for _, v := range podSpec.Volumes {
+			Expect(v.Secret == nil || v.Secret.SecretName != spcName).To(BeTrue(), "Exp. no secret volume for the SecretProviderClass")
+		}
+		Expect(podSpec.Containers[0].VolumeMounts).To(IncludeVolumeMount(v1.VolumeMount{
+			Name:      spcName,
+			ReadOnly:  true,
+			MountPath: path.Join(constants.CollectorSecretsDir, spcName)}))
+	})
+})
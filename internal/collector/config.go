@@ -8,6 +8,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	"github.com/openshift/cluster-logging-operator/internal/utils/comparators"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -15,6 +16,14 @@ import (
 // ReconcileCollectorConfig reconciles a collector config specifically for the collector defined by the factory
 func (f *Factory) ReconcileCollectorConfig(k8sClient client.Client, reader client.Reader, namespace, collectorConfig string, owner metav1.OwnerReference) error {
 	log.V(3).Info("Updating ConfigMap and Secrets")
+	configMap := f.NewCollectorConfig(namespace, collectorConfig)
+	utils.AddOwnerRefToObject(configMap, owner)
+	f.Provenance.keepSignature(reader, configMap)
+	return reconcile.Configmap(k8sClient, reader, configMap, f.Drift, comparators.CompareLabels, comparators.CompareAnnotations)
+}
+
+// NewCollectorConfig returns the configmap of the collector config and entrypoint script
+func (f *Factory) NewCollectorConfig(namespace, collectorConfig string) *corev1.ConfigMap {
 	configMap := runtime.NewConfigMap(
 		namespace,
 		f.ResourceNames.ConfigMap,
@@ -23,7 +32,6 @@ func (f *Factory) ReconcileCollectorConfig(k8sClient client.Client, reader clien
 			vector.RunVectorFile: fmt.Sprintf(vector.RunVectorScript, vector.GetDataPath(namespace, f.ResourceNames.ForwarderName)),
 		},
 		f.CommonLabelInitializer)
-
-	utils.AddOwnerRefToObject(configMap, owner)
-	return reconcile.Configmap(k8sClient, reader, configMap, comparators.CompareLabels)
+	f.Provenance.annotate(configMap, collectorConfig)
+	return configMap
 }
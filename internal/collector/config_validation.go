@@ -0,0 +1,154 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	"github.com/openshift/cluster-logging-operator/internal/collector/vector"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	configValidatorComponent = "config-validator"
+	configValidationVolume   = "config"
+	configValidationDataPath = "data"
+
+	configValidationDeadlineSeconds int64 = 300
+)
+
+// ErrConfigValidationPending is returned while the job validating the collector config has not completed
+var ErrConfigValidationPending = errors.New("collector config validation is pending")
+
+// ConfigValidationError is returned when the collector config fails validation
+type ConfigValidationError struct {
+	Namespace string
+	Job       string
+}
+
+func (e *ConfigValidationError) Error() string {
+	return fmt.Sprintf("collector config failed validation, see the logs of job %s/%s for details", e.Namespace, e.Job)
+}
+
+// ConfigValidationJobName is the name of the job which validates the collector config of the given hash
+func (f *Factory) ConfigValidationJobName() string {
+	hash := f.ConfigHash
+	if len(hash) > 10 {
+		hash = hash[:10]
+	}
+	return fmt.Sprintf("%s-validate-%s", f.ResourceNames.CommonName, hash)
+}
+
+// NewConfigValidationJob returns a job which validates the collector config stored in the configmap of the same name
+func (f *Factory) NewConfigValidationJob(namespace, name string) *batchv1.Job {
+	dataPath := vector.GetDataPath(namespace, f.ResourceNames.ForwarderName)
+	container := runtime.NewContainer(configValidatorComponent, utils.GetComponentImage(f.ImageName), corev1.PullIfNotPresent, nil)
+	container.Command = []string{"sh", "-c"}
+	container.Args = []string{fmt.Sprintf("mkdir -p %s && vector validate --no-environment %s", dataPath, path.Join("/etc/vector", vector.ConfigFile))}
+	container.VolumeMounts = []corev1.VolumeMount{
+		{Name: configValidationVolume, ReadOnly: true, MountPath: "/etc/vector"},
+		{Name: configValidationDataPath, MountPath: vector.DefaultDataPath},
+	}
+	container.SecurityContext = &corev1.SecurityContext{
+		AllowPrivilegeEscalation: utils.GetPtr(false),
+		RunAsNonRoot:             utils.GetPtr(true),
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+		SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+
+	job := &batchv1.Job{}
+	runtime.Initialize(job, namespace, name, f.configValidatorLabels)
+	job.Spec = batchv1.JobSpec{
+		BackoffLimit:          utils.GetPtr[int32](0),
+		ActiveDeadlineSeconds: utils.GetPtr(configValidationDeadlineSeconds),
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: job.Labels},
+			Spec: corev1.PodSpec{
+				Containers:    []corev1.Container{*container},
+				RestartPolicy: corev1.RestartPolicyNever,
+				NodeSelector:  f.NodeSelector(),
+				Tolerations:   f.Tolerations(),
+				Volumes: []corev1.Volume{
+					{Name: configValidationVolume, VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: name}}}},
+					{Name: configValidationDataPath, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+				},
+			},
+		},
+	}
+	return job
+}
+
+func (f *Factory) configValidatorLabels(o runtime.Object) {
+	runtime.SetCommonLabels(o, constants.VectorName, f.ResourceNames.ForwarderName, configValidatorComponent)
+}
+
+// ValidateCollectorConfig validates the collector config by running a job before it is rolled out to the collector.
+// It returns ErrConfigValidationPending until the job completes and a ConfigValidationError if validation fails.
+// Jobs for previous configs are removed once a config is validated
+func (f *Factory) ValidateCollectorConfig(k8sClient client.Client, namespace, collectorConfig string, owner metav1.OwnerReference) error {
+	name := f.ConfigValidationJobName()
+	job := &batchv1.Job{}
+	if err := k8sClient.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: name}, job); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		return f.createConfigValidationJob(k8sClient, namespace, name, collectorConfig, owner)
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return f.removeStaleConfigValidationJobs(k8sClient, namespace, name)
+		case batchv1.JobFailed:
+			return &ConfigValidationError{Namespace: namespace, Job: name}
+		}
+	}
+	return ErrConfigValidationPending
+}
+
+func (f *Factory) createConfigValidationJob(k8sClient client.Client, namespace, name, collectorConfig string, owner metav1.OwnerReference) error {
+	log.V(3).Info("Creating collector config validation job", "namespace", namespace, "name", name)
+	job := f.NewConfigValidationJob(namespace, name)
+	utils.AddOwnerRefToObject(job, owner)
+	if err := k8sClient.Create(context.TODO(), job); err != nil {
+		return err
+	}
+	// The configmap is owned by the job so it is removed with it
+	configMap := runtime.NewConfigMap(namespace, name, map[string]string{vector.ConfigFile: collectorConfig}, f.configValidatorLabels)
+	utils.AddOwnerRefToObject(configMap, utils.AsOwner(job))
+	if err := k8sClient.Create(context.TODO(), configMap); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return ErrConfigValidationPending
+}
+
+func (f *Factory) removeStaleConfigValidationJobs(k8sClient client.Client, namespace, current string) error {
+	jobs := &batchv1.JobList{}
+	if err := k8sClient.List(context.TODO(), jobs, client.InNamespace(namespace), client.MatchingLabels{
+		constants.LabelK8sInstance:  f.ResourceNames.ForwarderName,
+		constants.LabelK8sComponent: configValidatorComponent,
+	}); err != nil {
+		return err
+	}
+	for i, job := range jobs.Items {
+		if job.Name == current {
+			continue
+		}
+		log.V(3).Info("Removing stale collector config validation job", "namespace", namespace, "name", job.Name)
+		if err := k8sClient.Delete(context.TODO(), &jobs.Items[i], client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package collector
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Factory#ValidateCollectorConfig", func() {
+
+	const (
+		name   = "my-forwarder"
+		config = "[sources.foo]"
+	)
+
+	var (
+		k8sClient client.Client
+		owner     metav1.OwnerReference
+
+		newFactory = func(hash string) *Factory {
+			forwarder := obs.ClusterLogForwarder{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.OpenshiftNS}}
+			return New(hash, "clusterid", nil, nil, nil, forwarder.Spec, coreFactory.ResourceNames(forwarder), true, "")
+		}
+		getJob = func(name string) (*batchv1.Job, error) {
+			job := &batchv1.Job{}
+			return job, k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: name}, job)
+		}
+		completeJob = func(name string, condition batchv1.JobConditionType) {
+			job, err := getJob(name)
+			Expect(err).To(BeNil())
+			job.Status.Conditions = []batchv1.JobCondition{{Type: condition, Status: corev1.ConditionTrue}}
+			Expect(k8sClient.Status().Update(context.TODO(), job)).To(Succeed())
+		}
+	)
+
+	BeforeEach(func() {
+		k8sClient = fake.NewClientBuilder().WithStatusSubresource(&batchv1.Job{}).Build()
+		owner = utils.AsOwner(&obs.ClusterLogForwarder{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.OpenshiftNS}})
+	})
+
+	It("should create a job and configmap to validate the config", func() {
+		factory := newFactory("0123456789abcdef")
+		Expect(factory.ValidateCollectorConfig(k8sClient, constants.OpenshiftNS, config, owner)).To(MatchError(ErrConfigValidationPending))
+
+		job, err := getJob(name + "-validate-0123456789")
+		Expect(err).To(BeNil())
+		Expect(job.OwnerReferences).To(ContainElement(owner))
+		Expect(job.Labels).To(HaveKeyWithValue(constants.LabelK8sComponent, configValidatorComponent))
+		container := job.Spec.Template.Spec.Containers[0]
+		Expect(container.Args).To(Equal([]string{"mkdir -p /var/lib/vector/openshift-logging/my-forwarder && vector validate --no-environment /etc/vector/vector.toml"}))
+
+		configMap := &corev1.ConfigMap{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: job.Name}, configMap)).To(Succeed())
+		Expect(configMap.Data).To(HaveKeyWithValue("vector.toml", config))
+		Expect(configMap.OwnerReferences[0].Name).To(Equal(job.Name))
+	})
+
+	It("should remain pending until the job completes", func() {
+		factory := newFactory("0123456789abcdef")
+		Expect(factory.ValidateCollectorConfig(k8sClient, constants.OpenshiftNS, config, owner)).To(MatchError(ErrConfigValidationPending))
+		Expect(factory.ValidateCollectorConfig(k8sClient, constants.OpenshiftNS, config, owner)).To(MatchError(ErrConfigValidationPending))
+	})
+
+	It("should fail when the job fails", func() {
+		factory := newFactory("0123456789abcdef")
+		Expect(factory.ValidateCollectorConfig(k8sClient, constants.OpenshiftNS, config, owner)).To(MatchError(ErrConfigValidationPending))
+		completeJob(factory.ConfigValidationJobName(), batchv1.JobFailed)
+		err := factory.ValidateCollectorConfig(k8sClient, constants.OpenshiftNS, config, owner)
+		Expect(err).To(MatchError(&ConfigValidationError{Namespace: constants.OpenshiftNS, Job: factory.ConfigValidationJobName()}))
+	})
+
+	It("should succeed when the job completes and remove jobs for previous configs", func() {
+		previous := newFactory("previous")
+		Expect(previous.ValidateCollectorConfig(k8sClient, constants.OpenshiftNS, config, owner)).To(MatchError(ErrConfigValidationPending))
+		completeJob(previous.ConfigValidationJobName(), batchv1.JobComplete)
+
+		factory := newFactory("0123456789abcdef")
+		Expect(factory.ValidateCollectorConfig(k8sClient, constants.OpenshiftNS, config, owner)).To(MatchError(ErrConfigValidationPending))
+		completeJob(factory.ConfigValidationJobName(), batchv1.JobComplete)
+		Expect(factory.ValidateCollectorConfig(k8sClient, constants.OpenshiftNS, config, owner)).To(Succeed())
+
+		_, err := getJob(previous.ConfigValidationJobName())
+		Expect(errors.IsNotFound(err)).To(BeTrue(), "Exp. the job for the previous config to be removed")
+	})
+})
@@ -20,14 +20,27 @@ func (f *Factory) ReconcileDaemonset(k8sClient client.Client, namespace string,
 	tlsProfile, _ := tls.FetchAPIServerTlsProfile(k8sClient)
 	desired := f.NewDaemonSet(namespace, f.ResourceNames.DaemonSetName(), trustedCABundle, tls.GetClusterTLSProfileSpec(tlsProfile))
 	utils.AddOwnerRefToObject(desired, owner)
-	return reconcile.DaemonSet(k8sClient, desired)
+	return reconcile.DaemonSet(k8sClient, desired, f.Drift)
 }
 
 func Remove(k8sClient client.Client, namespace, name string) (err error) {
 	log.V(3).Info("Removing collector", "namespace", namespace, "name", name)
-	ds := runtime.NewDaemonSet(namespace, name)
-	if err = k8sClient.Delete(context.TODO(), ds); err != nil && !errors.IsNotFound(err) {
-		return fmt.Errorf("Failure deleting daemonset %s/%s: %v", namespace, name, err)
+	shards, err := deployedShards(k8sClient, namespace, name)
+	if err != nil {
+		return err
 	}
-	return nil
+	dsNames := []string{name, name + canarySuffix}
+	for _, shard := range shards {
+		dsNames = append(dsNames, name+"-"+shard)
+	}
+	for _, dsName := range dsNames {
+		ds := runtime.NewDaemonSet(namespace, dsName)
+		if err = k8sClient.Delete(context.TODO(), ds); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("Failure deleting daemonset %s/%s: %v", namespace, dsName, err)
+		}
+	}
+	if err = RemoveDeployment(k8sClient, namespace, name+aggregatorSuffix); err != nil {
+		return err
+	}
+	return RemoveStatefulSet(k8sClient, namespace, name+aggregatorSuffix)
 }
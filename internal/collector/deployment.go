@@ -15,12 +15,22 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const defaultDeploymentReplicas = int32(2)
+
 // ReconcileDeployment reconciles a deployment specifically for the collector defined by the factory
 func (f *Factory) ReconcileDeployment(k8sClient client.Client, namespace string, trustedCABundle *corev1.ConfigMap, owner metav1.OwnerReference) error {
 	tlsProfile, _ := tls.FetchAPIServerTlsProfile(k8sClient)
 	desired := f.NewDeployment(namespace, f.ResourceNames.DaemonSetName(), trustedCABundle, tls.GetClusterTLSProfileSpec(tlsProfile))
 	utils.AddOwnerRefToObject(desired, owner)
-	return reconcile.Deployment(k8sClient, desired)
+	return reconcile.Deployment(k8sClient, desired, f.Drift)
+}
+
+// deploymentReplicas returns the number of pods of the collector when deployed as a deployment
+func (f *Factory) deploymentReplicas() int32 {
+	if f.replicas > 0 {
+		return f.replicas
+	}
+	return defaultDeploymentReplicas
 }
 
 func RemoveDeployment(k8sClient client.Client, namespace, name string) (err error) {
@@ -16,7 +16,8 @@ import (
 // ReconcileInputServices evaluates receiver inputs and deploys services for them
 func (f *Factory) ReconcileInputServices(k8sClient kubernetes.Client, k8sReader kubernetes.Reader, namespace string, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
 
-	if err := RemoveOrphanedInputServices(k8sClient, k8sReader, namespace, f.ForwarderSpec, *f.ResourceNames, owner, true); err != nil {
+	// Services of defined inputs are updated in place so the node ports and load balancer of exposed receivers are kept
+	if err := RemoveOrphanedInputServices(k8sClient, k8sReader, namespace, f.ForwarderSpec, *f.ResourceNames, owner); err != nil {
 		return err
 	}
 
@@ -25,7 +26,7 @@ func (f *Factory) ReconcileInputServices(k8sClient kubernetes.Client, k8sReader
 		serviceName := f.ResourceNames.GenerateInputServiceName(input.Name)
 		if input.Receiver != nil {
 			listenPort = input.Receiver.Port
-			if err := network.ReconcileInputService(k8sClient, namespace, serviceName, f.ResourceNames.CommonName, serviceName, listenPort, listenPort, input.Receiver.Type, owner, visitors); err != nil {
+			if err := network.ReconcileInputService(k8sClient, namespace, serviceName, f.ResourceNames.CommonName, serviceName, listenPort, listenPort, *input.Receiver, owner, visitors); err != nil {
 				return err
 			}
 		}
@@ -34,7 +35,7 @@ func (f *Factory) ReconcileInputServices(k8sClient kubernetes.Client, k8sReader
 }
 
 // RemoveOrphanedInputServices removes receiver input services not owned by the given owner
-func RemoveOrphanedInputServices(client kubernetes.Client, reader kubernetes.Reader, namespace string, spec obs.ClusterLogForwarderSpec, resourceNames factory.ForwarderResourceNames, currOwner metav1.OwnerReference, removeAllServices bool) error {
+func RemoveOrphanedInputServices(client kubernetes.Client, reader kubernetes.Reader, namespace string, spec obs.ClusterLogForwarderSpec, resourceNames factory.ForwarderResourceNames, currOwner metav1.OwnerReference) error {
 
 	for _, receiverType := range obs.ReceiverTypes {
 		// Get list of input services by label/ namespace
@@ -53,10 +54,13 @@ func RemoveOrphanedInputServices(client kubernetes.Client, reader kubernetes.Rea
 
 		// Remove services only if owned by current CLF and isn't defined
 		for _, item := range services.Items {
-			if utils.HasSameOwner(item.OwnerReferences, []metav1.OwnerReference{currOwner}) && (!inputs.Has(item.Name) || removeAllServices) {
+			if utils.HasSameOwner(item.OwnerReferences, []metav1.OwnerReference{currOwner}) && !inputs.Has(item.Name) {
 				if err := service.Delete(client, item.Namespace, item.Name); err != nil {
 					return err
 				}
+				if err := network.RemoveInputRoute(client, item.Namespace, item.Name); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -0,0 +1,82 @@
+package collector
+
+import (
+	"bufio"
+	"context"
+	"regexp"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/collector/vector"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/set"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// componentHeader matches the table header of a top level component, e.g. [sinks.output_es] but not
+	// [sinks.output_es.tls]
+	componentHeader = regexp.MustCompile(`^\[(sources|transforms|sinks)\.([^.\]]+)\]$`)
+	componentType   = regexp.MustCompile(`^type\s*=\s*"([^"]+)"`)
+)
+
+// Inventory returns the types of the sources, transforms and sinks of a generated collector config deployed to the
+// named workload
+func Inventory(name, config string) obs.CollectorInventory {
+	kinds := map[string]set.Set[string]{
+		"sources":    set.New[string](),
+		"transforms": set.New[string](),
+		"sinks":      set.New[string](),
+	}
+	// kind is the kind of the component whose table is being scanned until its type is found
+	kind := ""
+	scanner := bufio.NewScanner(strings.NewReader(config))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") {
+			kind = ""
+			if match := componentHeader.FindStringSubmatch(line); match != nil {
+				kind = match[1]
+			}
+			continue
+		}
+		if kind == "" {
+			continue
+		}
+		if match := componentType.FindStringSubmatch(line); match != nil {
+			kinds[kind].Insert(match[1])
+			kind = ""
+		}
+	}
+	inventory := obs.CollectorInventory{Name: name}
+	if types := kinds["sources"]; types.Len() > 0 {
+		inventory.Sources = types.SortedList()
+	}
+	if types := kinds["transforms"]; types.Len() > 0 {
+		inventory.Transforms = types.SortedList()
+	}
+	if types := kinds["sinks"]; types.Len() > 0 {
+		inventory.Sinks = types.SortedList()
+	}
+	return inventory
+}
+
+// DeployedInventory returns the inventory of the collector config deployed to the workload of the factory and, while a
+// canary is rolled out, of the config deployed to the canary collectors.  The deployed configs differ from the
+// generated config while the canary is evaluated, after a rollback or when drift of the config is not corrected
+func (f *Factory) DeployedInventory(reader client.Reader, namespace string) ([]obs.CollectorInventory, error) {
+	inventory := []obs.CollectorInventory{}
+	for _, names := range []*factory.ForwarderResourceNames{f.ResourceNames, f.canaryNames()} {
+		configMap := &corev1.ConfigMap{}
+		if err := reader.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: names.ConfigMap}, configMap); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		inventory = append(inventory, Inventory(names.DaemonSetName(), configMap.Data[vector.ConfigFile]))
+	}
+	return inventory, nil
+}
@@ -0,0 +1,111 @@
+package collector
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Inventory", func() {
+
+	const config = `
+[sources.internal_metrics]
+type = "internal_metrics"
+
+[sources.input_application_container]
+type = "kubernetes_logs"
+max_read_bytes = 3145728
+
+[transforms.input_application_container_meta]
+type = "remap"
+inputs = ["input_application_container"]
+
+[transforms.output_es_route]
+type = "route"
+inputs = ["input_application_container_meta"]
+
+[transforms.output_es_dedot]
+type = "remap"
+inputs = ["output_es_route"]
+
+[sinks.output_es]
+type = "elasticsearch"
+inputs = ["output_es_dedot"]
+
+[sinks.output_es.tls]
+type = "not_a_component"
+
+[sinks.prometheus_output]
+type = "prometheus_exporter"
+inputs = ["internal_metrics"]
+`
+
+	It("should list the sorted unique types of the components of the config", func() {
+		Expect(Inventory("my-forwarder", config)).To(Equal(obs.CollectorInventory{
+			Name:       "my-forwarder",
+			Sources:    []string{"internal_metrics", "kubernetes_logs"},
+			Transforms: []string{"remap", "route"},
+			Sinks:      []string{"elasticsearch", "prometheus_exporter"},
+		}))
+	})
+
+	It("should only name the workload of an empty config", func() {
+		Expect(Inventory("my-forwarder", "")).To(Equal(obs.CollectorInventory{Name: "my-forwarder"}))
+	})
+
+	Context("#DeployedInventory", func() {
+
+		const (
+			deployed = `
+[sources.input_application_container]
+type = "kubernetes_logs"
+
+[sinks.output_es]
+type = "elasticsearch"
+inputs = ["input_application_container"]
+`
+			evaluated = `
+[sources.input_application_container]
+type = "kubernetes_logs"
+
+[sinks.output_http]
+type = "http"
+inputs = ["input_application_container"]
+`
+		)
+
+		var f *Factory
+
+		BeforeEach(func() {
+			forwarder := obs.ClusterLogForwarder{ObjectMeta: metav1.ObjectMeta{Name: "my-forwarder", Namespace: constants.OpenshiftNS}}
+			f = New("hash", "clusterid", nil, nil, nil, forwarder.Spec, coreFactory.ResourceNames(forwarder), true, "")
+		})
+
+		It("should list the components of the deployed config instead of the generated one", func() {
+			k8sClient := fake.NewFakeClient(runtime.NewConfigMap(constants.OpenshiftNS, "my-forwarder-config", map[string]string{"vector.toml": deployed}))
+			Expect(f.DeployedInventory(k8sClient, constants.OpenshiftNS)).To(Equal([]obs.CollectorInventory{
+				{Name: "my-forwarder", Sources: []string{"kubernetes_logs"}, Sinks: []string{"elasticsearch"}},
+			}))
+		})
+
+		It("should list the components of the config evaluated by the canary collectors", func() {
+			k8sClient := fake.NewFakeClient(
+				runtime.NewConfigMap(constants.OpenshiftNS, "my-forwarder-config", map[string]string{"vector.toml": deployed}),
+				runtime.NewConfigMap(constants.OpenshiftNS, "my-forwarder-config-canary", map[string]string{"vector.toml": evaluated}),
+			)
+			Expect(f.DeployedInventory(k8sClient, constants.OpenshiftNS)).To(Equal([]obs.CollectorInventory{
+				{Name: "my-forwarder", Sources: []string{"kubernetes_logs"}, Sinks: []string{"elasticsearch"}},
+				{Name: "my-forwarder-canary", Sources: []string{"kubernetes_logs"}, Sinks: []string{"http"}},
+			}))
+		})
+
+		It("should be empty when no config is deployed", func() {
+			Expect(f.DeployedInventory(fake.NewFakeClient(), constants.OpenshiftNS)).To(BeEmpty())
+		})
+	})
+})
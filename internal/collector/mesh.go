@@ -0,0 +1,35 @@
+package collector
+
+import (
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AnnotationMeshInject is the annotation by which the mesh injects its proxy sidecar into a pod
+	AnnotationMeshInject = "sidecar.istio.io/inject"
+	// AnnotationMeshProxyConfig is the annotation overriding the configuration of the proxy sidecar of a pod
+	AnnotationMeshProxyConfig = "proxy.istio.io/config"
+	// AnnotationMeshExcludeInboundPorts is the annotation of the ports of a pod which are not intercepted by the proxy
+	AnnotationMeshExcludeInboundPorts = "traffic.sidecar.istio.io/excludeInboundPorts"
+
+	holdApplicationUntilProxyStarts = `{"holdApplicationUntilProxyStarts": true}`
+)
+
+// attachMesh annotates the collector pod template for the injection of the proxy sidecar of the mesh.  The metrics
+// port is excluded from the mesh so that it can be scraped by the cluster monitoring stack which is outside the mesh
+func (f *Factory) attachMesh(template *metav1.ObjectMeta) {
+	mesh := f.CollectorSpec.Mesh
+	if mesh == nil {
+		return
+	}
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[AnnotationMeshInject] = "true"
+	template.Annotations[AnnotationMeshExcludeInboundPorts] = strconv.Itoa(int(MetricsPort))
+	if !mesh.SkipHoldUntilProxyStarts {
+		template.Annotations[AnnotationMeshProxyConfig] = holdApplicationUntilProxyStarts
+	}
+}
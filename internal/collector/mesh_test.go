@@ -0,0 +1,39 @@
+package collector
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/tls"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Factory#attachMesh", func() {
+
+	var (
+		newFactory = func(isDaemonSet bool, mesh *obs.CollectorMeshSpec) *Factory {
+			forwarder := obs.ClusterLogForwarder{ObjectMeta: metav1.ObjectMeta{Name: "my-forwarder", Namespace: constants.OpenshiftNS}}
+			return New("hash", "clusterid", &obs.CollectorSpec{Mesh: mesh}, nil, nil, forwarder.Spec, coreFactory.ResourceNames(forwarder), isDaemonSet, "")
+		}
+	)
+
+	It("should annotate the collector daemonset pods for the injection of the proxy held until it starts", func() {
+		ds := newFactory(true, &obs.CollectorMeshSpec{}).NewDaemonSet(constants.OpenshiftNS, "my-forwarder", nil, tls.GetClusterTLSProfileSpec(nil))
+		Expect(ds.Spec.Template.Annotations).To(HaveKeyWithValue(AnnotationMeshInject, "true"))
+		Expect(ds.Spec.Template.Annotations).To(HaveKeyWithValue(AnnotationMeshProxyConfig, `{"holdApplicationUntilProxyStarts": true}`))
+		Expect(ds.Spec.Template.Annotations).To(HaveKeyWithValue(AnnotationMeshExcludeInboundPorts, "24231"))
+	})
+
+	It("should not hold the collector deployment pods when skipped", func() {
+		dpl := newFactory(false, &obs.CollectorMeshSpec{SkipHoldUntilProxyStarts: true}).NewDeployment(constants.OpenshiftNS, "my-forwarder", nil, tls.GetClusterTLSProfileSpec(nil))
+		Expect(dpl.Spec.Template.Annotations).To(HaveKeyWithValue(AnnotationMeshInject, "true"))
+		Expect(dpl.Spec.Template.Annotations).ToNot(HaveKey(AnnotationMeshProxyConfig))
+	})
+
+	It("should not annotate the collector pods when the mesh is not defined", func() {
+		ds := newFactory(true, nil).NewDaemonSet(constants.OpenshiftNS, "my-forwarder", nil, tls.GetClusterTLSProfileSpec(nil))
+		Expect(ds.Spec.Template.Annotations).ToNot(HaveKey(AnnotationMeshInject))
+	})
+})
@@ -0,0 +1,48 @@
+package collector
+
+import (
+	"context"
+	"strconv"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// MetricsScraper scrapes the metrics exposed by a collector pod
+type MetricsScraper interface {
+	Scrape(ctx context.Context, pod corev1.Pod) ([]byte, error)
+}
+
+// PodProxyScraper scrapes the metrics of collector pods through the pod proxy of the API server
+type PodProxyScraper struct {
+	Client kubernetes.Interface
+}
+
+func (s PodProxyScraper) Scrape(ctx context.Context, pod corev1.Pod) ([]byte, error) {
+	return s.Client.CoreV1().Pods(pod.Namespace).
+		ProxyGet("https", pod.Name, strconv.Itoa(int(MetricsPort)), "metrics", nil).
+		DoRaw(ctx)
+}
+
+// ServingCertSecretName returns the name of the secret of the certificate served by the metrics endpoint of the
+// collector when it is provided instead of issued by the service CA
+func ServingCertSecretName(spec *obs.CollectorSpec) string {
+	if spec == nil || spec.Metrics == nil {
+		return ""
+	}
+	return spec.Metrics.ServingCertSecretName
+}
+
+// UserWorkloadMonitoring returns true when the metrics of the collector are scraped by the user workload monitoring stack
+func UserWorkloadMonitoring(spec *obs.CollectorSpec) bool {
+	return spec != nil && spec.Metrics != nil && spec.Metrics.Monitoring == obs.MetricsMonitoringUserWorkload
+}
+
+// metricsSecretName returns the name of the secret of the certificate served by the metrics endpoint of the collector
+func (f *Factory) metricsSecretName() string {
+	if name := ServingCertSecretName(&f.CollectorSpec); name != "" {
+		return name
+	}
+	return f.ResourceNames.SecretMetrics
+}
@@ -0,0 +1,41 @@
+package collector
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/tls"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Factory#metricsSecretName", func() {
+
+	var (
+		newFactory = func(metrics *obs.CollectorMetricsSpec) *Factory {
+			forwarder := obs.ClusterLogForwarder{ObjectMeta: metav1.ObjectMeta{Name: "my-forwarder", Namespace: constants.OpenshiftNS}}
+			return New("hash", "clusterid", &obs.CollectorSpec{Metrics: metrics}, nil, nil, forwarder.Spec, coreFactory.ResourceNames(forwarder), true, "")
+		}
+		metricsVolume = func(f *Factory) *corev1.SecretVolumeSource {
+			ds := f.NewDaemonSet(constants.OpenshiftNS, "my-forwarder", nil, tls.GetClusterTLSProfileSpec(nil))
+			for _, v := range ds.Spec.Template.Spec.Volumes {
+				if v.Name == metricsVolumeName {
+					return v.Secret
+				}
+			}
+			return nil
+		}
+	)
+
+	It("should serve the certificate issued by the service CA by default", func() {
+		Expect(metricsVolume(newFactory(nil)).SecretName).To(Equal("my-forwarder-metrics"))
+	})
+
+	It("should serve the provided certificate", func() {
+		f := newFactory(&obs.CollectorMetricsSpec{ServingCertSecretName: "my-cert"})
+		Expect(metricsVolume(f).SecretName).To(Equal("my-cert"))
+		Expect(metricsVolume(f.ForAggregator("hash")).SecretName).To(Equal("my-forwarder-metrics-aggregator"), "Exp. the aggregator to serve the certificate verified by the collectors")
+	})
+})
@@ -0,0 +1,38 @@
+package collector
+
+import (
+	"encoding/json"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationNetworks is the annotation by which Multus attaches secondary networks to a pod
+const AnnotationNetworks = "k8s.v1.cni.cncf.io/networks"
+
+// networkSelection is an element of the Multus network selection annotation
+type networkSelection struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Interface string `json:"interface,omitempty"`
+}
+
+// attachNetworks annotates the collector pod template to attach the secondary networks of the collector
+func (f *Factory) attachNetworks(template *metav1.ObjectMeta) {
+	if len(f.CollectorSpec.Networks) == 0 {
+		return
+	}
+	selections := make([]networkSelection, 0, len(f.CollectorSpec.Networks))
+	for _, network := range f.CollectorSpec.Networks {
+		selections = append(selections, networkSelection(network))
+	}
+	value, err := json.Marshal(selections)
+	if err != nil {
+		log.Error(err, "unable to marshal the collector networks")
+		return
+	}
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[AnnotationNetworks] = string(value)
+}
@@ -0,0 +1,38 @@
+package collector
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/tls"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Factory#attachNetworks", func() {
+
+	var (
+		newFactory = func(isDaemonSet bool, networks ...obs.NetworkAttachment) *Factory {
+			forwarder := obs.ClusterLogForwarder{ObjectMeta: metav1.ObjectMeta{Name: "my-forwarder", Namespace: constants.OpenshiftNS}}
+			return New("hash", "clusterid", &obs.CollectorSpec{Networks: networks}, nil, nil, forwarder.Spec, coreFactory.ResourceNames(forwarder), isDaemonSet, "")
+		}
+	)
+
+	It("should attach the networks to the collector daemonset pods", func() {
+		factory := newFactory(true, obs.NetworkAttachment{Name: "logging"}, obs.NetworkAttachment{Name: "audit", Namespace: "net", Interface: "audit0"})
+		ds := factory.NewDaemonSet(constants.OpenshiftNS, "my-forwarder", nil, tls.GetClusterTLSProfileSpec(nil))
+		Expect(ds.Spec.Template.Annotations).To(HaveKeyWithValue(AnnotationNetworks, `[{"name":"logging"},{"name":"audit","namespace":"net","interface":"audit0"}]`))
+	})
+
+	It("should attach the networks to the collector deployment pods", func() {
+		factory := newFactory(false, obs.NetworkAttachment{Name: "logging"})
+		dpl := factory.NewDeployment(constants.OpenshiftNS, "my-forwarder", nil, tls.GetClusterTLSProfileSpec(nil))
+		Expect(dpl.Spec.Template.Annotations).To(HaveKeyWithValue(AnnotationNetworks, `[{"name":"logging"}]`))
+	})
+
+	It("should not attach networks when none are defined", func() {
+		ds := newFactory(true).NewDaemonSet(constants.OpenshiftNS, "my-forwarder", nil, tls.GetClusterTLSProfileSpec(nil))
+		Expect(ds.Spec.Template.Annotations).ToNot(HaveKey(AnnotationNetworks))
+	})
+})
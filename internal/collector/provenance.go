@@ -0,0 +1,124 @@
+package collector
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationForwarderGeneration is the configmap annotation of the generation of the forwarder which produced the
+	// collector config
+	AnnotationForwarderGeneration = "observability.openshift.io/forwarder-generation"
+	// AnnotationConfigDigest is the configmap annotation of the hex encoded SHA-256 digest of the collector config
+	AnnotationConfigDigest = "observability.openshift.io/config-sha256"
+	// AnnotationConfigSignature is the configmap annotation of the base64 encoded ASN.1 ECDSA signature of the SHA-256
+	// digest of the collector config.  The signature is verifiable with `cosign verify-blob` or `openssl dgst -verify`
+	AnnotationConfigSignature = "observability.openshift.io/config-signature"
+
+	// SigningKey is the key of the secret holding the PEM encoded ECDSA private key which signs the collector config
+	SigningKey = "signing.key"
+)
+
+// Provenance identifies the forwarder which produced a collector config and optionally signs the config
+type Provenance struct {
+	// Generation is the generation of the forwarder spec from which the config was generated
+	Generation int64
+	// Signer signs the digest of the config.  The config is not signed when nil
+	Signer crypto.Signer
+}
+
+// annotate records the provenance of the collector config on its configmap
+func (p *Provenance) annotate(configMap *corev1.ConfigMap, collectorConfig string) {
+	if p == nil {
+		return
+	}
+	if configMap.Annotations == nil {
+		configMap.Annotations = map[string]string{}
+	}
+	digest := sha256.Sum256([]byte(collectorConfig))
+	configMap.Annotations[AnnotationForwarderGeneration] = strconv.FormatInt(p.Generation, 10)
+	configMap.Annotations[AnnotationConfigDigest] = hex.EncodeToString(digest[:])
+	if p.Signer == nil {
+		return
+	}
+	signature, err := p.Signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		log.V(0).Error(err, "Unable to sign the collector config", "configmap", configMap.Name)
+		return
+	}
+	configMap.Annotations[AnnotationConfigSignature] = base64.StdEncoding.EncodeToString(signature)
+}
+
+// keepSignature keeps the signature of the current configmap of the collector config when it still signs the digest
+// of the desired config with the signing key.  ECDSA signatures are randomized, so a config which is signed again
+// would otherwise differ from the current configmap on every reconciliation
+func (p *Provenance) keepSignature(reader client.Reader, configMap *corev1.ConfigMap) {
+	if p == nil || p.Signer == nil {
+		return
+	}
+	current := &corev1.ConfigMap{}
+	if err := reader.Get(context.TODO(), client.ObjectKeyFromObject(configMap), current); err != nil {
+		return
+	}
+	if current.Annotations[AnnotationConfigDigest] != configMap.Annotations[AnnotationConfigDigest] {
+		return
+	}
+	digest, err := hex.DecodeString(current.Annotations[AnnotationConfigDigest])
+	if err != nil {
+		return
+	}
+	signature, err := base64.StdEncoding.DecodeString(current.Annotations[AnnotationConfigSignature])
+	if err != nil {
+		return
+	}
+	if key, ok := p.Signer.Public().(*ecdsa.PublicKey); ok && ecdsa.VerifyASN1(key, digest, signature) {
+		configMap.Annotations[AnnotationConfigSignature] = current.Annotations[AnnotationConfigSignature]
+	}
+}
+
+// FetchSigner returns the signer of the ECDSA private key held by the signing.key of a secret
+func FetchSigner(k8sClient client.Reader, namespace, name string) (crypto.Signer, error) {
+	secret := &corev1.Secret{}
+	if err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		return nil, fmt.Errorf("unable to fetch the config signing secret %q: %v", name, err)
+	}
+	signer, err := ParseSigner(secret.Data[SigningKey])
+	if err != nil {
+		return nil, fmt.Errorf("secret %q: %v", name, err)
+	}
+	return signer, nil
+}
+
+// ParseSigner parses a PEM encoded ECDSA private key in PKCS #8 or SEC 1 form
+func ParseSigner(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s is not a PEM encoded private key", SigningKey)
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not an ECDSA private key: %v", SigningKey, err)
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an ECDSA private key", SigningKey)
+	}
+	return ecKey, nil
+}
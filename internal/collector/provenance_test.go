@@ -0,0 +1,108 @@
+package collector
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/reconcile"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Factory#NewCollectorConfig provenance", func() {
+
+	const collectorConfig = "[sources.internal_metrics]\ntype = \"internal_metrics\"\n"
+
+	var (
+		factory *Factory
+		digest  = sha256.Sum256([]byte(collectorConfig))
+	)
+
+	BeforeEach(func() {
+		forwarder := obs.ClusterLogForwarder{ObjectMeta: metav1.ObjectMeta{Name: "my-forwarder", Namespace: constants.OpenshiftNS}}
+		factory = New("hash", "clusterid", nil, nil, nil, forwarder.Spec, coreFactory.ResourceNames(forwarder), true, "")
+	})
+
+	It("should not annotate the config without provenance", func() {
+		configMap := factory.NewCollectorConfig(constants.OpenshiftNS, collectorConfig)
+		Expect(configMap.Annotations).ToNot(HaveKey(AnnotationConfigDigest))
+	})
+
+	It("should record the generation of the forwarder and the digest of the config", func() {
+		factory.Provenance = &Provenance{Generation: 7}
+		configMap := factory.NewCollectorConfig(constants.OpenshiftNS, collectorConfig)
+		Expect(configMap.Annotations).To(HaveKeyWithValue(AnnotationForwarderGeneration, "7"))
+		Expect(configMap.Annotations).To(HaveKeyWithValue(AnnotationConfigDigest, hex.EncodeToString(digest[:])))
+		Expect(configMap.Annotations).ToNot(HaveKey(AnnotationConfigSignature))
+	})
+
+	It("should sign the digest of the config with the signing key", func() {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		Expect(err).ToNot(HaveOccurred())
+		signer, err := ParseSigner(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+		Expect(err).ToNot(HaveOccurred())
+
+		factory.Provenance = &Provenance{Generation: 7, Signer: signer}
+		configMap := factory.NewCollectorConfig(constants.OpenshiftNS, collectorConfig)
+		signature, err := base64.StdEncoding.DecodeString(configMap.Annotations[AnnotationConfigSignature])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ecdsa.VerifyASN1(&key.PublicKey, digest[:], signature)).To(BeTrue())
+	})
+
+	It("should sign an unchanged config when signing is enabled and keep the signature while it is valid", func() {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		k8sClient := fake.NewClientBuilder().Build()
+		owner := metav1.OwnerReference{Name: "my-forwarder"}
+		current := func() *corev1.ConfigMap {
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: factory.ResourceNames.ConfigMap}, configMap)).To(Succeed())
+			return configMap
+		}
+
+		factory.Drift = &reconcile.DriftDetector{Policy: reconcile.DriftPolicyReport}
+		factory.Provenance = &Provenance{Generation: 7}
+		Expect(factory.ReconcileCollectorConfig(k8sClient, k8sClient, constants.OpenshiftNS, collectorConfig, owner)).To(Succeed())
+		Expect(current().Annotations).ToNot(HaveKey(AnnotationConfigSignature))
+
+		factory.Provenance = &Provenance{Generation: 7, Signer: key}
+		Expect(factory.ReconcileCollectorConfig(k8sClient, k8sClient, constants.OpenshiftNS, collectorConfig, owner)).To(Succeed())
+		Expect(factory.Drift.Drifts).To(BeEmpty())
+		signature := current().Annotations[AnnotationConfigSignature]
+		Expect(signature).ToNot(BeEmpty())
+		resourceVersion := current().ResourceVersion
+
+		Expect(factory.ReconcileCollectorConfig(k8sClient, k8sClient, constants.OpenshiftNS, collectorConfig, owner)).To(Succeed())
+		Expect(current().Annotations).To(HaveKeyWithValue(AnnotationConfigSignature, signature))
+		Expect(current().ResourceVersion).To(Equal(resourceVersion), "expected the configmap not to be updated")
+
+		rotated, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		factory.Provenance = &Provenance{Generation: 7, Signer: rotated}
+		Expect(factory.ReconcileCollectorConfig(k8sClient, k8sClient, constants.OpenshiftNS, collectorConfig, owner)).To(Succeed())
+		resigned, err := base64.StdEncoding.DecodeString(current().Annotations[AnnotationConfigSignature])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ecdsa.VerifyASN1(&rotated.PublicKey, digest[:], resigned)).To(BeTrue())
+	})
+
+	It("should fail to parse a signing key which is not an ECDSA private key", func() {
+		_, err := ParseSigner([]byte("not a key"))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,114 @@
+package collector
+
+import (
+	"context"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	apps "k8s.io/api/apps/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/set"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LabelCollectorShard identifies the daemonset of a collector shard by the name of the shard
+const LabelCollectorShard = "observability.openshift.io/collector-shard"
+
+// ShardResourceNames returns the names of the resources of the collector of a shard.  The collector of a shard is
+// deployed as a forwarder of its own whose name is suffixed with the name of the shard
+func ShardResourceNames(names *factory.ForwarderResourceNames, shard string) *factory.ForwarderResourceNames {
+	shardNames := *names
+	suffix := "-" + shard
+	shardNames.CommonName += suffix
+	shardNames.ConfigMap += suffix
+	shardNames.SecretMetrics += suffix
+	shardNames.ForwarderName += suffix
+	return &shardNames
+}
+
+// ForShard returns the factory of the collector of a shard which forwards the logs defined by the given spec
+func (f *Factory) ForShard(shard obs.CollectorShardSpec, confHash string, forwarderSpec obs.ClusterLogForwarderSpec) *Factory {
+	names := ShardResourceNames(f.ResourceNames, shard.Name)
+	collectorSpec := f.CollectorSpec
+	collectorSpec.Shards = nil
+	collectorSpec.VerticalPodAutoscaler = nil
+	if shard.Resources != nil {
+		collectorSpec.Resources = shard.Resources
+	}
+	if shard.NodeSelector != nil {
+		collectorSpec.NodeSelector = shard.NodeSelector
+	}
+	if shard.Tolerations != nil {
+		collectorSpec.Tolerations = shard.Tolerations
+	}
+
+	sf := *f
+	sf.ConfigHash = confHash
+	sf.CollectorSpec = collectorSpec
+	sf.ForwarderSpec = forwarderSpec
+	sf.ResourceNames = names
+	sf.CommonLabelInitializer = func(o runtime.Object) {
+		runtime.SetCommonLabels(o, constants.VectorName, names.ForwarderName, constants.CollectorName)
+	}
+	sf.shard = shard.Name
+	sf.priorityClassName = shard.PriorityClassName
+	return &sf
+}
+
+// placeShard labels the daemonset of the collector of a shard so it can be removed with the shard
+func (f *Factory) placeShard(ds *apps.DaemonSet) {
+	if f.shard == "" {
+		return
+	}
+	utils.AddLabels(&ds.ObjectMeta, map[string]string{LabelCollectorShard: f.shard})
+}
+
+// RemoveShards removes the collectors of the shards of the forwarder which are not retained
+func RemoveShards(k8sClient client.Client, reader client.Reader, namespace string, names *factory.ForwarderResourceNames, retain set.Set[string]) error {
+	shards, err := deployedShards(reader, namespace, names.ForwarderName)
+	if err != nil {
+		return err
+	}
+	for _, shard := range shards {
+		if retain.Has(shard) {
+			continue
+		}
+		log.V(3).Info("Removing collector shard", "namespace", namespace, "shard", shard)
+		shardNames := ShardResourceNames(names, shard)
+		policy := &networkingv1.NetworkPolicy{}
+		runtime.Initialize(policy, namespace, shardNames.CommonName)
+		for _, o := range []client.Object{
+			runtime.NewDaemonSet(namespace, shardNames.DaemonSetName()),
+			runtime.NewConfigMap(namespace, shardNames.ConfigMap, nil),
+			runtime.NewService(namespace, shardNames.CommonName),
+			runtime.NewServiceMonitor(namespace, shardNames.CommonName),
+			policy,
+		} {
+			if err := k8sClient.Delete(context.TODO(), o); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deployedShards returns the names of the shards whose collector daemonsets are deployed for the forwarder
+func deployedShards(reader client.Reader, namespace, forwarderName string) ([]string, error) {
+	daemonSets := &apps.DaemonSetList{}
+	if err := reader.List(context.TODO(), daemonSets, client.InNamespace(namespace), client.HasLabels{LabelCollectorShard}); err != nil {
+		return nil, err
+	}
+	shards := []string{}
+	for _, ds := range daemonSets.Items {
+		shard := ds.Labels[LabelCollectorShard]
+		if ds.Name == forwarderName+"-"+shard {
+			shards = append(shards, shard)
+		}
+	}
+	return shards, nil
+}
@@ -0,0 +1,108 @@
+package collector
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/tls"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/set"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Factory#ForShard", func() {
+
+	const name = "my-forwarder"
+
+	var (
+		primary *Factory
+		shard   = obs.CollectorShardSpec{
+			Name:              "audit",
+			InputTypes:        []obs.InputType{obs.InputTypeAudit},
+			NodeSelector:      map[string]string{"node-role.kubernetes.io/master": ""},
+			PriorityClassName: "audit-priority",
+			Resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		forwarder := obs.ClusterLogForwarder{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.OpenshiftNS}}
+		collectorSpec := &obs.CollectorSpec{
+			Tolerations: []corev1.Toleration{{Key: "primary", Operator: corev1.TolerationOpExists}},
+			Shards:      []obs.CollectorShardSpec{shard},
+		}
+		primary = New("primaryhash", "clusterid", collectorSpec, nil, nil, forwarder.Spec, coreFactory.ResourceNames(forwarder), true, "")
+	})
+
+	It("should deploy the collector of the shard as a forwarder of its own", func() {
+		f := primary.ForShard(shard, "shardhash", obs.ClusterLogForwarderSpec{})
+		Expect(f.ResourceNames.DaemonSetName()).To(Equal(name + "-audit"))
+		Expect(f.ResourceNames.ConfigMap).To(Equal(name + "-config-audit"))
+		Expect(f.ResourceNames.SecretMetrics).To(Equal(name + "-metrics-audit"))
+		Expect(primary.ResourceNames.DaemonSetName()).To(Equal(name), "Exp. the names of the primary collector to be unchanged")
+
+		ds := f.NewDaemonSet(constants.OpenshiftNS, f.ResourceNames.DaemonSetName(), nil, tls.GetClusterTLSProfileSpec(nil))
+		Expect(ds.Labels).To(HaveKeyWithValue(LabelCollectorShard, "audit"))
+		Expect(ds.Labels).To(HaveKeyWithValue(constants.LabelK8sInstance, name+"-audit"))
+		Expect(ds.Spec.Selector.MatchLabels).To(HaveKeyWithValue(constants.LabelK8sInstance, name+"-audit"))
+		podSpec := ds.Spec.Template.Spec
+		Expect(podSpec.PriorityClassName).To(Equal("audit-priority"))
+		Expect(podSpec.NodeSelector).To(HaveKey("node-role.kubernetes.io/master"))
+		Expect(podSpec.Tolerations).To(ContainElement(corev1.Toleration{Key: "primary", Operator: corev1.TolerationOpExists}), "Exp. the tolerations of the collector when not defined for the shard")
+		Expect(podSpec.Containers[0].Resources.Limits.Memory().String()).To(Equal("4Gi"))
+	})
+
+	It("should use the default priority class for the primary collector", func() {
+		Expect(primary.PriorityClassName()).To(Equal(clusterLoggingPriorityClassName))
+	})
+})
+
+var _ = Describe("#RemoveShards", func() {
+
+	const name = "my-forwarder"
+
+	var (
+		k8sClient client.Client
+		names     = &coreFactory.ForwarderResourceNames{CommonName: name, ConfigMap: name + "-config", ForwarderName: name}
+		shardDS   = func(dsName, shard string) *apps.DaemonSet {
+			ds := runtime.NewDaemonSet(constants.OpenshiftNS, dsName)
+			ds.Labels = map[string]string{LabelCollectorShard: shard}
+			return ds
+		}
+		exists = func(dsName string) bool {
+			return k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: dsName}, &apps.DaemonSet{}) == nil
+		}
+	)
+
+	BeforeEach(func() {
+		_ = monitoringv1.AddToScheme(scheme.Scheme)
+		k8sClient = fake.NewClientBuilder().WithObjects(
+			shardDS(name+"-audit", "audit"),
+			shardDS(name+"-infra", "infra"),
+			shardDS("other-forwarder-audit", "audit"),
+			runtime.NewConfigMap(constants.OpenshiftNS, name+"-config-infra", nil),
+		).Build()
+	})
+
+	It("should remove the collectors of the shards of the forwarder which are not retained", func() {
+		Expect(RemoveShards(k8sClient, k8sClient, constants.OpenshiftNS, names, set.New("audit"))).To(Succeed())
+		Expect(exists(name + "-audit")).To(BeTrue())
+		Expect(exists(name + "-infra")).To(BeFalse())
+		Expect(exists("other-forwarder-audit")).To(BeTrue(), "Exp. the shards of other forwarders to be kept")
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: name + "-config-infra"}, &corev1.ConfigMap{})).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,107 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var verticalPodAutoscalerGVK = schema.GroupVersionKind{
+	Group:   "autoscaling.k8s.io",
+	Version: "v1",
+	Kind:    "VerticalPodAutoscaler",
+}
+
+// NewVerticalPodAutoscaler returns a VerticalPodAutoscaler which targets the collector container of the collector workload
+func NewVerticalPodAutoscaler(namespace, name string, isDaemonSet bool, spec obs.VerticalPodAutoscalerSpec, visitor CommonLabelVisitor) *unstructured.Unstructured {
+	kind := "DaemonSet"
+	if !isDaemonSet {
+		kind = "Deployment"
+	}
+	mode := spec.UpdateMode
+	if mode == "" {
+		mode = obs.VerticalPodAutoscalerUpdateModeOff
+	}
+	vpa := &unstructured.Unstructured{}
+	vpa.SetGroupVersionKind(verticalPodAutoscalerGVK)
+	vpa.SetNamespace(namespace)
+	vpa.SetName(name)
+	vpa.Object["spec"] = map[string]interface{}{
+		"targetRef": map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       kind,
+			"name":       name,
+		},
+		"updatePolicy": map[string]interface{}{
+			"updateMode": string(mode),
+		},
+		"resourcePolicy": map[string]interface{}{
+			"containerPolicies": []interface{}{
+				map[string]interface{}{
+					"containerName":       constants.CollectorName,
+					"controlledResources": []interface{}{"cpu", "memory"},
+				},
+			},
+		},
+	}
+	visitor(vpa)
+	return vpa
+}
+
+// ReconcileVerticalPodAutoscaler reconciles the VerticalPodAutoscaler for the collector when one is defined for the
+// collector and removes it otherwise
+func (f *Factory) ReconcileVerticalPodAutoscaler(k8sClient client.Client, namespace string, owner metav1.OwnerReference) error {
+	name := f.ResourceNames.DaemonSetName()
+	if f.CollectorSpec.VerticalPodAutoscaler == nil {
+		return RemoveVerticalPodAutoscaler(k8sClient, namespace, name)
+	}
+	desired := NewVerticalPodAutoscaler(namespace, name, f.isDaemonset, *f.CollectorSpec.VerticalPodAutoscaler, f.CommonLabelInitializer)
+	utils.AddOwnerRefToObject(desired, owner)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &unstructured.Unstructured{}
+		current.SetGroupVersionKind(verticalPodAutoscalerGVK)
+		if err := k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(desired), current); err != nil {
+			if meta.IsNoMatchError(err) {
+				return fmt.Errorf("unable to create the verticalpodautoscaler for the collector because the Vertical Pod Autoscaler is not installed")
+			}
+			if errors.IsNotFound(err) {
+				return k8sClient.Create(context.TODO(), desired)
+			}
+			return fmt.Errorf("failed to get verticalpodautoscaler %s/%s: %w", namespace, name, err)
+		}
+		if equality.Semantic.DeepEqual(current.Object["spec"], desired.Object["spec"]) &&
+			equality.Semantic.DeepEqual(current.GetLabels(), desired.GetLabels()) &&
+			utils.HasSameOwner(current.GetOwnerReferences(), desired.GetOwnerReferences()) {
+			log.V(3).Info("VerticalPodAutoscaler are the same skipping update")
+			return nil
+		}
+		current.Object["spec"] = desired.Object["spec"]
+		current.SetLabels(desired.GetLabels())
+		current.SetOwnerReferences(desired.GetOwnerReferences())
+		return k8sClient.Update(context.TODO(), current)
+	})
+}
+
+// RemoveVerticalPodAutoscaler removes the VerticalPodAutoscaler for the collector if it exists
+func RemoveVerticalPodAutoscaler(k8sClient client.Client, namespace, name string) error {
+	vpa := &unstructured.Unstructured{}
+	vpa.SetGroupVersionKind(verticalPodAutoscalerGVK)
+	vpa.SetNamespace(namespace)
+	vpa.SetName(name)
+	if err := k8sClient.Delete(context.TODO(), vpa); err != nil && !errors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return fmt.Errorf("failure deleting verticalpodautoscaler %s/%s: %v", namespace, name, err)
+	}
+	return nil
+}
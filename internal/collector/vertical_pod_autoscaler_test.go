@@ -0,0 +1,77 @@
+package collector
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Factory#ReconcileVerticalPodAutoscaler", func() {
+
+	const name = "my-forwarder"
+
+	var (
+		k8sClient client.Client
+
+		newFactory = func(spec *obs.VerticalPodAutoscalerSpec, isDaemonSet bool) *Factory {
+			forwarder := obs.ClusterLogForwarder{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: constants.OpenshiftNS}}
+			return New("hash", "clusterid", &obs.CollectorSpec{VerticalPodAutoscaler: spec}, nil, nil, forwarder.Spec, coreFactory.ResourceNames(forwarder), isDaemonSet, "")
+		}
+		field = func(vpa *unstructured.Unstructured, fields ...string) string {
+			value, _, _ := unstructured.NestedString(vpa.Object, fields...)
+			return value
+		}
+		getVPA = func() (*unstructured.Unstructured, error) {
+			vpa := &unstructured.Unstructured{}
+			vpa.SetGroupVersionKind(verticalPodAutoscalerGVK)
+			return vpa, k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: name}, vpa)
+		}
+	)
+
+	BeforeEach(func() {
+		k8sClient = fake.NewClientBuilder().Build()
+	})
+
+	It("should create a VerticalPodAutoscaler targeting the collector daemonset", func() {
+		f := newFactory(&obs.VerticalPodAutoscalerSpec{UpdateMode: obs.VerticalPodAutoscalerUpdateModeInitial}, true)
+		Expect(f.ReconcileVerticalPodAutoscaler(k8sClient, constants.OpenshiftNS, metav1.OwnerReference{})).To(Succeed())
+
+		vpa, err := getVPA()
+		Expect(err).To(BeNil())
+		Expect(field(vpa, "spec", "targetRef", "kind")).To(Equal("DaemonSet"))
+		Expect(field(vpa, "spec", "targetRef", "name")).To(Equal(name))
+		Expect(field(vpa, "spec", "updatePolicy", "updateMode")).To(Equal("Initial"))
+		Expect(vpa.GetLabels()).To(HaveKeyWithValue(constants.LabelK8sComponent, constants.CollectorName))
+	})
+
+	It("should update the VerticalPodAutoscaler when the update mode or workload changes", func() {
+		Expect(newFactory(&obs.VerticalPodAutoscalerSpec{}, true).ReconcileVerticalPodAutoscaler(k8sClient, constants.OpenshiftNS, metav1.OwnerReference{})).To(Succeed())
+		vpa, err := getVPA()
+		Expect(err).To(BeNil())
+		Expect(field(vpa, "spec", "updatePolicy", "updateMode")).To(Equal("Off"))
+
+		f := newFactory(&obs.VerticalPodAutoscalerSpec{UpdateMode: obs.VerticalPodAutoscalerUpdateModeAuto}, false)
+		Expect(f.ReconcileVerticalPodAutoscaler(k8sClient, constants.OpenshiftNS, metav1.OwnerReference{})).To(Succeed())
+		vpa, err = getVPA()
+		Expect(err).To(BeNil())
+		Expect(field(vpa, "spec", "targetRef", "kind")).To(Equal("Deployment"))
+		Expect(field(vpa, "spec", "updatePolicy", "updateMode")).To(Equal("Auto"))
+	})
+
+	It("should remove the VerticalPodAutoscaler when it is no longer defined", func() {
+		Expect(newFactory(&obs.VerticalPodAutoscalerSpec{}, true).ReconcileVerticalPodAutoscaler(k8sClient, constants.OpenshiftNS, metav1.OwnerReference{})).To(Succeed())
+		Expect(newFactory(nil, true).ReconcileVerticalPodAutoscaler(k8sClient, constants.OpenshiftNS, metav1.OwnerReference{})).To(Succeed())
+		_, err := getVPA()
+		Expect(errors.IsNotFound(err)).To(BeTrue(), "Exp. the VerticalPodAutoscaler to be removed")
+	})
+})
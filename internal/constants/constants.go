@@ -11,6 +11,8 @@ const (
 	ClientCertKey      = "tls.crt"
 	ClientPrivateKey   = "tls.key"
 	TrustedCABundleKey = "ca-bundle.crt"
+	CACertKey          = "ca.crt"
+	ServiceCABundleKey = "service-ca.crt"
 	Passphrase         = "passphrase"
 
 	// Username/Password keys, used by any output with username/password authentication.
@@ -69,6 +71,13 @@ const (
 
 	// Annotation Names
 	AnnotationServingCertSecretName = "service.beta.openshift.io/serving-cert-secret-name"
+	// AnnotationInjectCABundle is the configmap annotation by which the service CA bundle is injected into the configmap
+	AnnotationInjectCABundle = "service.beta.openshift.io/inject-cabundle"
+	// AnnotationLogParser is the pod annotation selecting a parser of a namespaceParsers filter for the containers of the pod
+	AnnotationLogParser = "observability.openshift.io/log-parser"
+	// AnnotationLogsThrottled is the namespace annotation reporting the container log records of the namespace recently
+	// dropped because they exceeded the rate limit of a forwarder
+	AnnotationLogsThrottled = "observability.openshift.io/logs-throttled"
 
 	// K8s recommended label names: https://kubernetes.io/docs/concepts/overview/working-with-objects/common-labels/
 	LabelK8sName      = "app.kubernetes.io/name"       // The name of the application (string)
@@ -98,6 +107,8 @@ const (
 	HTTPReceiverPort   = 8443
 	HTTPFormat         = "kubeAPIAudit"
 	SyslogReceiverPort = 10514
+	// AggregatorPort is the port on which the aggregator receives the logs forwarded by the collectors
+	AggregatorPort = 24240
 )
 
 var ReconcileForGlobalProxyList = []string{CollectorTrustedCAName}
@@ -17,4 +17,27 @@ const (
 
 	// AnnotationOtlpOutputTechPreview is the annotation to enable tech preview of output type otlp for forwarding logs.
 	AnnotationOtlpOutputTechPreview = "observability.openshift.io/tech-preview-otlp-output"
+
+	// AnnotationWorkloadMetrics is the annotation to enable per-namespace and per-pod ingest rate metrics
+	// for container logs collected by the forwarder
+	AnnotationWorkloadMetrics = "observability.openshift.io/workload-metrics"
+
+	// AnnotationMaintenanceWindow is a cron expression, evaluated in UTC, of when a maintenance window opens.
+	// Changes to the forwarder spec are deferred until the window is open
+	AnnotationMaintenanceWindow = "observability.openshift.io/maintenance-window"
+
+	// AnnotationMaintenanceWindowDuration is the duration a maintenance window remains open. Defaults to 1h
+	AnnotationMaintenanceWindowDuration = "observability.openshift.io/maintenance-window-duration"
+
+	// AnnotationDriftPolicy determines how modifications of the collector config and workload made outside of the
+	// operator are handled. One of: Revert (default), Report
+	AnnotationDriftPolicy = "observability.openshift.io/drift-policy"
+
+	// AnnotationValidateConfig is the annotation to validate the collector config with a job before it is
+	// rolled out to the collector
+	AnnotationValidateConfig = "observability.openshift.io/validate-config"
+
+	// AnnotationConfigSigningSecret is the name of a secret in the namespace of the forwarder whose signing.key
+	// signs the collector config
+	AnnotationConfigSigningSecret = "observability.openshift.io/config-signing-secret"
 )
@@ -0,0 +1,128 @@
+package autotune
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	loggerName = "controller.autotune"
+)
+
+var (
+	// ObservationInterval is the interval between observations of the usage of the collector pods
+	ObservationInterval = time.Minute * 10
+
+	podMetricsListGVK = schema.GroupVersionKind{
+		Group:   "metrics.k8s.io",
+		Version: "v1beta1",
+		Kind:    "PodMetricsList",
+	}
+)
+
+// CollectorResourceReconciler observes the resource usage of the collector pods of a ClusterLogForwarder and records
+// the resource requirements recommended for the collector in the status of the forwarder
+type CollectorResourceReconciler struct {
+	Client client.Client
+}
+
+func (r *CollectorResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.WithName(loggerName)
+	log.V(3).Info("reconcile", "namespace", req.Namespace, "name", req.Name)
+
+	forwarder, err := observability.FetchClusterLogForwarder(r.Client, req.Namespace, req.Name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+	if forwarder.DeletionTimestamp != nil || forwarder.Spec.ManagementState == obsv1.ManagementStateUnmanaged {
+		return ctrl.Result{}, nil
+	}
+
+	usage, err := CollectorUsage(r.Client, forwarder)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			log.V(2).Info("unable to observe collector usage because the metrics API is not available")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if len(usage) == 0 {
+		return ctrl.Result{RequeueAfter: ObservationInterval}, nil
+	}
+
+	var previous *corev1.ResourceRequirements
+	if forwarder.Status.Collector != nil {
+		previous = forwarder.Status.Collector.RecommendedResources
+	}
+	forwarder.Status.Collector = &obsv1.CollectorStatus{
+		RecommendedResources: Recommend(usage, previous),
+		LastObservedTime:     &metav1.Time{Time: time.Now()},
+	}
+	log.V(3).Info("recommending collector resources", "recommendation", forwarder.Status.Collector.RecommendedResources)
+	if err := r.Client.Status().Update(ctx, forwarder); err != nil {
+		if strings.Contains(err.Error(), constants.OptimisticLockErrorMsg) {
+			return ctrl.Result{RequeueAfter: time.Second * 1}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: ObservationInterval}, nil
+}
+
+// CollectorUsage returns the usage of the collector container of each of the collector pods of the forwarder as
+// reported by the metrics API
+func CollectorUsage(k8Client client.Reader, forwarder *obsv1.ClusterLogForwarder) ([]corev1.ResourceList, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(podMetricsListGVK)
+	selector := runtime.Selectors(forwarder.Name, constants.CollectorName, constants.VectorName)
+	if err := k8Client.List(context.TODO(), list, client.InNamespace(forwarder.Namespace), client.MatchingLabels(selector)); err != nil {
+		return nil, err
+	}
+	usage := []corev1.ResourceList{}
+	for _, item := range list.Items {
+		containers, _, _ := unstructured.NestedSlice(item.Object, "containers")
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok || container["name"] != constants.CollectorName {
+				continue
+			}
+			values, _, _ := unstructured.NestedStringMap(container, "usage")
+			resources := corev1.ResourceList{}
+			for name, value := range values {
+				if quantity, err := resource.ParseQuantity(value); err == nil {
+					resources[corev1.ResourceName(name)] = quantity
+				}
+			}
+			usage = append(usage, resources)
+		}
+	}
+	return usage, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CollectorResourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("collector-autotune").
+		For(&obsv1.ClusterLogForwarder{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,89 @@
+package autotune_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/controller/autotune"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	"github.com/openshift/cluster-logging-operator/test"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("CollectorResourceReconciler", func() {
+
+	const name = "my-forwarder"
+
+	podMetrics := func(forwarderName, podName, cpu, memory string) *unstructured.Unstructured {
+		metrics := &unstructured.Unstructured{}
+		metrics.SetAPIVersion("metrics.k8s.io/v1beta1")
+		metrics.SetKind("PodMetrics")
+		metrics.SetNamespace(constants.OpenshiftNS)
+		metrics.SetName(podName)
+		metrics.SetLabels(runtime.Selectors(forwarderName, constants.CollectorName, constants.VectorName))
+		_ = unstructured.SetNestedSlice(metrics.Object, []interface{}{
+			map[string]interface{}{
+				"name":  constants.CollectorName,
+				"usage": map[string]interface{}{"cpu": cpu, "memory": memory},
+			},
+		}, "containers")
+		return metrics
+	}
+
+	It("should record the recommended resources for the collector in the status of the forwarder", func() {
+		forwarder := obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, name, runtime.Initialize)
+		k8sClient := fake.NewClientBuilder().
+			WithObjects(forwarder,
+				podMetrics(name, "collector-a", "100m", "100Mi"),
+				podMetrics(name, "collector-b", "400m", "400Mi"),
+				podMetrics("other", "other-collector", "4", "4Gi"),
+			).
+			WithStatusSubresource(forwarder).
+			Build()
+		reconciler := &autotune.CollectorResourceReconciler{Client: k8sClient}
+		result, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: constants.OpenshiftNS, Name: name}})
+		Expect(err).To(BeNil())
+		Expect(result.RequeueAfter).To(Equal(autotune.ObservationInterval))
+
+		actual := &obs.ClusterLogForwarder{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: name}, actual)).To(Succeed())
+		Expect(actual.Status.Collector).ToNot(BeNil())
+		Expect(actual.Status.Collector.LastObservedTime).ToNot(BeNil())
+		recommended := actual.Status.Collector.RecommendedResources
+		Expect(recommended.Requests.Cpu().Cmp(resource.MustParse("500m"))).To(BeZero(), test.YAMLString(recommended))
+		Expect(recommended.Requests.Memory().Cmp(resource.MustParse("500Mi"))).To(BeZero(), test.YAMLString(recommended))
+	})
+
+	It("should ignore forwarders which are not found", func() {
+		reconciler := &autotune.CollectorResourceReconciler{Client: fake.NewClientBuilder().Build()}
+		result, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: constants.OpenshiftNS, Name: name}})
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ctrl.Result{}))
+	})
+
+	Context("#CollectorUsage", func() {
+		It("should only return the usage of the collector container", func() {
+			metrics := podMetrics(name, "collector-a", "100m", "100Mi")
+			containers, _, _ := unstructured.NestedSlice(metrics.Object, "containers")
+			containers = append(containers, map[string]interface{}{
+				"name":  "logfilesmetricexporter",
+				"usage": map[string]interface{}{"cpu": "1", "memory": "1Gi"},
+			})
+			_ = unstructured.SetNestedSlice(metrics.Object, containers, "containers")
+			forwarder := obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, name, runtime.Initialize)
+			usage, err := autotune.CollectorUsage(fake.NewClientBuilder().WithObjects(metrics).Build(), forwarder)
+			Expect(err).To(BeNil())
+			Expect(usage).To(HaveLen(1))
+			Expect(usage[0].Cpu().Cmp(resource.MustParse("100m"))).To(BeZero())
+			Expect(usage[0].Memory().Cmp(resource.MustParse("100Mi"))).To(BeZero())
+		})
+	})
+})
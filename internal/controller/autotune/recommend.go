@@ -0,0 +1,76 @@
+package autotune
+
+import (
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// requestHeadroom is the factor applied to the peak usage for the recommended requests
+	requestHeadroom = 1.25
+	// memoryLimitFactor is the factor applied to the recommended memory request for the memory limit
+	memoryLimitFactor = 2
+	// decay is the factor applied to a previous recommendation to bound how fast a recommendation is lowered
+	// when usage drops between observations
+	decay = 0.8
+	// tolerance is the relative change to a previous recommendation below which it is kept to avoid redeploying the
+	// collector for insignificant changes in usage
+	tolerance = 0.05
+	mebibyte  = 1024 * 1024
+)
+
+var (
+	minCPURequest    = resource.MustParse("50m")
+	minMemoryRequest = resource.MustParse("64Mi")
+)
+
+// Recommend returns the resource requirements for the collector from the usage of each of the collector pods. The
+// previous recommendation, if any, bounds the rate at which the recommendation is lowered.  The recommendation
+// does not include a CPU limit so the collector is not throttled during bursts of logs
+func Recommend(usage []corev1.ResourceList, previous *corev1.ResourceRequirements) *corev1.ResourceRequirements {
+	if len(usage) == 0 {
+		return previous
+	}
+	var peakCPU, peakMemory float64
+	for _, u := range usage {
+		if cpu, found := u[corev1.ResourceCPU]; found {
+			peakCPU = math.Max(peakCPU, float64(cpu.MilliValue()))
+		}
+		if memory, found := u[corev1.ResourceMemory]; found {
+			peakMemory = math.Max(peakMemory, float64(memory.Value()))
+		}
+	}
+	cpuRequest := peakCPU * requestHeadroom
+	memoryRequest := peakMemory * requestHeadroom
+	if previous != nil {
+		if cpu, found := previous.Requests[corev1.ResourceCPU]; found {
+			cpuRequest = math.Max(cpuRequest, float64(cpu.MilliValue())*decay)
+		}
+		if memory, found := previous.Requests[corev1.ResourceMemory]; found {
+			memoryRequest = math.Max(memoryRequest, float64(memory.Value())*decay)
+		}
+	}
+	cpuRequest = math.Max(math.Ceil(cpuRequest), float64(minCPURequest.MilliValue()))
+	memoryRequest = math.Max(math.Ceil(memoryRequest/mebibyte)*mebibyte, float64(minMemoryRequest.Value()))
+	if previous != nil && withinTolerance(previous.Requests.Cpu(), cpuRequest/1000) && withinTolerance(previous.Requests.Memory(), memoryRequest) {
+		return previous
+	}
+	return &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    *resource.NewMilliQuantity(int64(cpuRequest), resource.DecimalSI),
+			corev1.ResourceMemory: *resource.NewQuantity(int64(memoryRequest), resource.BinarySI),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: *resource.NewQuantity(int64(memoryRequest)*memoryLimitFactor, resource.BinarySI),
+		},
+	}
+}
+
+func withinTolerance(previous *resource.Quantity, value float64) bool {
+	if previous.IsZero() {
+		return false
+	}
+	return math.Abs(value-previous.AsApproximateFloat64()) <= previous.AsApproximateFloat64()*tolerance
+}
@@ -0,0 +1,59 @@
+package autotune_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/cluster-logging-operator/internal/controller/autotune"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("#Recommend", func() {
+
+	usage := func(cpu, memory string) corev1.ResourceList {
+		return corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(memory),
+		}
+	}
+	requirements := func(cpu, memory string) *corev1.ResourceRequirements {
+		r := &corev1.ResourceRequirements{
+			Requests: usage(cpu, memory),
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(memory)},
+		}
+		r.Limits[corev1.ResourceMemory] = *resource.NewQuantity(r.Requests.Memory().Value()*2, resource.BinarySI)
+		return r
+	}
+	expectEqual := func(actual, exp *corev1.ResourceRequirements) {
+		Expect(actual.Requests.Cpu().Cmp(*exp.Requests.Cpu())).To(BeZero(), "cpu request: %s", actual.Requests.Cpu())
+		Expect(actual.Requests.Memory().Cmp(*exp.Requests.Memory())).To(BeZero(), "memory request: %s", actual.Requests.Memory())
+		Expect(actual.Limits.Memory().Cmp(*exp.Limits.Memory())).To(BeZero(), "memory limit: %s", actual.Limits.Memory())
+		Expect(actual.Limits).ToNot(HaveKey(corev1.ResourceCPU))
+	}
+
+	It("should recommend requests with headroom for the peak usage of the collector pods", func() {
+		expectEqual(autotune.Recommend([]corev1.ResourceList{usage("100m", "200Mi"), usage("400m", "400Mi")}, nil), requirements("500m", "500Mi"))
+	})
+
+	It("should not recommend less than the minimum requests", func() {
+		expectEqual(autotune.Recommend([]corev1.ResourceList{usage("1m", "10Mi")}, nil), requirements("50m", "64Mi"))
+	})
+
+	It("should recommend more resources as soon as usage increases", func() {
+		expectEqual(autotune.Recommend([]corev1.ResourceList{usage("800m", "800Mi")}, requirements("500m", "500Mi")), requirements("1", "1000Mi"))
+	})
+
+	It("should bound the rate at which the recommendation is lowered", func() {
+		expectEqual(autotune.Recommend([]corev1.ResourceList{usage("80m", "80Mi")}, requirements("500m", "500Mi")), requirements("400m", "400Mi"))
+	})
+
+	It("should keep the previous recommendation when the change is insignificant", func() {
+		previous := requirements("500m", "500Mi")
+		Expect(autotune.Recommend([]corev1.ResourceList{usage("410m", "410Mi")}, previous)).To(BeIdenticalTo(previous))
+	})
+
+	It("should keep the previous recommendation when there is no usage", func() {
+		previous := requirements("500m", "500Mi")
+		Expect(autotune.Recommend(nil, previous)).To(BeIdenticalTo(previous))
+	})
+})
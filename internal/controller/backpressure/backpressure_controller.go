@@ -0,0 +1,220 @@
+package backpressure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/collector"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	loggerName = "controller.backpressure"
+
+	// EventReasonLogsThrottled is the reason of the events recorded for namespaces whose logs are throttled
+	EventReasonLogsThrottled = "LogsThrottled"
+)
+
+var (
+	// ObservationInterval is the interval between observations of the records dropped by the collector pods
+	ObservationInterval = time.Minute * 5
+)
+
+// ThrottleReport is the value of the annotation recorded on a namespace whose container logs were dropped because
+// they exceeded the rate limit of a forwarder
+type ThrottleReport struct {
+	// Forwarder is the namespace/name of the ClusterLogForwarder which dropped the records
+	Forwarder string `json:"forwarder"`
+	// DroppedRecords is the number of records dropped since the previous observation
+	DroppedRecords int64 `json:"droppedRecords"`
+	// Interval is the interval between observations
+	Interval string `json:"interval"`
+	// LastObservedTime is the time of the observation
+	LastObservedTime metav1.Time `json:"lastObservedTime"`
+}
+
+// NamespaceThrottleReconciler observes the container log records dropped by the collector pods of a
+// ClusterLogForwarder which limits the rate of container logs and signals the namespaces of the containers by an
+// annotation and an event
+type NamespaceThrottleReconciler struct {
+	Client   client.Client
+	Reader   client.Reader
+	Recorder record.EventRecorder
+	Scraper  collector.MetricsScraper
+
+	mutex sync.Mutex
+	// observed is the total of the records dropped by each collector pod for each namespace as of the previous
+	// observation
+	observed map[types.UID]map[string]float64
+}
+
+func (r *NamespaceThrottleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.WithName(loggerName)
+	log.V(3).Info("reconcile", "namespace", req.Namespace, "name", req.Name)
+
+	forwarder, err := observability.FetchClusterLogForwarder(r.Client, req.Namespace, req.Name)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+	if forwarder.DeletionTimestamp != nil || forwarder.Spec.ManagementState == obsv1.ManagementStateUnmanaged {
+		return ctrl.Result{}, nil
+	}
+
+	dropped := map[string]int64{}
+	throttled := throttlesContainers(forwarder)
+	if throttled {
+		if dropped, err = r.recentlyDropped(ctx, forwarder); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if err := r.signal(ctx, forwarder, dropped); err != nil {
+		return ctrl.Result{}, err
+	}
+	if !throttled {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: ObservationInterval}, nil
+}
+
+// recentlyDropped returns the number of records dropped for each namespace by the collector pods of the forwarder
+// since the previous observation
+func (r *NamespaceThrottleReconciler) recentlyDropped(ctx context.Context, forwarder *obsv1.ClusterLogForwarder) (map[string]int64, error) {
+	log := log.WithName(loggerName)
+	pods := &corev1.PodList{}
+	selector := runtime.Selectors(forwarder.Name, constants.CollectorName, constants.VectorName)
+	if err := r.Reader.List(ctx, pods, client.InNamespace(forwarder.Namespace), client.MatchingLabels(selector)); err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	observed := map[types.UID]map[string]float64{}
+	dropped := map[string]int64{}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		previous, found := r.observed[pod.UID]
+		// the totals of a pod first observed after it has been running for an interval, e.g. when the operator was
+		// restarted, include records dropped before the interval and are only the baseline of the next observation
+		baseline := !found && pod.Status.StartTime != nil && time.Since(pod.Status.StartTime.Time) > ObservationInterval
+		metrics, err := r.Scraper.Scrape(ctx, pod)
+		if err != nil {
+			log.V(2).Info("unable to scrape the metrics of the collector", "pod", pod.Name, "error", err.Error())
+			if found {
+				observed[pod.UID] = previous
+			}
+			continue
+		}
+		discarded, err := DiscardedByNamespace(bytes.NewReader(metrics))
+		if err != nil {
+			log.V(2).Info("unable to parse the metrics of the collector", "pod", pod.Name, "error", err.Error())
+			if found {
+				observed[pod.UID] = previous
+			}
+			continue
+		}
+		observed[pod.UID] = discarded
+		if baseline {
+			continue
+		}
+		for namespace, total := range discarded {
+			// A total lower than the previous one means the collector was restarted
+			if total >= previous[namespace] {
+				total -= previous[namespace]
+			}
+			dropped[namespace] += int64(total)
+		}
+	}
+	// the totals of the pods which no longer run are forgotten
+	r.observed = observed
+	return dropped, nil
+}
+
+// signal annotates the namespaces for which records were dropped by the forwarder and records an event for each of
+// them.  The annotation is removed from the namespaces for which records are no longer dropped by the forwarder
+func (r *NamespaceThrottleReconciler) signal(ctx context.Context, forwarder *obsv1.ClusterLogForwarder, dropped map[string]int64) error {
+	name := fmt.Sprintf("%s/%s", forwarder.Namespace, forwarder.Name)
+	namespaces := &corev1.NamespaceList{}
+	if err := r.Client.List(ctx, namespaces); err != nil {
+		return err
+	}
+	for i := range namespaces.Items {
+		namespace := &namespaces.Items[i]
+		patch := client.MergeFrom(namespace.DeepCopy())
+		if count := dropped[namespace.Name]; count > 0 {
+			report, err := json.Marshal(ThrottleReport{
+				Forwarder:        name,
+				DroppedRecords:   count,
+				Interval:         ObservationInterval.String(),
+				LastObservedTime: metav1.Now(),
+			})
+			if err != nil {
+				return err
+			}
+			if namespace.Annotations == nil {
+				namespace.Annotations = map[string]string{}
+			}
+			namespace.Annotations[constants.AnnotationLogsThrottled] = string(report)
+			if err := r.Client.Patch(ctx, namespace, patch); err != nil {
+				return err
+			}
+			r.Recorder.Eventf(namespace, corev1.EventTypeWarning, EventReasonLogsThrottled,
+				"%d container log records were dropped by ClusterLogForwarder %s in the last %s because they exceeded the maxRecordsPerSecond limit",
+				count, name, ObservationInterval)
+			continue
+		}
+		value, found := namespace.Annotations[constants.AnnotationLogsThrottled]
+		if !found {
+			continue
+		}
+		report := ThrottleReport{}
+		if err := json.Unmarshal([]byte(value), &report); err == nil && report.Forwarder != name {
+			continue
+		}
+		delete(namespace.Annotations, constants.AnnotationLogsThrottled)
+		if err := r.Client.Patch(ctx, namespace, patch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// throttlesContainers returns true if any of the inputs of the forwarder limits the rate of container logs
+func throttlesContainers(forwarder *obsv1.ClusterLogForwarder) bool {
+	for _, input := range forwarder.Spec.Inputs {
+		if _, found := internalobs.MaxRecordsPerSecond(input); found {
+			return true
+		}
+	}
+	return false
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NamespaceThrottleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("namespace-backpressure").
+		For(&obsv1.ClusterLogForwarder{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
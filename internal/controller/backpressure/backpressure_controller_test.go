@@ -0,0 +1,165 @@
+package backpressure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type fakeScraper map[string]string
+
+func (s fakeScraper) Scrape(_ context.Context, pod corev1.Pod) ([]byte, error) {
+	return []byte(s[pod.Name]), nil
+}
+
+var _ = Describe("NamespaceThrottleReconciler", func() {
+
+	const name = "my-forwarder"
+
+	var (
+		forwarder *obs.ClusterLogForwarder
+		recorder  *record.FakeRecorder
+		request   = ctrl.Request{NamespacedName: types.NamespacedName{Namespace: constants.OpenshiftNS, Name: name}}
+	)
+
+	discarded := func(namespace string, total int) string {
+		return fmt.Sprintf(`
+# TYPE collector_throttle_received_events_total counter
+collector_throttle_received_events_total{kubernetes_namespace_name="%[1]s"} %[2]d
+# TYPE collector_throttle_sent_events_total counter
+collector_throttle_sent_events_total{kubernetes_namespace_name="%[1]s"} 100
+`, namespace, total+100)
+	}
+	collectorPod := func(podName string) *corev1.Pod {
+		pod := runtime.NewPod(constants.OpenshiftNS, podName)
+		pod.UID = types.UID(podName)
+		pod.Labels = runtime.Selectors(name, constants.CollectorName, constants.VectorName)
+		pod.Status.Phase = corev1.PodRunning
+		return pod
+	}
+	namespace := func(nsName string, annotations map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: nsName, Annotations: annotations}}
+	}
+	report := func(k8sClient client.Client, nsName string) (*ThrottleReport, bool) {
+		ns := &corev1.Namespace{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: nsName}, ns)).To(Succeed())
+		value, found := ns.Annotations[constants.AnnotationLogsThrottled]
+		if !found {
+			return nil, false
+		}
+		report := &ThrottleReport{}
+		Expect(json.Unmarshal([]byte(value), report)).To(Succeed())
+		return report, true
+	}
+
+	BeforeEach(func() {
+		forwarder = obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, name, runtime.Initialize)
+		forwarder.Spec.Inputs = []obs.InputSpec{
+			{
+				Name: "app",
+				Type: obs.InputTypeApplication,
+				Application: &obs.Application{
+					Tuning: &obs.ContainerInputTuningSpec{
+						RateLimitPerContainer: &obs.LimitSpec{MaxRecordsPerSecond: 10},
+					},
+				},
+			},
+		}
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	It("should annotate the namespaces with the records recently dropped and record an event", func() {
+		k8sClient := fake.NewClientBuilder().
+			WithObjects(forwarder, collectorPod("collector-a"), collectorPod("collector-b"),
+				namespace("my-app", nil), namespace("other", nil)).
+			Build()
+		scraper := fakeScraper{
+			"collector-a": discarded("my-app", 10),
+			"collector-b": discarded("my-app", 5),
+		}
+		reconciler := &NamespaceThrottleReconciler{Client: k8sClient, Reader: k8sClient, Recorder: recorder, Scraper: scraper}
+		result, err := reconciler.Reconcile(context.TODO(), request)
+		Expect(err).To(BeNil())
+		Expect(result.RequeueAfter).To(Equal(ObservationInterval))
+
+		actual, found := report(k8sClient, "my-app")
+		Expect(found).To(BeTrue())
+		Expect(actual.Forwarder).To(Equal(constants.OpenshiftNS + "/" + name))
+		Expect(actual.DroppedRecords).To(BeEquivalentTo(15))
+		Expect(actual.Interval).To(Equal(ObservationInterval.String()))
+		Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonLogsThrottled)))
+
+		_, found = report(k8sClient, "other")
+		Expect(found).To(BeFalse())
+
+		By("reporting only the records dropped since the previous observation")
+		scraper["collector-a"] = discarded("my-app", 12)
+		scraper["collector-b"] = discarded("my-app", 5)
+		_, err = reconciler.Reconcile(context.TODO(), request)
+		Expect(err).To(BeNil())
+		actual, _ = report(k8sClient, "my-app")
+		Expect(actual.DroppedRecords).To(BeEquivalentTo(2))
+
+		By("removing the annotation when records are no longer dropped")
+		_, err = reconciler.Reconcile(context.TODO(), request)
+		Expect(err).To(BeNil())
+		_, found = report(k8sClient, "my-app")
+		Expect(found).To(BeFalse())
+	})
+
+	It("should only report the records dropped after the first observation of a collector running for an interval", func() {
+		pod := collectorPod("collector-a")
+		pod.Status.StartTime = &metav1.Time{Time: time.Now().Add(-2 * ObservationInterval)}
+		k8sClient := fake.NewClientBuilder().WithObjects(forwarder, pod, namespace("my-app", nil)).Build()
+		scraper := fakeScraper{"collector-a": discarded("my-app", 1000)}
+		reconciler := &NamespaceThrottleReconciler{Client: k8sClient, Reader: k8sClient, Recorder: recorder, Scraper: scraper}
+		_, err := reconciler.Reconcile(context.TODO(), request)
+		Expect(err).To(BeNil())
+		_, found := report(k8sClient, "my-app")
+		Expect(found).To(BeFalse())
+
+		scraper["collector-a"] = discarded("my-app", 1003)
+		_, err = reconciler.Reconcile(context.TODO(), request)
+		Expect(err).To(BeNil())
+		actual, found := report(k8sClient, "my-app")
+		Expect(found).To(BeTrue())
+		Expect(actual.DroppedRecords).To(BeEquivalentTo(3))
+	})
+
+	It("should not remove the annotation recorded for another forwarder", func() {
+		value, _ := json.Marshal(ThrottleReport{Forwarder: "other/forwarder", DroppedRecords: 1})
+		k8sClient := fake.NewClientBuilder().
+			WithObjects(forwarder, namespace("my-app", map[string]string{constants.AnnotationLogsThrottled: string(value)})).
+			Build()
+		reconciler := &NamespaceThrottleReconciler{Client: k8sClient, Reader: k8sClient, Recorder: recorder, Scraper: fakeScraper{}}
+		_, err := reconciler.Reconcile(context.TODO(), request)
+		Expect(err).To(BeNil())
+		actual, found := report(k8sClient, "my-app")
+		Expect(found).To(BeTrue())
+		Expect(actual.Forwarder).To(Equal("other/forwarder"))
+	})
+
+	It("should not requeue forwarders which do not limit the rate of container logs", func() {
+		forwarder.Spec.Inputs = nil
+		k8sClient := fake.NewClientBuilder().WithObjects(forwarder).Build()
+		reconciler := &NamespaceThrottleReconciler{Client: k8sClient, Reader: k8sClient, Recorder: recorder, Scraper: fakeScraper{}}
+		result, err := reconciler.Reconcile(context.TODO(), request)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ctrl.Result{}))
+	})
+})
@@ -0,0 +1,50 @@
+package backpressure
+
+import (
+	"io"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/input"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	// metricsNamespace is the namespace the collector exports the metrics of its components in
+	metricsNamespace = "collector_"
+	// namespaceLabel is the label of the namespace of the records counted by the throttle metrics
+	namespaceLabel = "kubernetes_namespace_name"
+)
+
+// DiscardedByNamespace returns the total number of container log records discarded by the throttles of a collector
+// for each namespace, parsed from the metrics of the records received and passed by the throttles which are exposed
+// by the collector
+func DiscardedByNamespace(metrics io.Reader) (map[string]float64, error) {
+	parser := expfmt.TextParser{}
+	families, err := parser.TextToMetricFamilies(metrics)
+	if err != nil {
+		return nil, err
+	}
+	received := countByNamespace(families[metricsNamespace+input.ThrottleReceivedMetric])
+	sent := countByNamespace(families[metricsNamespace+input.ThrottleSentMetric])
+	discarded := map[string]float64{}
+	for namespace, total := range received {
+		// records may be counted as received but not yet as passed by the throttle
+		if count := total - sent[namespace]; count > 0 {
+			discarded[namespace] = count
+		}
+	}
+	return discarded, nil
+}
+
+// countByNamespace sums the values of a counter for each namespace
+func countByNamespace(family *dto.MetricFamily) map[string]float64 {
+	counts := map[string]float64{}
+	for _, metric := range family.GetMetric() {
+		for _, label := range metric.GetLabel() {
+			if label.GetName() == namespaceLabel && label.GetValue() != "" {
+				counts[label.GetValue()] += metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return counts
+}
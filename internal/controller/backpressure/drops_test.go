@@ -0,0 +1,42 @@
+package backpressure
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("#DiscardedByNamespace", func() {
+
+	It("should subtract the records passed from the records received by throttles for each namespace", func() {
+		metrics := `
+# HELP collector_throttle_received_events_total throttle_received_events_total
+# TYPE collector_throttle_received_events_total counter
+collector_throttle_received_events_total{hostname="node-a",kubernetes_namespace_name="my-app"} 115
+collector_throttle_received_events_total{hostname="node-a",kubernetes_namespace_name="other"} 11
+collector_throttle_received_events_total{hostname="node-a",kubernetes_namespace_name="quiet"} 7
+# HELP collector_throttle_sent_events_total throttle_sent_events_total
+# TYPE collector_throttle_sent_events_total counter
+collector_throttle_sent_events_total{hostname="node-a",kubernetes_namespace_name="my-app"} 100
+collector_throttle_sent_events_total{hostname="node-a",kubernetes_namespace_name="other"} 10
+collector_throttle_sent_events_total{hostname="node-a",kubernetes_namespace_name="quiet"} 7
+# HELP vector_component_discarded_events_total component_discarded_events_total
+# TYPE vector_component_discarded_events_total counter
+vector_component_discarded_events_total{component_id="input_app_container_throttle",component_kind="transform",component_type="throttle",hostname="node-a"} 16
+`
+		Expect(DiscardedByNamespace(strings.NewReader(metrics))).To(Equal(map[string]float64{
+			"my-app": 15,
+			"other":  1,
+		}))
+	})
+
+	It("should return nothing when no records are discarded", func() {
+		Expect(DiscardedByNamespace(strings.NewReader(""))).To(BeEmpty())
+	})
+
+	It("should fail for invalid metrics", func() {
+		_, err := DiscardedByNamespace(strings.NewReader("collector_throttle_received_events_total{ 1"))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,143 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/outputs"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CertManagerSecrets is the option key for the names of secrets issued by cert-manager for output TLS
+	CertManagerSecrets = "certManagerSecrets"
+
+	certManagerCAKey = "ca.crt"
+
+	// certManagerCertificateNameAnnotation is the annotation cert-manager adds to a secret it issued for a certificate
+	certManagerCertificateNameAnnotation = "cert-manager.io/certificate-name"
+)
+
+var certManagerCertificateGVK = schema.GroupVersionKind{
+	Group:   "cert-manager.io",
+	Version: "v1",
+	Kind:    "Certificate",
+}
+
+// ResolveCertManagerCertificates replaces output references to cert-manager Certificates with references to the
+// keys of the secrets issued for them.  TLS fields that are explicitly set take precedence over the issued secret.
+// The names of the issued secrets are recorded in options so changes to them (e.g. renewal) can be tracked.  The
+// certificates which can not be resolved (e.g. not issued yet) are recorded in options by output name so only those
+// outputs fail validation
+func ResolveCertManagerCertificates(k8Client client.Reader, forwarder *obsv1.ClusterLogForwarder, options utils.Options) {
+	secretNames := []string{}
+	unresolved := map[string]string{}
+	for i, o := range forwarder.Spec.Outputs {
+		if o.TLS == nil || o.TLS.CertManagerCertificate == "" {
+			continue
+		}
+		secret, err := fetchCertManagerSecret(k8Client, forwarder.Namespace, o.TLS.CertManagerCertificate)
+		if err != nil {
+			log.WithName(loggerName).V(3).Info("unable to resolve cert-manager certificate", "output", o.Name, "err", err)
+			unresolved[o.Name] = err.Error()
+			continue
+		}
+		tls := forwarder.Spec.Outputs[i].TLS
+		if tls.Certificate == nil {
+			tls.Certificate = &obsv1.ValueReference{Key: corev1.TLSCertKey, SecretName: secret.Name}
+		}
+		if tls.Key == nil {
+			tls.Key = &obsv1.SecretReference{Key: corev1.TLSPrivateKeyKey, SecretName: secret.Name}
+		}
+		if _, found := secret.Data[certManagerCAKey]; found && tls.CA == nil {
+			tls.CA = &obsv1.ValueReference{Key: certManagerCAKey, SecretName: secret.Name}
+		}
+		secretNames = append(secretNames, secret.Name)
+	}
+	if len(secretNames) > 0 {
+		options[CertManagerSecrets] = secretNames
+	}
+	if len(unresolved) > 0 {
+		options[outputs.UnresolvedCertManagerCertificatesOpt] = unresolved
+	}
+}
+
+// hasUnresolvedCertManagerCertificates returns true when a certificate referenced by an output could not be resolved
+func hasUnresolvedCertManagerCertificates(options utils.Options) bool {
+	_, found := options[outputs.UnresolvedCertManagerCertificatesOpt]
+	return found
+}
+
+// ForwardersOfCertManagerSecret returns the requests to reconcile the forwarders whose outputs reference the
+// cert-manager Certificate for which a secret was issued, so the collector is redeployed when the certificate is issued
+// or renewed
+func ForwardersOfCertManagerSecret(ctx context.Context, k8sClient client.Reader, secret client.Object) (requests []ctrl.Request) {
+	certName, found := secret.GetAnnotations()[certManagerCertificateNameAnnotation]
+	if !found {
+		return nil
+	}
+	forwarders := &obsv1.ClusterLogForwarderList{}
+	if err := k8sClient.List(ctx, forwarders, client.InNamespace(secret.GetNamespace())); err != nil {
+		log.WithName(loggerName).V(3).Error(err, "unable to list the forwarders referencing a cert-manager certificate", "certificate", certName)
+		return nil
+	}
+	for _, forwarder := range forwarders.Items {
+		for _, o := range forwarder.Spec.Outputs {
+			if o.TLS != nil && o.TLS.CertManagerCertificate == certName {
+				requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: forwarder.Namespace, Name: forwarder.Name}})
+				break
+			}
+		}
+	}
+	return requests
+}
+
+func fetchCertManagerSecret(k8Client client.Reader, namespace, name string) (*corev1.Secret, error) {
+	cert := &unstructured.Unstructured{}
+	cert.SetGroupVersionKind(certManagerCertificateGVK)
+	if err := k8Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, cert); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, fmt.Errorf("certificate %q can not be resolved because cert-manager is not installed", name)
+		}
+		return nil, fmt.Errorf("unable to fetch certificate %q: %v", name, err)
+	}
+	secretName, found, err := unstructured.NestedString(cert.Object, "spec", "secretName")
+	if err != nil || !found || secretName == "" {
+		return nil, fmt.Errorf("certificate %q does not specify a secretName", name)
+	}
+	secret := &corev1.Secret{}
+	if err := k8Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: secretName}, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, fmt.Errorf("secret %q for certificate %q has not been issued", secretName, name)
+		}
+		return nil, err
+	}
+	log.WithName(loggerName).V(4).Info("resolved cert-manager certificate", "certificate", name, "secret", secretName)
+	return secret, nil
+}
+
+// certManagerSecretVersions returns a stable representation of the versions of the secrets issued by cert-manager
+// so the collector is redeployed when a certificate is renewed
+func certManagerSecretVersions(options utils.Options, secrets map[string]*corev1.Secret) string {
+	names, _ := utils.GetOption(options, CertManagerSecrets, []string{})
+	sort.Strings(names)
+	versions := []string{}
+	for _, name := range names {
+		if secret, found := secrets[name]; found {
+			versions = append(versions, name+"="+secret.ResourceVersion)
+		}
+	}
+	return strings.Join(versions, ",")
+}
@@ -0,0 +1,159 @@
+package observability_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/outputs"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("#ResolveCertManagerCertificates", func() {
+
+	const (
+		namespace  = "openshift-logging"
+		certName   = "mycert"
+		secretName = "mycert-tls"
+	)
+
+	var (
+		forwarder *obs.ClusterLogForwarder
+		options   utils.Options
+		cert      *unstructured.Unstructured
+		secret    *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		options = utils.Options{}
+		cert = &unstructured.Unstructured{}
+		cert.SetAPIVersion("cert-manager.io/v1")
+		cert.SetKind("Certificate")
+		cert.SetNamespace(namespace)
+		cert.SetName(certName)
+		_ = unstructured.SetNestedField(cert.Object, secretName, "spec", "secretName")
+		secret = runtime.NewSecret(namespace, secretName, map[string][]byte{
+			corev1.TLSCertKey:       []byte("cert"),
+			corev1.TLSPrivateKeyKey: []byte("key"),
+			"ca.crt":                []byte("ca"),
+		})
+		forwarder = &obs.ClusterLogForwarder{}
+		forwarder.Namespace = namespace
+		forwarder.Spec.Outputs = []obs.OutputSpec{
+			{
+				Name: "myoutput",
+				Type: obs.OutputTypeHTTP,
+				TLS: &obs.OutputTLSSpec{
+					CertManagerCertificate: certName,
+				},
+			},
+		}
+	})
+
+	It("should reference the keys of the issued secret", func() {
+		k8sClient := fake.NewFakeClient(cert, secret)
+		observability.ResolveCertManagerCertificates(k8sClient, forwarder, options)
+		tls := forwarder.Spec.Outputs[0].TLS
+		Expect(tls.Certificate).To(Equal(&obs.ValueReference{Key: corev1.TLSCertKey, SecretName: secretName}))
+		Expect(tls.Key).To(Equal(&obs.SecretReference{Key: corev1.TLSPrivateKeyKey, SecretName: secretName}))
+		Expect(tls.CA).To(Equal(&obs.ValueReference{Key: "ca.crt", SecretName: secretName}))
+		Expect(options[observability.CertManagerSecrets]).To(Equal([]string{secretName}))
+	})
+
+	It("should not replace explicitly defined values", func() {
+		ca := &obs.ValueReference{Key: "ca-bundle.crt", ConfigMapName: "myca"}
+		forwarder.Spec.Outputs[0].TLS.CA = ca
+		k8sClient := fake.NewFakeClient(cert, secret)
+		observability.ResolveCertManagerCertificates(k8sClient, forwarder, options)
+		Expect(forwarder.Spec.Outputs[0].TLS.CA).To(Equal(ca))
+	})
+
+	It("should not reference a CA when the issued secret does not have one", func() {
+		delete(secret.Data, "ca.crt")
+		k8sClient := fake.NewFakeClient(cert, secret)
+		observability.ResolveCertManagerCertificates(k8sClient, forwarder, options)
+		Expect(forwarder.Spec.Outputs[0].TLS.CA).To(BeNil())
+	})
+
+	It("should record the output whose secret has not been issued", func() {
+		k8sClient := fake.NewFakeClient(cert)
+		observability.ResolveCertManagerCertificates(k8sClient, forwarder, options)
+		Expect(options[outputs.UnresolvedCertManagerCertificatesOpt]).To(HaveKeyWithValue("myoutput", ContainSubstring("has not been issued")))
+		Expect(forwarder.Spec.Outputs[0].TLS.Certificate).To(BeNil())
+		Expect(options).ToNot(HaveKey(observability.CertManagerSecrets))
+	})
+
+	It("should resolve the certificates of the other outputs when a secret has not been issued", func() {
+		pending := cert.DeepCopy()
+		pending.SetName("pending")
+		_ = unstructured.SetNestedField(pending.Object, "pending-tls", "spec", "secretName")
+		forwarder.Spec.Outputs = append(forwarder.Spec.Outputs, obs.OutputSpec{
+			Name: "pendingoutput",
+			Type: obs.OutputTypeHTTP,
+			TLS: &obs.OutputTLSSpec{
+				CertManagerCertificate: "pending",
+			},
+		})
+		k8sClient := fake.NewFakeClient(cert, pending, secret)
+		observability.ResolveCertManagerCertificates(k8sClient, forwarder, options)
+		Expect(forwarder.Spec.Outputs[0].TLS.Certificate).To(Equal(&obs.ValueReference{Key: corev1.TLSCertKey, SecretName: secretName}))
+		Expect(options[observability.CertManagerSecrets]).To(Equal([]string{secretName}))
+		Expect(options[outputs.UnresolvedCertManagerCertificatesOpt]).To(Equal(map[string]string{
+			"pendingoutput": `secret "pending-tls" for certificate "pending" has not been issued`,
+		}))
+	})
+
+	It("should do nothing when no output references a certificate", func() {
+		forwarder.Spec.Outputs[0].TLS.CertManagerCertificate = ""
+		k8sClient := fake.NewFakeClient()
+		observability.ResolveCertManagerCertificates(k8sClient, forwarder, options)
+		Expect(forwarder.Spec.Outputs[0].TLS.Certificate).To(BeNil())
+		Expect(options).To(BeEmpty())
+	})
+})
+
+var _ = Describe("#ForwardersOfCertManagerSecret", func() {
+
+	const namespace = "openshift-logging"
+
+	newForwarder := func(namespace, name, certName string) *obs.ClusterLogForwarder {
+		return &obs.ClusterLogForwarder{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: obs.ClusterLogForwarderSpec{
+				Outputs: []obs.OutputSpec{
+					{Name: "myoutput", Type: obs.OutputTypeHTTP, TLS: &obs.OutputTLSSpec{CertManagerCertificate: certName}},
+				},
+			},
+		}
+	}
+	newIssuedSecret := func(certName string) *corev1.Secret {
+		secret := runtime.NewSecret(namespace, "mycert-tls", nil)
+		secret.Annotations = map[string]string{"cert-manager.io/certificate-name": certName}
+		return secret
+	}
+
+	It("should reconcile only the forwarders whose outputs reference the certificate of the secret", func() {
+		k8sClient := fake.NewFakeClient(
+			newForwarder(namespace, "referencing", "mycert"),
+			newForwarder(namespace, "other", "othercert"),
+			newForwarder("other-namespace", "referencing", "mycert"),
+		)
+		Expect(observability.ForwardersOfCertManagerSecret(context.TODO(), k8sClient, newIssuedSecret("mycert"))).To(Equal([]ctrl.Request{
+			{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "referencing"}},
+		}))
+	})
+
+	It("should not reconcile any forwarder for a secret not issued by cert-manager", func() {
+		k8sClient := fake.NewFakeClient(newForwarder(namespace, "referencing", "mycert"))
+		Expect(observability.ForwardersOfCertManagerSecret(context.TODO(), k8sClient, runtime.NewSecret(namespace, "mycert-tls", nil))).To(BeEmpty())
+	})
+})
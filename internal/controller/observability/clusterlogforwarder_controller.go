@@ -2,6 +2,8 @@ package observability
 
 import (
 	"context"
+	goerrors "errors"
+	"fmt"
 	"github.com/openshift/cluster-logging-operator/internal/api/initialize"
 	"time"
 
@@ -17,9 +19,11 @@ import (
 	"k8s.io/utils/set"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 )
 
 const (
@@ -35,6 +39,19 @@ var (
 	}
 
 	defaultRequeue = ctrl.Result{}
+
+	// configValidationPollInterval is the interval at which a pending collector config validation job is checked
+	configValidationPollInterval = 10 * time.Second
+
+	// canaryRolloutPollInterval is the interval at which the health of the canary collectors is evaluated
+	canaryRolloutPollInterval = 30 * time.Second
+
+	// rolloutPollInterval is the interval at which the summarized status is refreshed while the collector is rolled out
+	rolloutPollInterval = 30 * time.Second
+
+	// certManagerPollInterval is the interval at which the cert-manager Certificates which have not been issued yet
+	// are resolved again
+	certManagerPollInterval = 30 * time.Second
 )
 
 // ClusterLogForwarderReconciler reconciles a ClusterLogForwarder object
@@ -62,10 +79,19 @@ func (r *ClusterLogForwarderReconciler) Reconcile(ctx context.Context, req ctrl.
 	}
 
 	removeStaleStatuses(r.Forwarder)
+	r.AdditionalContext = nil
 
 	readyCond := internalobs.NewCondition(obsv1.ConditionTypeReady, obsv1.ConditionUnknown, obsv1.ReasonUnknownState, "")
 	defer func() {
 		updateStatus(r.Client, r.Forwarder, readyCond)
+		progressing := meta.FindStatusCondition(r.Forwarder.Status.Conditions, obsv1.ConditionTypeProgressing)
+		if progressing != nil && progressing.Reason == obsv1.ReasonRolloutInProgress && (result.RequeueAfter == 0 || result.RequeueAfter > rolloutPollInterval) {
+			result.RequeueAfter = rolloutPollInterval
+		}
+		// the certificates are not watched so the outputs waiting for them are validated again until they are resolved
+		if hasUnresolvedCertManagerCertificates(r.AdditionalContext) && (result.RequeueAfter == 0 || result.RequeueAfter > certManagerPollInterval) {
+			result.RequeueAfter = certManagerPollInterval
+		}
 	}()
 
 	if r.Forwarder.Spec.ManagementState == obsv1.ManagementStateUnmanaged {
@@ -75,22 +101,43 @@ func (r *ClusterLogForwarderReconciler) Reconcile(ctx context.Context, req ctrl.
 	}
 
 	readyCond.Status = obsv1.ConditionFalse
+	// changes are deferred before the forwarder is initialized, which modifies the copied secrets the running
+	// collector mounts
+	var nextWindow *time.Time
+	if nextWindow, err = DeferToMaintenanceWindow(r.Forwarder, time.Now()); err != nil {
+		readyCond.Reason = obsv1.ReasonValidationFailure
+		readyCond.Message = err.Error()
+		return defaultRequeue, nil
+	}
+	if nextWindow != nil {
+		readyCond.Reason = obsv1.ReasonMaintenanceWindowPending
+		readyCond.Message = fmt.Sprintf("changes to the spec are deferred until the maintenance window opens at %s", nextWindow.Format(time.RFC3339))
+		return ctrl.Result{RequeueAfter: time.Until(*nextWindow)}, nil
+	}
+
 	if err = r.Initialize(); err != nil {
 		readyCond.Reason = obsv1.ReasonInitializationFailed
 		readyCond.Message = err.Error()
 		return defaultRequeue, nil
 	}
 
+	var prunedMessage string
 	if !validateForwarder(r.ForwarderContext) {
 		readyCond.Reason = obsv1.ReasonValidationFailure
 		if validations.MustUndeployCollector(r.Forwarder.Status.Conditions) {
 			if deleteErr := collector.Remove(r.Client, r.Forwarder.Namespace, r.Forwarder.Name); deleteErr != nil {
 				log.V(0).Error(deleteErr, "Unable to remove collector deployment")
 			}
+			return defaultRequeue, err
+		}
+		var permissive bool
+		if prunedMessage, permissive = ApplyPermissiveValidation(r.Forwarder); !permissive {
+			return defaultRequeue, err
 		}
-		return defaultRequeue, err
 	}
 
+	r.Forwarder.Status.Effective = EffectiveSpec(*r.Forwarder)
+
 	if err = RemoveStaleWorkload(r.Client, r.Forwarder); err != nil {
 		readyCond.Reason = obsv1.ReasonFailureToRemoveStaleWorkload
 		readyCond.Message = err.Error()
@@ -98,6 +145,26 @@ func (r *ClusterLogForwarderReconciler) Reconcile(ctx context.Context, req ctrl.
 	}
 
 	reconcileErr := ReconcileCollector(r.ForwarderContext, collector.DefaultPollInterval, collector.DefaultTimeOut)
+	var validationErr *collector.ConfigValidationError
+	var canaryErr *collector.CanaryRolloutError
+	switch {
+	case goerrors.Is(reconcileErr, collector.ErrConfigValidationPending):
+		readyCond.Reason = obsv1.ReasonConfigValidationPending
+		readyCond.Message = reconcileErr.Error()
+		return ctrl.Result{RequeueAfter: configValidationPollInterval}, nil
+	case goerrors.As(reconcileErr, &validationErr):
+		readyCond.Reason = obsv1.ReasonConfigValidationFailure
+		readyCond.Message = validationErr.Error()
+		return defaultRequeue, nil
+	case goerrors.Is(reconcileErr, collector.ErrCanaryRolloutPending):
+		readyCond.Reason = obsv1.ReasonCanaryRolloutPending
+		readyCond.Message = reconcileErr.Error()
+		return ctrl.Result{RequeueAfter: canaryRolloutPollInterval}, nil
+	case goerrors.As(reconcileErr, &canaryErr):
+		readyCond.Reason = obsv1.ReasonCanaryRolloutFailure
+		readyCond.Message = canaryErr.Error()
+		return defaultRequeue, nil
+	}
 	if reconcileErr != nil {
 		log.V(2).Error(reconcileErr, "reconcile error")
 		readyCond.Reason = obsv1.ReasonDeploymentError
@@ -106,6 +173,7 @@ func (r *ClusterLogForwarderReconciler) Reconcile(ctx context.Context, req ctrl.
 	}
 	readyCond.Reason = obsv1.ReasonReconciliationComplete
 	readyCond.Status = obsv1.ConditionTrue
+	readyCond.Message = prunedMessage
 
 	return periodicRequeue, nil
 }
@@ -120,9 +188,10 @@ func RemoveStaleWorkload(k8Client client.Client, forwarder *obsv1.ClusterLogForw
 	return remove(k8Client, forwarder.Namespace, forwarder.Name)
 }
 
-func MapSecrets(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs) (secretMap map[string]*corev1.Secret, err error) {
+func MapSecrets(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs, filters internalobs.Filters) (secretMap map[string]*corev1.Secret, err error) {
 	names := set.New(inputs.SecretNames()...)
 	names.Insert(outputs.SecretNames()...)
+	names.Insert(filters.SecretNames()...)
 	log.WithName(loggerName).V(4).Info("MapSecrets", "names", names.SortedList())
 	secretMap = map[string]*corev1.Secret{}
 	var secrets []*corev1.Secret
@@ -136,9 +205,27 @@ func MapSecrets(k8Client client.Client, namespace string, inputs internalobs.Inp
 	return secretMap, nil
 }
 
-func MapConfigMaps(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs) (configMaps map[string]*corev1.ConfigMap, err error) {
+// MapSecretProviderClasses returns placeholder secrets for the objects mounted by each SecretProviderClass that is
+// referenced by an output.  The values of these objects are only available to the collector
+func MapSecretProviderClasses(namespace string, outputs internalobs.Outputs) map[string]*corev1.Secret {
+	secretMap := map[string]*corev1.Secret{}
+	for name, keys := range outputs.SecretProviderClassKeys() {
+		data := map[string][]byte{}
+		for _, key := range keys {
+			data[key] = []byte{}
+		}
+		secretMap[name] = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Data:       data,
+		}
+	}
+	return secretMap
+}
+
+func MapConfigMaps(k8Client client.Client, namespace string, inputs internalobs.Inputs, outputs internalobs.Outputs, filters internalobs.Filters) (configMaps map[string]*corev1.ConfigMap, err error) {
 	names := set.New(inputs.ConfigmapNames()...)
 	names.Insert(outputs.ConfigmapNames()...)
+	names.Insert(filters.ConfigmapNames()...)
 	log.WithName(loggerName).V(4).Info("MapConfigMaps", "names", names.SortedList())
 	configMaps = map[string]*corev1.ConfigMap{}
 	var configs []*corev1.ConfigMap
@@ -160,7 +247,13 @@ func (r *ClusterLogForwarderReconciler) Initialize() (err error) {
 	migrated := initialize.ClusterLogForwarder(*r.Forwarder, r.AdditionalContext)
 	r.Forwarder = &migrated
 
-	if r.Secrets, err = MapSecrets(r.Client, r.Forwarder.Namespace, r.Forwarder.Spec.Inputs, r.Forwarder.Spec.Outputs); err != nil {
+	if err = CopySecrets(r.Client, r.Forwarder); err != nil {
+		return err
+	}
+
+	ResolveCertManagerCertificates(r.Client, r.Forwarder, r.AdditionalContext)
+
+	if r.Secrets, err = MapSecrets(r.Client, r.Forwarder.Namespace, r.Forwarder.Spec.Inputs, r.Forwarder.Spec.Outputs, r.Forwarder.Spec.Filters); err != nil {
 		return err
 	}
 
@@ -170,7 +263,11 @@ func (r *ClusterLogForwarderReconciler) Initialize() (err error) {
 		}
 	}
 
-	if r.ConfigMaps, err = MapConfigMaps(r.Client, r.Forwarder.Namespace, r.Forwarder.Spec.Inputs, r.Forwarder.Spec.Outputs); err != nil {
+	for name, secret := range MapSecretProviderClasses(r.Forwarder.Namespace, r.Forwarder.Spec.Outputs) {
+		r.Secrets[name] = secret
+	}
+
+	if r.ConfigMaps, err = MapConfigMaps(r.Client, r.Forwarder.Namespace, r.Forwarder.Spec.Inputs, r.Forwarder.Spec.Outputs, r.Forwarder.Spec.Filters); err != nil {
 		return err
 	}
 	return nil
@@ -180,6 +277,12 @@ func (r *ClusterLogForwarderReconciler) Initialize() (err error) {
 func (r *ClusterLogForwarderReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&obsv1.ClusterLogForwarder{}).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+			return ForwardersOfFilterConfigMap(ctx, r.Client, obj)
+		})).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+			return append(ForwardersOfCertManagerSecret(ctx, r.Client, obj), ForwardersOfCopiedSecret(ctx, r.Client, obj)...)
+		})).
 		Complete(r)
 }
 
@@ -198,6 +301,11 @@ func validateForwarder(forwarderContext internalcontext.ForwarderContext) (valid
 
 func updateStatus(k8Client client.Client, instance *obsv1.ClusterLogForwarder, ready metav1.Condition) {
 	internalobs.SetCondition(&instance.Status.Conditions, ready)
+	if ready.Status == metav1.ConditionTrue {
+		instance.Status.AppliedGeneration = instance.Generation
+	}
+	SummarizeStatus(k8Client, instance, ready)
+	RecordHistory(instance, ready, metav1.Now())
 	if err := k8Client.Status().Update(context.TODO(), instance); err != nil {
 		log.Error(err, "clusterlogforwarder-controller error updating status", "status", instance.Status)
 	}
@@ -1,6 +1,9 @@
 package observability
 
 import (
+	goerrors "errors"
+	"fmt"
+
 	log "github.com/ViaQ/logerr/v2/log/static"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
@@ -12,6 +15,7 @@ import (
 	forwardergenerator "github.com/openshift/cluster-logging-operator/internal/generator/forwarder"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	generatorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/aggregator"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/metrics"
 	"github.com/openshift/cluster-logging-operator/internal/network"
@@ -20,6 +24,8 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/tls"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/set"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"strings"
 	"time"
@@ -35,7 +41,7 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 	ownerRef := utils.AsOwner(context.Forwarder)
 	resourceNames := factory.ResourceNames(*context.Forwarder)
 
-	options := framework.Options{}
+	options := GeneratorOptions(context, resourceNames)
 	if internalobs.Outputs(context.Forwarder.Spec.Outputs).NeedServiceAccountToken() {
 		// temporarily create SA token until collector is capable of dynamically reloading a projected serviceaccount token
 		var sa *corev1.ServiceAccount
@@ -50,7 +56,6 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 			return err
 		}
 		context.Secrets[saTokenSecret.Name] = saTokenSecret
-		options[framework.OptionServiceAccountTokenSecretName] = resourceNames.ServiceAccountTokenSecret
 	}
 
 	// Add roles to ServiceAccount to allow the collector to read from the node
@@ -66,34 +71,102 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 	}
 	trustedCABundle := collector.WaitForTrustedCAToBePopulated(context.Client, context.Forwarder.Namespace, resourceNames.CaTrustBundle, pollInterval, timeout)
 
+	// The user workload Prometheus verifies the metrics endpoints with the service CA bundle injected into a configmap
+	if collector.UserWorkloadMonitoring(context.Forwarder.Spec.Collector) {
+		err = metrics.ReconcileServiceCABundle(context.Client, context.Forwarder.Namespace, resourceNames.MetricsCABundle, ownerRef)
+	} else {
+		err = metrics.RemoveServiceCABundle(context.Client, context.Forwarder.Namespace, resourceNames.MetricsCABundle)
+	}
+	if err != nil {
+		log.Error(err, "metrics.ReconcileServiceCABundle")
+		return err
+	}
+
+	isDaemonSet := !internalobs.DeployAsDeployment(*context.Forwarder)
+	log.V(3).Info("Deploying as DaemonSet", "isDaemonSet", isDaemonSet)
+
 	var collectorConfig string
-	if collectorConfig, err = GenerateConfig(context.Client, *context.Forwarder, *resourceNames, context.Secrets, options); err != nil {
+	if collectorConfig, err = GeneratePrimaryConfig(context, *resourceNames, options); err != nil {
 		log.V(9).Error(err, "collector.GenerateConfig")
 		return err
 	}
 	log.V(3).Info("Generated collector config", "config", collectorConfig)
 	var collectorConfHash string
-	collectorConfHash, err = utils.CalculateMD5Hash(collectorConfig)
+	collectorConfHash, err = utils.CalculateMD5Hash(collectorConfig + certManagerSecretVersions(context.AdditionalContext, context.Secrets))
 	if err != nil {
 		log.Error(err, "unable to calculate MD5 hash")
 		log.V(9).Error(err, "Returning from unable to calculate MD5 hash")
 		return
 	}
 
-	isDaemonSet := !internalobs.DeployAsDeployment(*context.Forwarder)
-	log.V(3).Info("Deploying as DaemonSet", "isDaemonSet", isDaemonSet)
 	factory := collector.New(collectorConfHash, context.ClusterID, context.Forwarder.Spec.Collector, context.Secrets, context.ConfigMaps, context.Forwarder.Spec, resourceNames, isDaemonSet, LogLevel(context.Forwarder.Annotations))
-	if err = factory.ReconcileCollectorConfig(context.Client, context.Reader, context.Forwarder.Namespace, collectorConfig, ownerRef); err != nil {
-		log.Error(err, "collector.ReconcileCollectorConfig")
-		return
+	factory.Drift = &reconcile.DriftDetector{Policy: DriftPolicy(context.Forwarder.Annotations)}
+	factory.Provenance = &collector.Provenance{Generation: context.Forwarder.Generation}
+	if name := context.Forwarder.Annotations[constants.AnnotationConfigSigningSecret]; name != "" {
+		if factory.Provenance.Signer, err = collector.FetchSigner(context.Client, context.Forwarder.Namespace, name); err != nil {
+			log.V(3).Error(err, "collector.FetchSigner")
+			return err
+		}
+	}
+	if strings.ToLower(context.Forwarder.Annotations[constants.AnnotationValidateConfig]) == "true" {
+		if err = factory.ValidateCollectorConfig(context.Client, context.Forwarder.Namespace, collectorConfig, ownerRef); err != nil {
+			log.V(3).Info("collector.ValidateCollectorConfig", "result", err.Error())
+			return err
+		}
 	}
+	// rolloutErr reports the state of a canary rollout after the remaining collector resources are reconciled
+	var rolloutErr error
+	if isDaemonSet && context.Forwarder.Spec.Collector != nil && context.Forwarder.Spec.Collector.Rollout != nil {
+		var scraper collector.MetricsScraper
+		if context.Clientset != nil {
+			scraper = collector.PodProxyScraper{Client: context.Clientset}
+		}
+		rolloutErr = factory.ReconcileCanaryRollout(context.Client, context.Reader, scraper, context.Forwarder.Namespace, collectorConfig, trustedCABundle, ownerRef, time.Now())
+		if rolloutErr != nil && !goerrors.Is(rolloutErr, collector.ErrCanaryRolloutPending) && !goerrors.As(rolloutErr, new(*collector.CanaryRolloutError)) {
+			log.Error(rolloutErr, "collector.ReconcileCanaryRollout")
+			return rolloutErr
+		}
+	} else {
+		if err = factory.ReconcileCollectorConfig(context.Client, context.Reader, context.Forwarder.Namespace, collectorConfig, ownerRef); err != nil {
+			log.Error(err, "collector.ReconcileCollectorConfig")
+			return
+		}
 
-	reconcileWorkload := factory.ReconcileDaemonset
-	if !isDaemonSet {
-		reconcileWorkload = factory.ReconcileDeployment
+		reconcileWorkload := factory.ReconcileDaemonset
+		if !isDaemonSet {
+			reconcileWorkload = factory.ReconcileDeployment
+		}
+		if err := reconcileWorkload(context.Client, context.Forwarder.Namespace, trustedCABundle, ownerRef); err != nil {
+			log.Error(err, "Error reconciling the deployment of the collector")
+			return err
+		}
+		if err := factory.RemoveCanary(context.Client, context.Forwarder.Namespace); err != nil {
+			log.Error(err, "collector.RemoveCanary")
+			return err
+		}
+	}
+	inventory, err := factory.DeployedInventory(context.Reader, context.Forwarder.Namespace)
+	if err != nil {
+		log.Error(err, "collector.DeployedInventory")
+		return err
+	}
+	shardInventory, err := reconcileShards(context, factory, isDaemonSet, options, trustedCABundle, ownerRef)
+	if err != nil {
+		log.Error(err, "Error reconciling the collector shards")
+		return err
+	}
+	inventory = append(inventory, shardInventory...)
+	aggregatorInventory, err := reconcileAggregator(context, factory, options, trustedCABundle, ownerRef)
+	if err != nil {
+		log.Error(err, "Error reconciling the aggregator")
+		return err
 	}
-	if err := reconcileWorkload(context.Client, context.Forwarder.Namespace, trustedCABundle, ownerRef); err != nil {
-		log.Error(err, "Error reconciling the deployment of the collector")
+	inventory = append(inventory, aggregatorInventory...)
+	context.Forwarder.Status.Inventory = inventory
+	internalobs.SetCondition(&context.Forwarder.Status.Conditions, driftCondition(factory.Drift))
+
+	if err := factory.ReconcileVerticalPodAutoscaler(context.Client, context.Forwarder.Namespace, ownerRef); err != nil {
+		log.Error(err, "collector.ReconcileVerticalPodAutoscaler")
 		return err
 	}
 
@@ -107,18 +180,179 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 		log.Error(err, "collector.ReconcileService")
 		return err
 	}
-	if err := metrics.ReconcileServiceMonitor(context.Client, context.Forwarder.Namespace, resourceNames.CommonName, constants.CollectorName, collector.MetricsPortName, ownerRef); err != nil {
+	if err := reconcileServiceMonitor(context, resourceNames, true, ownerRef); err != nil {
 		log.Error(err, "collector.ReconcileServiceMonitor")
 		return err
 	}
 
-	return nil
+	if err := network.ReconcileNetworkPolicy(context.Client, context.Forwarder.Namespace, resourceNames.CommonName, context.Forwarder.Name, context.Forwarder.Spec, collector.MetricsPort, ownerRef, factory.CommonLabelInitializer); err != nil {
+		log.Error(err, "network.ReconcileNetworkPolicy")
+		return err
+	}
+
+	return rolloutErr
+}
+
+// reconcileShards deploys the collector of each shard which collects the logs of at least one pipeline and removes
+// the collectors of the remaining shards.  Shards are only deployed when the collector is deployed as a daemonset.  It
+// returns the component inventory of each deployed shard
+func reconcileShards(context internalcontext.ForwarderContext, primary *collector.Factory, isDaemonSet bool, options framework.Options, trustedCABundle *corev1.ConfigMap, ownerRef metav1.OwnerReference) ([]obs.CollectorInventory, error) {
+	namespace := context.Forwarder.Namespace
+	deployed := set.New[string]()
+	inventory := []obs.CollectorInventory{}
+	for _, shard := range internalobs.CollectorShards(context.Forwarder.Spec) {
+		spec := internalobs.ShardSpec(context.Forwarder.Spec, shard)
+		if !isDaemonSet || len(spec.Pipelines) == 0 {
+			continue
+		}
+		names := collector.ShardResourceNames(primary.ResourceNames, shard.Name)
+		forwarder := *context.Forwarder
+		forwarder.Name = names.ForwarderName
+		forwarder.Spec = spec
+		config, err := GenerateConfig(context.Client, forwarder, *names, context.Secrets, options)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := utils.CalculateMD5Hash(config + certManagerSecretVersions(context.AdditionalContext, context.Secrets))
+		if err != nil {
+			return nil, err
+		}
+		factory := primary.ForShard(shard, hash, spec)
+		if err = factory.ReconcileCollectorConfig(context.Client, context.Reader, namespace, config, ownerRef); err != nil {
+			return nil, err
+		}
+		if err = factory.ReconcileDaemonset(context.Client, namespace, trustedCABundle, ownerRef); err != nil {
+			return nil, err
+		}
+		if err = network.ReconcileService(context.Client, namespace, names.CommonName, names.ForwarderName, constants.CollectorName, collector.MetricsPortName, names.SecretMetrics, collector.MetricsPort, ownerRef, factory.CommonLabelInitializer); err != nil {
+			return nil, err
+		}
+		if err = reconcileServiceMonitor(context, names, true, ownerRef); err != nil {
+			return nil, err
+		}
+		if err = network.ReconcileNetworkPolicy(context.Client, namespace, names.CommonName, names.ForwarderName, spec, collector.MetricsPort, ownerRef, factory.CommonLabelInitializer); err != nil {
+			return nil, err
+		}
+		deployed.Insert(shard.Name)
+		shardInventory, err := factory.DeployedInventory(context.Reader, namespace)
+		if err != nil {
+			return nil, err
+		}
+		inventory = append(inventory, shardInventory...)
+	}
+	return inventory, collector.RemoveShards(context.Client, context.Reader, namespace, primary.ResourceNames, deployed)
+}
+
+// reconcileServiceMonitor reconciles the ServiceMonitor of the metrics endpoint of a collector.  The endpoint is
+// verified with the CA of the provided serving certificate when the collector serves it, and with the injected service
+// CA bundle when it is scraped by the user workload monitoring stack
+func reconcileServiceMonitor(context internalcontext.ForwarderContext, names *factory.ForwarderResourceNames, servesProvidedCert bool, ownerRef metav1.OwnerReference) error {
+	namespace := context.Forwarder.Namespace
+	spec := context.Forwarder.Spec.Collector
+	if secretName := collector.ServingCertSecretName(spec); secretName != "" && servesProvidedCert {
+		return metrics.ReconcileServiceMonitorWithCA(context.Client, namespace, names.CommonName, constants.CollectorName, collector.MetricsPortName, metrics.SecretCA(secretName), ownerRef)
+	}
+	if collector.UserWorkloadMonitoring(spec) {
+		return metrics.ReconcileServiceMonitorWithCA(context.Client, namespace, names.CommonName, constants.CollectorName, collector.MetricsPortName, metrics.ServiceCABundleCA(names.MetricsCABundle), ownerRef)
+	}
+	return metrics.ReconcileServiceMonitor(context.Client, namespace, names.CommonName, constants.CollectorName, collector.MetricsPortName, ownerRef)
+}
+
+// reconcileAggregator deploys the aggregator to which the collectors forward the collected logs when the forwarder
+// defines one and removes the aggregator otherwise.  It returns the component inventory of the deployed aggregator
+func reconcileAggregator(context internalcontext.ForwarderContext, primary *collector.Factory, options framework.Options, trustedCABundle *corev1.ConfigMap, ownerRef metav1.OwnerReference) ([]obs.CollectorInventory, error) {
+	namespace := context.Forwarder.Namespace
+	if _, found := options[framework.OptionAggregatorAddress]; !found {
+		return nil, collector.RemoveAggregator(context.Client, namespace, primary.ResourceNames)
+	}
+	names := collector.AggregatorResourceNames(primary.ResourceNames)
+	aggregatorOptions := framework.Options{framework.OptionAggregator: "true"}
+	for key, value := range options {
+		if key != framework.OptionAggregatorAddress {
+			aggregatorOptions[key] = value
+		}
+	}
+	queue := context.Forwarder.Spec.Collector.Aggregator.PersistentQueue
+	if queue != nil {
+		aggregatorOptions[framework.OptionAggregatorQueue] = *queue
+	}
+	forwarder := *context.Forwarder
+	forwarder.Name = names.ForwarderName
+	config, err := GenerateConfig(context.Client, forwarder, *names, context.Secrets, aggregatorOptions)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := utils.CalculateMD5Hash(config + certManagerSecretVersions(context.AdditionalContext, context.Secrets))
+	if err != nil {
+		return nil, err
+	}
+	factory := primary.ForAggregator(hash)
+	if err = factory.ReconcileCollectorConfig(context.Client, context.Reader, namespace, config, ownerRef); err != nil {
+		return nil, err
+	}
+	// An aggregator with a persistent queue is deployed as a statefulset whose pods claim the volumes of their queues
+	if queue != nil {
+		if err = factory.ReconcileStatefulSet(context.Client, namespace, trustedCABundle, ownerRef); err != nil {
+			return nil, err
+		}
+		err = collector.RemoveDeployment(context.Client, namespace, names.DaemonSetName())
+	} else {
+		if err = factory.ReconcileDeployment(context.Client, namespace, trustedCABundle, ownerRef); err != nil {
+			return nil, err
+		}
+		err = collector.RemoveStatefulSet(context.Client, namespace, names.DaemonSetName())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = network.ReconcileAggregatorService(context.Client, namespace, names.CommonName, names.ForwarderName, collector.MetricsPortName, names.SecretMetrics, collector.MetricsPort, ownerRef, factory.CommonLabelInitializer); err != nil {
+		return nil, err
+	}
+	if err = reconcileServiceMonitor(context, names, false, ownerRef); err != nil {
+		return nil, err
+	}
+	if err = network.ReconcileAggregatorNetworkPolicy(context.Client, namespace, names.CommonName, names.ForwarderName, context.Forwarder.Name, context.Forwarder.Spec, collector.MetricsPort, ownerRef, factory.CommonLabelInitializer); err != nil {
+		return nil, err
+	}
+	return factory.DeployedInventory(context.Reader, namespace)
+}
+
+// GeneratorOptions returns the options with which the collector config of a forwarder is generated.  The options are
+// shared by the operator and the generator service so both render the same config for the same forwarder
+func GeneratorOptions(context internalcontext.ForwarderContext, resourceNames *factory.ForwarderResourceNames) framework.Options {
+	forwarder := context.Forwarder
+	options := framework.Options{
+		framework.OptionConfigMaps:               context.ConfigMaps,
+		framework.OptionResumedOutputs:           PausedOutputs(forwarder),
+		framework.OptionInfrastructureNamespaces: forwarder.Spec.InfrastructureNamespaces,
+	}
+	if internalobs.Outputs(forwarder.Spec.Outputs).NeedServiceAccountToken() {
+		options[framework.OptionServiceAccountTokenSecretName] = resourceNames.ServiceAccountTokenSecret
+	}
+	if !internalobs.DeployAsDeployment(*forwarder) && forwarder.Spec.Collector != nil && forwarder.Spec.Collector.Aggregator != nil {
+		// The collectors forward the collected logs to the aggregator which forwards them to the outputs
+		aggregatorNames := collector.AggregatorResourceNames(resourceNames)
+		options[framework.OptionAggregatorAddress] = aggregator.Address(forwarder.Namespace, aggregatorNames.CommonName)
+	}
+	if spec := forwarder.Spec.Collector; spec != nil && spec.Mesh != nil && spec.Mesh.TLSOrigination == obs.MeshTLSOriginationMesh {
+		options[framework.OptionMeshTLSOrigination] = "true"
+	}
+	return options
+}
+
+// GeneratePrimaryConfig generates the config of the primary collector of a forwarder, which does not collect the
+// logs of the inputs collected by shards
+func GeneratePrimaryConfig(context internalcontext.ForwarderContext, resourceNames factory.ForwarderResourceNames, options framework.Options) (string, error) {
+	primary := *context.Forwarder
+	primary.Spec = internalobs.PrimarySpec(context.Forwarder.Spec)
+	return GenerateConfig(context.Client, primary, resourceNames, context.Secrets, options)
 }
 
 func GenerateConfig(k8Client client.Client, spec obs.ClusterLogForwarder, resourceNames factory.ForwarderResourceNames, secrets helpers.Secrets, op framework.Options) (config string, err error) {
 	tlsProfile, _ := tls.FetchAPIServerTlsProfile(k8Client)
 	op[framework.ClusterTLSProfileSpec] = tls.GetClusterTLSProfileSpec(tlsProfile)
 	//EvaluateAnnotationsForEnabledCapabilities(clusterRequest.Forwarder, op)
+	EvaluateAnnotationForWorkloadMetrics(spec.Annotations, op)
 	g := forwardergenerator.New()
 	generatedConfig, err := g.GenerateConf(secrets, spec.Spec, spec.Namespace, spec.Name, resourceNames, op)
 
@@ -146,6 +380,39 @@ func EvaluateAnnotationsForEnabledCapabilities(annotations map[string]string, op
 	}
 }
 
+// EvaluateAnnotationForWorkloadMetrics enables the workload metrics when the ClusterLogForwarder is annotated to
+// generate them.  It is evaluated apart from EvaluateAnnotationsForEnabledCapabilities, which is not evaluated, so
+// the annotation does not also enable the debug output
+func EvaluateAnnotationForWorkloadMetrics(annotations map[string]string, options framework.Options) {
+	if strings.ToLower(annotations[constants.AnnotationWorkloadMetrics]) == "true" {
+		options[framework.OptionWorkloadMetrics] = "true"
+	}
+}
+
+// DriftPolicy is the policy for handling modifications of the collector resources made outside of the operator
+func DriftPolicy(annotations map[string]string) reconcile.DriftPolicy {
+	if strings.EqualFold(annotations[constants.AnnotationDriftPolicy], string(reconcile.DriftPolicyReport)) {
+		return reconcile.DriftPolicyReport
+	}
+	return reconcile.DriftPolicyRevert
+}
+
+func driftCondition(drift *reconcile.DriftDetector) metav1.Condition {
+	if len(drift.Drifts) == 0 {
+		return internalobs.NewCondition(obs.ConditionTypeDrifted, obs.ConditionFalse, obs.ReasonNoDriftDetected, "")
+	}
+	modified := make([]string, 0, len(drift.Drifts))
+	for _, d := range drift.Drifts {
+		modified = append(modified, d.String())
+	}
+	action := "reverted"
+	if drift.Policy == reconcile.DriftPolicyReport {
+		action = "not reverted"
+	}
+	msg := fmt.Sprintf("resources modified outside of the operator were %s: %s", action, strings.Join(modified, "; "))
+	return internalobs.NewCondition(obs.ConditionTypeDrifted, obs.ConditionTrue, obs.ReasonDriftDetected, msg)
+}
+
 func LogLevel(annotations map[string]string) string {
 	if level, ok := annotations[constants.AnnotationVectorLogLevel]; ok {
 		return level
@@ -42,3 +42,17 @@ var _ = Describe("#EvaluateAnnotationsForEnabledCapabilities", func() {
 	)
 
 })
+
+var _ = Describe("#EvaluateAnnotationForWorkloadMetrics", func() {
+
+	DescribeTable("should evaluate the workload metrics annotation only", func(annotations map[string]string, expected framework.Options) {
+		options := framework.Options{}
+		observability.EvaluateAnnotationForWorkloadMetrics(annotations, options)
+		Expect(options).To(Equal(expected))
+	},
+		Entry("with nil annotations", nil, framework.Options{}),
+		Entry("enables workload metrics for true", map[string]string{AnnotationWorkloadMetrics: "True"}, framework.Options{framework.OptionWorkloadMetrics: "true"}),
+		Entry("disables workload metrics for anything else", map[string]string{AnnotationWorkloadMetrics: "yes"}, framework.Options{}),
+		Entry("does not enable the debug output", map[string]string{AnnotationDebugOutput: "true"}, framework.Options{}),
+	)
+})
@@ -23,6 +23,7 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -231,6 +232,176 @@ var _ = Describe("Reconciling the Collector", func() {
 			Entry("when deployed as a Deployment", receiverForwarder),
 		)
 
+		It("should verify the metrics with the injected service CA bundle when scraped by user workload monitoring", func() {
+			clf := forwarder.DeepCopy()
+			clf.Spec.Collector = &obs.CollectorSpec{
+				Metrics: &obs.CollectorMetricsSpec{Monitoring: obs.MetricsMonitoringUserWorkload},
+			}
+			beforeEach(clf)
+			reconcileCollector(clf)
+
+			caBundleKey := types.NamespacedName{Name: resourceNames.MetricsCABundle, Namespace: namespaceName}
+			caBundle := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), caBundleKey, caBundle)).To(Succeed())
+			Expect(caBundle.Annotations).To(HaveKeyWithValue(constants.AnnotationInjectCABundle, "true"))
+
+			key := types.NamespacedName{Name: clfName, Namespace: namespaceName}
+			sm := &monitoringv1.ServiceMonitor{}
+			Expect(client.Get(context.TODO(), key, sm)).To(Succeed())
+			Expect(sm.Spec.Endpoints[0].TLSConfig.CAFile).To(BeEmpty(), "Exp. no files of the platform Prometheus")
+			Expect(sm.Spec.Endpoints[0].TLSConfig.CA.ConfigMap.Name).To(Equal(resourceNames.MetricsCABundle))
+
+			clf.Spec.Collector = nil
+			reconcileCollector(clf)
+			Expect(client.Get(context.TODO(), caBundleKey, &corev1.ConfigMap{})).ToNot(Succeed(), "Exp. the CA bundle to be removed")
+			Expect(client.Get(context.TODO(), key, sm)).To(Succeed())
+			Expect(sm.Spec.Endpoints[0].TLSConfig.CAFile).ToNot(BeEmpty())
+		})
+
+		It("should deploy a collector daemonset for each shard which collects logs and remove it with the shard", func() {
+			clf := obsruntime.NewClusterLogForwarder(namespaceName, clfName, runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+				clf.Spec = obs.ClusterLogForwarderSpec{
+					Collector: &obs.CollectorSpec{
+						Shards: []obs.CollectorShardSpec{
+							{Name: "audit", InputTypes: []obs.InputType{obs.InputTypeAudit}, PriorityClassName: "audit-priority"},
+							{Name: "infra", InputTypes: []obs.InputType{obs.InputTypeInfrastructure}},
+						},
+					},
+					Inputs: []obs.InputSpec{
+						{Name: "my-app", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+						{Name: "my-audit", Type: obs.InputTypeAudit, Audit: &obs.Audit{Sources: []obs.AuditSource{obs.AuditSourceKube}}},
+					},
+					Outputs: []obs.OutputSpec{
+						{Name: "my-http", Type: obs.OutputTypeHTTP, HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "https://my.example.com"}}},
+					},
+					Pipelines: []obs.PipelineSpec{
+						{Name: "all", InputRefs: []string{"my-app", "my-audit"}, OutputRefs: []string{"my-http"}},
+					},
+					ServiceAccount: obs.ServiceAccount{
+						Name: saName,
+					},
+				}
+			})
+			beforeEach(clf)
+			reconcileCollector(clf)
+
+			shardName := clfName + "-audit"
+			ds := &appsv1.DaemonSet{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: shardName, Namespace: namespaceName}, ds)).To(Succeed())
+			Expect(ds.Spec.Template.Spec.PriorityClassName).To(Equal("audit-priority"))
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: shardName, Namespace: namespaceName}, &corev1.Service{})).To(Succeed())
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: clfName + "-infra", Namespace: namespaceName}, &appsv1.DaemonSet{})).ToNot(Succeed(), "Exp. no collector for a shard without logs to collect")
+
+			config := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: resourceNames.ConfigMap + "-audit", Namespace: namespaceName}, config)).To(Succeed())
+			Expect(config.Data["vector.toml"]).To(ContainSubstring("input_my_audit"))
+			Expect(config.Data["vector.toml"]).ToNot(ContainSubstring("input_my_app"))
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: resourceNames.ConfigMap, Namespace: namespaceName}, config)).To(Succeed())
+			Expect(config.Data["vector.toml"]).To(ContainSubstring("input_my_app"))
+			Expect(config.Data["vector.toml"]).ToNot(ContainSubstring("input_my_audit"))
+
+			clf.Spec.Collector = nil
+			reconcileCollector(clf)
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: shardName, Namespace: namespaceName}, &appsv1.DaemonSet{})).ToNot(Succeed(), "Exp. the collector of the shard to be removed")
+		})
+
+		It("should deploy an aggregator to which the collectors forward the logs and remove it with the aggregator", func() {
+			clf := obsruntime.NewClusterLogForwarder(namespaceName, clfName, runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+				clf.Spec = obs.ClusterLogForwarderSpec{
+					Collector: &obs.CollectorSpec{
+						Aggregator: &obs.AggregatorSpec{Replicas: 3},
+					},
+					Inputs: []obs.InputSpec{
+						{Name: "my-app", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+					},
+					Outputs: []obs.OutputSpec{
+						{Name: "my-http", Type: obs.OutputTypeHTTP, HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "https://my.example.com"}}},
+					},
+					Pipelines: []obs.PipelineSpec{
+						{Name: "all", InputRefs: []string{"my-app"}, OutputRefs: []string{"my-http"}},
+					},
+					ServiceAccount: obs.ServiceAccount{
+						Name: saName,
+					},
+				}
+			})
+			beforeEach(clf)
+			reconcileCollector(clf)
+
+			aggregatorName := clfName + "-aggregator"
+			dpl := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: aggregatorName, Namespace: namespaceName}, dpl)).To(Succeed())
+			Expect(*dpl.Spec.Replicas).To(BeEquivalentTo(3))
+			service := &corev1.Service{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: aggregatorName, Namespace: namespaceName}, service)).To(Succeed())
+			Expect(service.Spec.Ports).To(ContainElement(HaveField("Port", BeEquivalentTo(constants.AggregatorPort))))
+
+			config := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: resourceNames.ConfigMap + "-aggregator", Namespace: namespaceName}, config)).To(Succeed())
+			Expect(config.Data["vector.toml"]).To(ContainSubstring("[sources.input_aggregator]"))
+			Expect(config.Data["vector.toml"]).To(ContainSubstring("[sinks.output_my_http]"))
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: resourceNames.ConfigMap, Namespace: namespaceName}, config)).To(Succeed())
+			Expect(config.Data["vector.toml"]).To(ContainSubstring(`address = "mycollector-aggregator.mylogging.svc:24240"`))
+			Expect(config.Data["vector.toml"]).ToNot(ContainSubstring("[sinks.output_my_http]"))
+
+			clf.Spec.Collector = nil
+			reconcileCollector(clf)
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: aggregatorName, Namespace: namespaceName}, &appsv1.Deployment{})).ToNot(Succeed(), "Exp. the aggregator to be removed")
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: aggregatorName, Namespace: namespaceName}, &corev1.Service{})).ToNot(Succeed(), "Exp. the service of the aggregator to be removed")
+		})
+
+		It("should deploy an aggregator with a persistent queue as a statefulset", func() {
+			clf := obsruntime.NewClusterLogForwarder(namespaceName, clfName, runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+				clf.Spec = obs.ClusterLogForwarderSpec{
+					Collector: &obs.CollectorSpec{
+						Aggregator: &obs.AggregatorSpec{
+							Replicas: 2,
+							PersistentQueue: &obs.AggregatorPersistentQueueSpec{
+								Size:             resource.MustParse("10Gi"),
+								StorageClassName: "my-storage",
+								Retention:        obs.QueueRetentionPolicyDropNewest,
+							},
+						},
+					},
+					Inputs: []obs.InputSpec{
+						{Name: "my-app", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+					},
+					Outputs: []obs.OutputSpec{
+						{Name: "my-http", Type: obs.OutputTypeHTTP, HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "https://my.example.com"}}},
+					},
+					Pipelines: []obs.PipelineSpec{
+						{Name: "all", InputRefs: []string{"my-app"}, OutputRefs: []string{"my-http"}},
+					},
+					ServiceAccount: obs.ServiceAccount{
+						Name: saName,
+					},
+				}
+			})
+			beforeEach(clf)
+			reconcileCollector(clf)
+
+			aggregatorName := clfName + "-aggregator"
+			sts := &appsv1.StatefulSet{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: aggregatorName, Namespace: namespaceName}, sts)).To(Succeed())
+			Expect(sts.Spec.ServiceName).To(Equal(aggregatorName))
+			Expect(sts.Spec.VolumeClaimTemplates).To(HaveLen(1))
+			claim := sts.Spec.VolumeClaimTemplates[0]
+			Expect(*claim.Spec.StorageClassName).To(Equal("my-storage"))
+			Expect(claim.Spec.Resources.Requests.Storage().String()).To(Equal("10Gi"))
+			Expect(sts.Spec.Template.Spec.Volumes).ToNot(ContainElement(HaveField("Name", claim.Name)), "Exp. the data directory to be claimed instead of on the node")
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: aggregatorName, Namespace: namespaceName}, &appsv1.Deployment{})).ToNot(Succeed())
+
+			config := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: resourceNames.ConfigMap + "-aggregator", Namespace: namespaceName}, config)).To(Succeed())
+			Expect(config.Data["vector.toml"]).To(ContainSubstring(`type = "disk"`))
+			Expect(config.Data["vector.toml"]).To(ContainSubstring(`when_full = "drop_newest"`))
+
+			clf.Spec.Collector.Aggregator.PersistentQueue = nil
+			reconcileCollector(clf)
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: aggregatorName, Namespace: namespaceName}, &appsv1.Deployment{})).To(Succeed())
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: aggregatorName, Namespace: namespaceName}, &appsv1.StatefulSet{})).ToNot(Succeed(), "Exp. the statefulset to be replaced by a deployment")
+		})
+
 		DescribeTable("when the cluster proxy is present should use the injected custom CA bundle", func(clf *obs.ClusterLogForwarder, obj cli.Object, templateSpec func(obj cli.Object) corev1.PodTemplateSpec) {
 			beforeEach(clf)
 
@@ -0,0 +1,21 @@
+package observability
+
+import (
+	"slices"
+
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+// EffectiveSpec returns the spec of an initialized forwarder as acted upon by the operator.  Composite inputs and
+// pipeline templates are removed since the pipelines reference the inputs, filters and outputs they resolve to
+func EffectiveSpec(forwarder obsv1.ClusterLogForwarder) *obsv1.ClusterLogForwarderSpec {
+	spec := forwarder.Spec.DeepCopy()
+	spec.Inputs = slices.DeleteFunc(spec.Inputs, func(i obsv1.InputSpec) bool {
+		return i.Type == obsv1.InputTypeComposite
+	})
+	spec.PipelineTemplates = nil
+	for i := range spec.Pipelines {
+		spec.Pipelines[i].TemplateRef = ""
+	}
+	return spec
+}
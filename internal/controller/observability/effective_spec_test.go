@@ -0,0 +1,43 @@
+package observability_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/initialize"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+var _ = Describe("#EffectiveSpec", func() {
+
+	It("should resolve composite inputs and pipeline templates", func() {
+		forwarder := obs.ClusterLogForwarder{
+			Spec: obs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{
+					{Name: "my-app", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+					{Name: "all", Type: obs.InputTypeComposite, Composite: &obs.Composite{InputRefs: []string{"my-app", string(obs.InputTypeInfrastructure)}}},
+				},
+				PipelineTemplates: []obs.PipelineTemplateSpec{
+					{Name: "common", OutputRefs: []string{"default"}},
+				},
+				Pipelines: []obs.PipelineSpec{
+					{Name: "mine", InputRefs: []string{"all"}, TemplateRef: "common"},
+				},
+			},
+		}
+		initialized := initialize.ClusterLogForwarder(forwarder, utils.Options{})
+
+		spec := observability.EffectiveSpec(initialized)
+		names := []string{}
+		for _, i := range spec.Inputs {
+			names = append(names, i.Name)
+		}
+		Expect(names).To(ConsistOf("my-app", "infrastructure"))
+		Expect(spec.PipelineTemplates).To(BeEmpty())
+		Expect(spec.Pipelines).To(Equal([]obs.PipelineSpec{
+			{Name: "mine", InputRefs: []string{"my-app", "infrastructure"}, OutputRefs: []string{"default"}, FilterRefs: []string{}},
+		}))
+		Expect(initialized.Spec.Inputs).To(HaveLen(3), "Exp. the spec of the forwarder to be unchanged")
+	})
+})
@@ -0,0 +1,29 @@
+package observability
+
+import (
+	"context"
+	"slices"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ForwardersOfFilterConfigMap returns the requests to reconcile the forwarders whose filters reference a configmap (e.g.
+// the parsers of a namespaceParsers filter) so changes to the configmap are applied without a change to the forwarders
+func ForwardersOfFilterConfigMap(ctx context.Context, k8sClient client.Reader, configMap client.Object) (requests []ctrl.Request) {
+	forwarders := &obsv1.ClusterLogForwarderList{}
+	if err := k8sClient.List(ctx, forwarders, client.InNamespace(configMap.GetNamespace())); err != nil {
+		log.WithName(loggerName).V(3).Error(err, "unable to list the forwarders referencing a configmap", "configmap", configMap.GetName())
+		return nil
+	}
+	for _, forwarder := range forwarders.Items {
+		if slices.Contains(internalobs.Filters(forwarder.Spec.Filters).ConfigmapNames(), configMap.GetName()) {
+			requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: forwarder.Namespace, Name: forwarder.Name}})
+		}
+	}
+	return requests
+}
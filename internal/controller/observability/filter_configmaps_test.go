@@ -0,0 +1,49 @@
+package observability_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("#ForwardersOfFilterConfigMap", func() {
+
+	const namespace = "openshift-logging"
+
+	newForwarder := func(namespace, name string, filters ...obs.FilterSpec) *obs.ClusterLogForwarder {
+		return &obs.ClusterLogForwarder{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       obs.ClusterLogForwarderSpec{Filters: filters},
+		}
+	}
+	parsers := obs.FilterSpec{
+		Name:             "parsers",
+		Type:             obs.FilterTypeNamespaceParsers,
+		NamespaceParsers: &obs.NamespaceParsersFilterSpec{ConfigMapName: "parsers"},
+	}
+
+	It("should reconcile only the forwarders whose filters reference the configmap", func() {
+		k8sClient := fake.NewFakeClient(
+			newForwarder(namespace, "referencing", parsers),
+			newForwarder(namespace, "other", obs.FilterSpec{Name: "drop", Type: obs.FilterTypeDrop}),
+			newForwarder("other-namespace", "referencing", parsers),
+		)
+		configMap := runtime.NewConfigMap(namespace, "parsers", nil)
+		Expect(observability.ForwardersOfFilterConfigMap(context.TODO(), k8sClient, configMap)).To(Equal([]ctrl.Request{
+			{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "referencing"}},
+		}))
+	})
+
+	It("should not reconcile any forwarder for an unreferenced configmap", func() {
+		k8sClient := fake.NewFakeClient(newForwarder(namespace, "referencing", parsers))
+		Expect(observability.ForwardersOfFilterConfigMap(context.TODO(), k8sClient, runtime.NewConfigMap(namespace, "unrelated", nil))).To(BeEmpty())
+	})
+})
@@ -0,0 +1,53 @@
+package observability
+
+import (
+	"bytes"
+
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HistoryLimit is the maximum number of generations recorded in the history of a forwarder
+const HistoryLimit = 10
+
+// RecordHistory records the result of reconciling the current generation of the forwarder in its status history.
+// A generation is recorded once and its result is updated by subsequent reconciliations.  The oldest records are
+// removed once the history exceeds HistoryLimit
+func RecordHistory(forwarder *obsv1.ClusterLogForwarder, ready metav1.Condition, now metav1.Time) {
+	history := forwarder.Status.History
+	if n := len(history); n > 0 && history[n-1].Generation == forwarder.Generation {
+		record := &history[n-1]
+		record.Status = ready.Status
+		record.Reason = ready.Reason
+		record.Message = ready.Message
+		return
+	}
+	history = append(history, obsv1.ConfigurationRecord{
+		Generation: forwarder.Generation,
+		Time:       now,
+		Manager:    specManager(forwarder.ManagedFields),
+		Status:     ready.Status,
+		Reason:     ready.Reason,
+		Message:    ready.Message,
+	})
+	if len(history) > HistoryLimit {
+		history = history[len(history)-HistoryLimit:]
+	}
+	forwarder.Status.History = history
+}
+
+// specManager returns the field manager that most recently modified the spec
+func specManager(managedFields []metav1.ManagedFieldsEntry) string {
+	manager := ""
+	var latest *metav1.Time
+	for _, entry := range managedFields {
+		if entry.Subresource != "" || entry.FieldsV1 == nil || !bytes.Contains(entry.FieldsV1.Raw, []byte(`"f:spec"`)) {
+			continue
+		}
+		if manager == "" || (entry.Time != nil && (latest == nil || latest.Before(entry.Time))) {
+			manager = entry.Manager
+			latest = entry.Time
+		}
+	}
+	return manager
+}
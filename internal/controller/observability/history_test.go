@@ -0,0 +1,63 @@
+package observability_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("#RecordHistory", func() {
+
+	var (
+		forwarder *obs.ClusterLogForwarder
+		now       metav1.Time
+		ready     metav1.Condition
+		failed    metav1.Condition
+	)
+
+	BeforeEach(func() {
+		now = metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		ready = internalobs.NewCondition(obs.ConditionTypeReady, obs.ConditionTrue, obs.ReasonReconciliationComplete, "")
+		failed = internalobs.NewCondition(obs.ConditionTypeReady, obs.ConditionFalse, obs.ReasonValidationFailure, "invalid")
+		earlier := metav1.NewTime(now.Add(-time.Hour))
+		forwarder = &obs.ClusterLogForwarder{}
+		forwarder.Generation = 1
+		forwarder.ManagedFields = []metav1.ManagedFieldsEntry{
+			{Manager: "kubectl-client-side-apply", Operation: metav1.ManagedFieldsOperationUpdate, Time: &earlier, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{}}`)}},
+			{Manager: "argocd-controller", Operation: metav1.ManagedFieldsOperationApply, Time: &now, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:outputs":{}}}`)}},
+			{Manager: "labeler", Operation: metav1.ManagedFieldsOperationUpdate, Time: &now, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{}}`)}},
+			{Manager: "cluster-logging-operator", Operation: metav1.ManagedFieldsOperationUpdate, Subresource: "status", Time: &now, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:status":{}}`)}},
+		}
+	})
+
+	It("should record the generation, its result and the manager that last modified the spec", func() {
+		observability.RecordHistory(forwarder, failed, now)
+		Expect(forwarder.Status.History).To(Equal([]obs.ConfigurationRecord{
+			{Generation: 1, Time: now, Manager: "argocd-controller", Status: obs.ConditionFalse, Reason: obs.ReasonValidationFailure, Message: "invalid"},
+		}))
+	})
+
+	It("should update the result of a generation that is already recorded", func() {
+		observability.RecordHistory(forwarder, failed, now)
+		observability.RecordHistory(forwarder, ready, metav1.NewTime(now.Add(time.Minute)))
+		Expect(forwarder.Status.History).To(Equal([]obs.ConfigurationRecord{
+			{Generation: 1, Time: now, Manager: "argocd-controller", Status: obs.ConditionTrue, Reason: obs.ReasonReconciliationComplete},
+		}))
+	})
+
+	It("should retain only the most recent generations", func() {
+		for i := 1; i <= observability.HistoryLimit+2; i++ {
+			forwarder.Generation = int64(i)
+			observability.RecordHistory(forwarder, ready, now)
+		}
+		history := forwarder.Status.History
+		Expect(history).To(HaveLen(observability.HistoryLimit))
+		Expect(history[0].Generation).To(BeEquivalentTo(3))
+		Expect(history[observability.HistoryLimit-1].Generation).To(BeEquivalentTo(observability.HistoryLimit + 2))
+	})
+})
@@ -0,0 +1,62 @@
+package observability
+
+import (
+	"fmt"
+	"time"
+
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/utils/cron"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const defaultMaintenanceWindowDuration = time.Hour
+
+// DeferToMaintenanceWindow evaluates the maintenance window annotations of the forwarder to determine if changes to
+// the spec must be deferred.  It returns the time at which the next window opens when they are deferred.  Changes
+// are never deferred for a forwarder that has not yet been successfully reconciled
+func DeferToMaintenanceWindow(forwarder *obsv1.ClusterLogForwarder, now time.Time) (*time.Time, error) {
+	expr, found := forwarder.Annotations[constants.AnnotationMaintenanceWindow]
+	if !found {
+		return nil, nil
+	}
+	schedule, err := cron.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %v", constants.AnnotationMaintenanceWindow, err)
+	}
+	duration := defaultMaintenanceWindowDuration
+	if value, found := forwarder.Annotations[constants.AnnotationMaintenanceWindowDuration]; found {
+		if duration, err = time.ParseDuration(value); err != nil || duration <= 0 {
+			return nil, fmt.Errorf("invalid %s annotation: %q is not a positive duration", constants.AnnotationMaintenanceWindowDuration, value)
+		}
+	}
+
+	applied, found := appliedGeneration(forwarder.Status)
+	if !found || applied == forwarder.Generation {
+		return nil, nil
+	}
+	now = now.UTC()
+	if start := schedule.Next(now.Add(-duration)); !start.IsZero() && !start.After(now) {
+		return nil, nil
+	}
+	next := schedule.Next(now)
+	if next.IsZero() {
+		return nil, fmt.Errorf("invalid %s annotation: %q never opens", constants.AnnotationMaintenanceWindow, expr)
+	}
+	return &next, nil
+}
+
+// appliedGeneration is the most recent generation of the spec that was successfully reconciled.  The history is only
+// consulted for forwarders reconciled before the applied generation was recorded
+func appliedGeneration(status obsv1.ClusterLogForwarderStatus) (int64, bool) {
+	if status.AppliedGeneration > 0 {
+		return status.AppliedGeneration, true
+	}
+	history := status.History
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Status == metav1.ConditionTrue {
+			return history[i].Generation, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,90 @@
+package observability_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("#DeferToMaintenanceWindow", func() {
+
+	var (
+		forwarder *obs.ClusterLogForwarder
+		// Monday
+		now = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		forwarder = &obs.ClusterLogForwarder{}
+		forwarder.Generation = 2
+		forwarder.Annotations = map[string]string{
+			constants.AnnotationMaintenanceWindow: "0 2 * * 6",
+		}
+		forwarder.Status.History = []obs.ConfigurationRecord{
+			{Generation: 1, Status: metav1.ConditionTrue},
+			{Generation: 2, Status: metav1.ConditionFalse, Reason: obs.ReasonMaintenanceWindowPending},
+		}
+	})
+
+	It("should defer changes until the next window opens", func() {
+		Expect(observability.DeferToMaintenanceWindow(forwarder, now)).To(Equal(utils.GetPtr(time.Date(2024, 1, 6, 2, 0, 0, 0, time.UTC))))
+	})
+
+	It("should apply changes while the window is open", func() {
+		Expect(observability.DeferToMaintenanceWindow(forwarder, time.Date(2024, 1, 6, 2, 59, 0, 0, time.UTC))).To(BeNil())
+	})
+
+	It("should honor the duration of the window", func() {
+		forwarder.Annotations[constants.AnnotationMaintenanceWindowDuration] = "30m"
+		Expect(observability.DeferToMaintenanceWindow(forwarder, time.Date(2024, 1, 6, 2, 45, 0, 0, time.UTC))).To(Equal(utils.GetPtr(time.Date(2024, 1, 13, 2, 0, 0, 0, time.UTC))))
+	})
+
+	It("should not defer when the generation has already been applied", func() {
+		forwarder.Status.History = forwarder.Status.History[:1]
+		forwarder.Generation = 1
+		Expect(observability.DeferToMaintenanceWindow(forwarder, now)).To(BeNil())
+	})
+
+	It("should defer changes once the applied generation is no longer in the history", func() {
+		forwarder.Generation = 12
+		forwarder.Status.AppliedGeneration = 1
+		forwarder.Status.History = nil
+		for generation := int64(3); generation <= 12; generation++ {
+			forwarder.Status.History = append(forwarder.Status.History, obs.ConfigurationRecord{Generation: generation, Status: metav1.ConditionFalse, Reason: obs.ReasonMaintenanceWindowPending})
+		}
+		Expect(observability.DeferToMaintenanceWindow(forwarder, now)).To(Equal(utils.GetPtr(time.Date(2024, 1, 6, 2, 0, 0, 0, time.UTC))))
+	})
+
+	It("should not defer when the applied generation is the current generation", func() {
+		forwarder.Status.AppliedGeneration = 2
+		Expect(observability.DeferToMaintenanceWindow(forwarder, now)).To(BeNil())
+	})
+
+	It("should not defer when the forwarder has never been applied", func() {
+		forwarder.Status.History = nil
+		Expect(observability.DeferToMaintenanceWindow(forwarder, now)).To(BeNil())
+	})
+
+	It("should not defer without a maintenance window", func() {
+		delete(forwarder.Annotations, constants.AnnotationMaintenanceWindow)
+		Expect(observability.DeferToMaintenanceWindow(forwarder, now)).To(BeNil())
+	})
+
+	It("should fail for an invalid window", func() {
+		forwarder.Annotations[constants.AnnotationMaintenanceWindow] = "every saturday"
+		_, err := observability.DeferToMaintenanceWindow(forwarder, now)
+		Expect(err).To(MatchError(ContainSubstring("invalid " + constants.AnnotationMaintenanceWindow)))
+	})
+
+	It("should fail for an invalid duration", func() {
+		forwarder.Annotations[constants.AnnotationMaintenanceWindowDuration] = "-1h"
+		_, err := observability.DeferToMaintenanceWindow(forwarder, now)
+		Expect(err).To(MatchError(ContainSubstring("is not a positive duration")))
+	})
+})
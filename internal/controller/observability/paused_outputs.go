@@ -0,0 +1,36 @@
+package observability
+
+import (
+	"fmt"
+
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// PausedOutputs records the paused state of the outputs in the status of the forwarder and returns the names of the
+// outputs which were resumed.  The records spooled on the nodes while these outputs were paused are delivered
+// once they are resumed
+func PausedOutputs(forwarder *obsv1.ClusterLogForwarder) []string {
+	resumed := []string{}
+	for _, output := range forwarder.Spec.Outputs {
+		if output.Paused {
+			message := fmt.Sprintf("output %q is paused and its records are spooled on the nodes", output.Name)
+			if output.PausePolicy == obsv1.PausePolicyDrop {
+				message = fmt.Sprintf("output %q is paused and its records are dropped", output.Name)
+			}
+			internalobs.SetCondition(&forwarder.Status.Outputs,
+				internalobs.NewConditionFromPrefix(obsv1.ConditionTypePausedOutputPrefix, output.Name, true, obsv1.ReasonOutputPaused, message))
+			continue
+		}
+		conditionType := fmt.Sprintf("%s-%s", obsv1.ConditionTypePausedOutputPrefix, output.Name)
+		if meta.FindStatusCondition(forwarder.Status.Outputs, conditionType) == nil {
+			continue
+		}
+		resumed = append(resumed, output.Name)
+		internalobs.SetCondition(&forwarder.Status.Outputs,
+			internalobs.NewConditionFromPrefix(obsv1.ConditionTypePausedOutputPrefix, output.Name, false, obsv1.ReasonOutputResumed,
+				fmt.Sprintf("output %q was resumed and the records spooled while it was paused are delivered", output.Name)))
+	}
+	return resumed
+}
@@ -0,0 +1,59 @@
+package observability_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+var _ = Describe("#PausedOutputs", func() {
+
+	const (
+		pausedType = obs.ConditionTypePausedOutputPrefix + "-my-output"
+	)
+
+	var (
+		forwarder *obs.ClusterLogForwarder
+	)
+
+	BeforeEach(func() {
+		forwarder = &obs.ClusterLogForwarder{}
+		forwarder.Spec.Outputs = []obs.OutputSpec{
+			{Name: "my-output", Type: obs.OutputTypeHTTP},
+			{Name: "other", Type: obs.OutputTypeHTTP},
+		}
+	})
+
+	It("should record the paused state of paused outputs", func() {
+		forwarder.Spec.Outputs[0].Paused = true
+		Expect(observability.PausedOutputs(forwarder)).To(BeEmpty())
+		condition := meta.FindStatusCondition(forwarder.Status.Outputs, pausedType)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(obs.ConditionTrue))
+		Expect(condition.Reason).To(Equal(obs.ReasonOutputPaused))
+		Expect(condition.Message).To(ContainSubstring("spooled"))
+		Expect(forwarder.Status.Outputs).To(HaveLen(1), "exp no condition for outputs which were never paused")
+	})
+
+	It("should report records are dropped for the Drop policy", func() {
+		forwarder.Spec.Outputs[0].Paused = true
+		forwarder.Spec.Outputs[0].PausePolicy = obs.PausePolicyDrop
+		observability.PausedOutputs(forwarder)
+		Expect(meta.FindStatusCondition(forwarder.Status.Outputs, pausedType).Message).To(ContainSubstring("dropped"))
+	})
+
+	It("should return the outputs which were resumed", func() {
+		forwarder.Spec.Outputs[0].Paused = true
+		observability.PausedOutputs(forwarder)
+
+		forwarder.Spec.Outputs[0].Paused = false
+		Expect(observability.PausedOutputs(forwarder)).To(Equal([]string{"my-output"}))
+		condition := meta.FindStatusCondition(forwarder.Status.Outputs, pausedType)
+		Expect(condition.Status).To(Equal(obs.ConditionFalse))
+		Expect(condition.Reason).To(Equal(obs.ReasonOutputResumed))
+
+		Expect(observability.PausedOutputs(forwarder)).To(Equal([]string{"my-output"}), "exp spooled records to be delivered after later reconciliations")
+	})
+})
@@ -0,0 +1,227 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	authorizationapi "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/set"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationCopiedFrom identifies the secret from which a secret was copied into the namespace of the forwarder
+	AnnotationCopiedFrom = "observability.openshift.io/copied-from"
+
+	copiedSecretComponent = "copied-secret"
+)
+
+// CopySecrets copies secrets referenced from other namespaces into the namespace of the forwarder and replaces
+// the references with references to the copies.  Copies that are no longer referenced are removed
+func CopySecrets(k8Client client.Client, forwarder *obsv1.ClusterLogForwarder) error {
+	copies := map[types.NamespacedName]string{}
+	for _, ref := range secretNamespaceReferences(forwarder) {
+		if ref.namespace == "" || ref.namespace == forwarder.Namespace {
+			ref.setName(ref.name)
+			continue
+		}
+		source := types.NamespacedName{Namespace: ref.namespace, Name: ref.name}
+		name, found := copies[source]
+		if !found {
+			var err error
+			if name, err = copySecret(k8Client, forwarder, source); err != nil {
+				return err
+			}
+			copies[source] = name
+		}
+		ref.setName(name)
+	}
+	desired := set.New[string]()
+	for _, name := range copies {
+		desired.Insert(name)
+	}
+	return removeStaleSecretCopies(k8Client, forwarder, desired)
+}
+
+func copySecret(k8Client client.Client, forwarder *obsv1.ClusterLogForwarder, source types.NamespacedName) (string, error) {
+	if err := authorizeSecretCopy(k8Client, forwarder, source); err != nil {
+		return "", err
+	}
+	secret := &corev1.Secret{}
+	if err := k8Client.Get(context.TODO(), source, secret); err != nil {
+		return "", fmt.Errorf("unable to fetch secret %q: %v", source.String(), err)
+	}
+	name, err := secretCopyName(forwarder.Name, source)
+	if err != nil {
+		return "", err
+	}
+	desired := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: forwarder.Namespace,
+			Labels: map[string]string{
+				constants.LabelK8sManagedBy: constants.ClusterLoggingOperator,
+				constants.LabelK8sInstance:  forwarder.Name,
+				constants.LabelK8sComponent: copiedSecretComponent,
+			},
+			Annotations: map[string]string{
+				AnnotationCopiedFrom: source.String(),
+			},
+		},
+		Type: secret.Type,
+		Data: secret.Data,
+	}
+	utils.AddOwnerRefToObject(desired, utils.AsOwner(forwarder))
+
+	current := &corev1.Secret{}
+	if err := k8Client.Get(context.TODO(), client.ObjectKeyFromObject(desired), current); err != nil {
+		if !errors.IsNotFound(err) {
+			return "", err
+		}
+		log.WithName(loggerName).V(3).Info("creating secret copy", "source", source, "name", desired.Name)
+		return desired.Name, k8Client.Create(context.TODO(), desired)
+	}
+	if current.Annotations[AnnotationCopiedFrom] != source.String() {
+		return "", fmt.Errorf("unable to copy secret %q: secret %q already exists and is not a copy of it", source.String(), desired.Name)
+	}
+	current.Data = desired.Data
+	current.Labels = desired.Labels
+	current.OwnerReferences = desired.OwnerReferences
+	return desired.Name, k8Client.Update(context.TODO(), current)
+}
+
+// secretCopyName returns the name of the copy of a secret which is suffixed with a hash of the namespace and name of
+// the source, so the copies of secrets whose namespace and name only differ by the position of a dash do not collide
+func secretCopyName(forwarderName string, source types.NamespacedName) (string, error) {
+	hash, err := utils.CalculateMD5Hash(source.String())
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s-%s", forwarderName, source.Name, hash[:10]), nil
+}
+
+// ForwardersOfCopiedSecret returns the requests to reconcile the forwarders which copied a secret from another
+// namespace so the copies are kept in sync with the source secret
+func ForwardersOfCopiedSecret(ctx context.Context, k8sClient client.Reader, secret client.Object) (requests []ctrl.Request) {
+	source := client.ObjectKeyFromObject(secret).String()
+	copies := &corev1.SecretList{}
+	if err := k8sClient.List(ctx, copies, client.MatchingLabels{
+		constants.LabelK8sManagedBy: constants.ClusterLoggingOperator,
+		constants.LabelK8sComponent: copiedSecretComponent,
+	}); err != nil {
+		log.WithName(loggerName).V(3).Error(err, "unable to list the copies of a secret", "secret", source)
+		return nil
+	}
+	for _, copied := range copies.Items {
+		if copied.Annotations[AnnotationCopiedFrom] == source {
+			requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: copied.Namespace, Name: copied.Labels[constants.LabelK8sInstance]}})
+		}
+	}
+	return requests
+}
+
+// authorizeSecretCopy verifies the service account of the forwarder is permitted to get the source secret so
+// the operator does not expose secrets to forwarders which could not otherwise read them
+func authorizeSecretCopy(k8Client client.Client, forwarder *obsv1.ClusterLogForwarder, source types.NamespacedName) error {
+	sar := &authorizationapi.SubjectAccessReview{
+		Spec: authorizationapi.SubjectAccessReviewSpec{
+			User: fmt.Sprintf("system:serviceaccount:%s:%s", forwarder.Namespace, forwarder.Spec.ServiceAccount.Name),
+			ResourceAttributes: &authorizationapi.ResourceAttributes{
+				Namespace: source.Namespace,
+				Verb:      "get",
+				Resource:  "secrets",
+				Name:      source.Name,
+			},
+		},
+	}
+	if err := k8Client.Create(context.TODO(), sar); err != nil {
+		return err
+	}
+	if !sar.Status.Allowed {
+		return fmt.Errorf("serviceaccount %q is not permitted to get secret %q", forwarder.Spec.ServiceAccount.Name, source.String())
+	}
+	return nil
+}
+
+func removeStaleSecretCopies(k8Client client.Client, forwarder *obsv1.ClusterLogForwarder, desired set.Set[string]) error {
+	list := &corev1.SecretList{}
+	if err := k8Client.List(context.TODO(), list, client.InNamespace(forwarder.Namespace), client.MatchingLabels{
+		constants.LabelK8sManagedBy: constants.ClusterLoggingOperator,
+		constants.LabelK8sInstance:  forwarder.Name,
+		constants.LabelK8sComponent: copiedSecretComponent,
+	}); err != nil {
+		return err
+	}
+	for i, secret := range list.Items {
+		if desired.Has(secret.Name) {
+			continue
+		}
+		log.WithName(loggerName).V(3).Info("removing stale secret copy", "name", secret.Name)
+		if err := k8Client.Delete(context.TODO(), &list.Items[i]); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// secretNamespaceRef is a reference to a secret by either a SecretReference or a ValueReference
+type secretNamespaceRef struct {
+	name      string
+	namespace string
+	// setName replaces the reference with one to the named secret in the namespace of the forwarder
+	setName func(string)
+}
+
+func secretNamespaceReferences(forwarder *obsv1.ClusterLogForwarder) (refs []secretNamespaceRef) {
+	addSecretRef := func(ref *obsv1.SecretReference) {
+		if ref != nil {
+			refs = append(refs, secretNamespaceRef{
+				name:      ref.SecretName,
+				namespace: ref.SecretNamespace,
+				setName: func(name string) {
+					ref.SecretName = name
+					ref.SecretNamespace = ""
+				},
+			})
+		}
+	}
+	addValueRef := func(ref *obsv1.ValueReference) {
+		if ref != nil && ref.SecretName != "" {
+			refs = append(refs, secretNamespaceRef{
+				name:      ref.SecretName,
+				namespace: ref.SecretNamespace,
+				setName: func(name string) {
+					ref.SecretName = name
+					ref.SecretNamespace = ""
+				},
+			})
+		}
+	}
+	for _, o := range forwarder.Spec.Outputs {
+		for _, ref := range internalobs.SecretReferences(o) {
+			addSecretRef(ref)
+		}
+		if o.TLS != nil {
+			addValueRef(o.TLS.CA)
+			addValueRef(o.TLS.Certificate)
+			addSecretRef(o.TLS.Key)
+			addSecretRef(o.TLS.KeyPassphrase)
+		}
+	}
+	for _, f := range forwarder.Spec.Filters {
+		if f.Encrypt != nil {
+			addSecretRef(f.Encrypt.Key)
+		}
+	}
+	return refs
+}
@@ -0,0 +1,159 @@
+package observability_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	authorizationapi "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("#CopySecrets", func() {
+
+	const (
+		sourceNamespace = "my-app"
+		copyName        = "my-forwarder-creds-92eed81f13"
+	)
+
+	var (
+		forwarder *obs.ClusterLogForwarder
+		source    *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		forwarder = obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, "my-forwarder", runtime.Initialize)
+		forwarder.Spec.ServiceAccount.Name = "logcollector"
+		forwarder.Spec.Outputs = []obs.OutputSpec{
+			{
+				Name: "http",
+				Type: obs.OutputTypeHTTP,
+				HTTP: &obs.HTTP{
+					Authentication: &obs.HTTPAuthentication{
+						Username: &obs.SecretReference{Key: "username", SecretName: "creds", SecretNamespace: sourceNamespace},
+						Password: &obs.SecretReference{Key: "password", SecretName: "local"},
+					},
+				},
+				TLS: &obs.OutputTLSSpec{
+					TLSSpec: obs.TLSSpec{
+						CA: &obs.ValueReference{Key: "ca.crt", SecretName: "creds", SecretNamespace: sourceNamespace},
+					},
+				},
+			},
+		}
+		source = runtime.NewSecret(sourceNamespace, "creds", map[string][]byte{"username": []byte("fred")})
+	})
+
+	It("should copy the secret and reference the copy when the service account is permitted", func() {
+		k8sClient := &mockSecretSARClient{Client: fake.NewFakeClient(source), allowed: true}
+		Expect(observability.CopySecrets(k8sClient, forwarder)).To(Succeed())
+
+		auth := forwarder.Spec.Outputs[0].HTTP.Authentication
+		Expect(auth.Username).To(Equal(&obs.SecretReference{Key: "username", SecretName: copyName}))
+		Expect(auth.Password).To(Equal(&obs.SecretReference{Key: "password", SecretName: "local"}))
+		Expect(forwarder.Spec.Outputs[0].TLS.CA).To(Equal(&obs.ValueReference{Key: "ca.crt", SecretName: copyName}))
+
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: copyName}, secret)).To(Succeed())
+		Expect(secret.Data).To(Equal(source.Data))
+		Expect(secret.Annotations).To(HaveKeyWithValue(observability.AnnotationCopiedFrom, "my-app/creds"))
+	})
+
+	It("should keep the copy in sync with the source secret", func() {
+		k8sClient := &mockSecretSARClient{Client: fake.NewFakeClient(source), allowed: true}
+		Expect(observability.CopySecrets(k8sClient, forwarder.DeepCopy())).To(Succeed())
+
+		source.Data["username"] = []byte("wilma")
+		Expect(k8sClient.Update(context.TODO(), source)).To(Succeed())
+		Expect(observability.CopySecrets(k8sClient, forwarder)).To(Succeed())
+
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: copyName}, secret)).To(Succeed())
+		Expect(secret.Data["username"]).To(Equal([]byte("wilma")))
+	})
+
+	It("should remove copies that are no longer referenced", func() {
+		k8sClient := &mockSecretSARClient{Client: fake.NewFakeClient(source), allowed: true}
+		Expect(observability.CopySecrets(k8sClient, forwarder.DeepCopy())).To(Succeed())
+
+		forwarder.Spec.Outputs[0].HTTP.Authentication.Username.SecretNamespace = ""
+		forwarder.Spec.Outputs[0].TLS = nil
+		Expect(observability.CopySecrets(k8sClient, forwarder)).To(Succeed())
+
+		err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: copyName}, &corev1.Secret{})
+		Expect(errors.IsNotFound(err)).To(BeTrue(), "Exp. the stale copy to be removed")
+	})
+
+	It("should not collide the copies of secrets whose namespace and name only differ by the position of a dash", func() {
+		forwarder.Spec.Outputs[0].HTTP.Authentication.Username = &obs.SecretReference{Key: "username", SecretName: "d", SecretNamespace: "b-c"}
+		forwarder.Spec.Outputs[0].HTTP.Authentication.Password = &obs.SecretReference{Key: "password", SecretName: "c-d", SecretNamespace: "b"}
+		forwarder.Spec.Outputs[0].TLS = nil
+		k8sClient := &mockSecretSARClient{Client: fake.NewFakeClient(
+			runtime.NewSecret("b-c", "d", map[string][]byte{"username": []byte("fred")}),
+			runtime.NewSecret("b", "c-d", map[string][]byte{"password": []byte("secret")}),
+		), allowed: true}
+		Expect(observability.CopySecrets(k8sClient, forwarder)).To(Succeed())
+
+		auth := forwarder.Spec.Outputs[0].HTTP.Authentication
+		Expect(auth.Username.SecretName).ToNot(Equal(auth.Password.SecretName))
+		for _, name := range []string{auth.Username.SecretName, auth.Password.SecretName} {
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: constants.OpenshiftNS, Name: name}, &corev1.Secret{})).To(Succeed())
+		}
+	})
+
+	It("should fail when the service account is not permitted to get the secret", func() {
+		k8sClient := &mockSecretSARClient{Client: fake.NewFakeClient(source)}
+		Expect(observability.CopySecrets(k8sClient, forwarder)).To(MatchError(`serviceaccount "logcollector" is not permitted to get secret "my-app/creds"`))
+	})
+})
+
+var _ = Describe("#ForwardersOfCopiedSecret", func() {
+
+	It("should reconcile only the forwarders which copied the secret", func() {
+		forwarder := obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, "my-forwarder", runtime.Initialize)
+		forwarder.Spec.ServiceAccount.Name = "logcollector"
+		forwarder.Spec.Outputs = []obs.OutputSpec{
+			{
+				Name: "http",
+				Type: obs.OutputTypeHTTP,
+				HTTP: &obs.HTTP{
+					Authentication: &obs.HTTPAuthentication{
+						Username: &obs.SecretReference{Key: "username", SecretName: "creds", SecretNamespace: "my-app"},
+					},
+				},
+			},
+		}
+		source := runtime.NewSecret("my-app", "creds", map[string][]byte{"username": []byte("fred")})
+		k8sClient := &mockSecretSARClient{Client: fake.NewFakeClient(source), allowed: true}
+		Expect(observability.CopySecrets(k8sClient, forwarder)).To(Succeed())
+
+		Expect(observability.ForwardersOfCopiedSecret(context.TODO(), k8sClient, source)).To(Equal([]ctrl.Request{
+			{NamespacedName: types.NamespacedName{Namespace: constants.OpenshiftNS, Name: "my-forwarder"}},
+		}))
+		Expect(observability.ForwardersOfCopiedSecret(context.TODO(), k8sClient, runtime.NewSecret("my-app", "other", nil))).To(BeEmpty())
+	})
+})
+
+// mockSecretSARClient answers subject access reviews with a fixed result
+type mockSecretSARClient struct {
+	client.Client
+	allowed bool
+}
+
+func (c *mockSecretSARClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if sar, ok := obj.(*authorizationapi.SubjectAccessReview); ok {
+		sar.Status.Allowed = c.allowed
+		return nil
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
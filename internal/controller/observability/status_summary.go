@@ -0,0 +1,155 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/collector"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// pendingReasons are the reasons of the Ready condition while changes to the spec are held back before their rollout
+var pendingReasons = map[string]bool{
+	obsv1.ReasonConfigValidationPending:  true,
+	obsv1.ReasonCanaryRolloutPending:     true,
+	obsv1.ReasonMaintenanceWindowPending: true,
+}
+
+// rollout is the state of the rollout of the collector workloads of a forwarder
+type rollout struct {
+	desired   int32
+	updated   int32
+	available int32
+	// observed is false while a workload has not observed the latest change to its spec
+	observed bool
+}
+
+// SummarizeStatus sets the Available, Progressing and Degraded conditions which summarize the health of the forwarder
+// following the conventions of the ClusterOperator status, so that it can be consumed by cluster health tooling.  The
+// conditions are derived from the Ready condition and the rollout of the collector workloads
+func SummarizeStatus(k8Client client.Client, forwarder *obsv1.ClusterLogForwarder, ready metav1.Condition) {
+	conditions := &forwarder.Status.Conditions
+	if ready.Status == obsv1.ConditionUnknown {
+		for _, conditionType := range []string{obsv1.ConditionTypeAvailable, obsv1.ConditionTypeProgressing, obsv1.ConditionTypeDegraded} {
+			internalobs.SetCondition(conditions, internalobs.NewCondition(conditionType, obsv1.ConditionUnknown, ready.Reason, ready.Message))
+		}
+		return
+	}
+	r := collectorRollout(k8Client, forwarder)
+
+	available := internalobs.NewCondition(obsv1.ConditionTypeAvailable, obsv1.ConditionTrue, obsv1.ReasonCollectorAvailable,
+		fmt.Sprintf("%d of %d collector pods are available", r.available, r.desired))
+	if r.available == 0 {
+		available.Status = obsv1.ConditionFalse
+		available.Reason = obsv1.ReasonCollectorUnavailable
+		available.Message = "no collector pods are available"
+	}
+
+	rolledOut := r.observed && r.updated >= r.desired
+	progressing := internalobs.NewCondition(obsv1.ConditionTypeProgressing, obsv1.ConditionFalse, obsv1.ReasonRolloutComplete, "")
+	switch {
+	case pendingReasons[ready.Reason]:
+		progressing = internalobs.NewCondition(obsv1.ConditionTypeProgressing, obsv1.ConditionTrue, ready.Reason, ready.Message)
+	case !rolledOut:
+		progressing = internalobs.NewCondition(obsv1.ConditionTypeProgressing, obsv1.ConditionTrue, obsv1.ReasonRolloutInProgress,
+			fmt.Sprintf("%d of %d collector pods are updated", r.updated, r.desired))
+	}
+
+	// the Valid condition is false while the valid pipelines of a forwarder in Permissive mode are forwarded
+	valid := meta.FindStatusCondition(forwarder.Status.Conditions, obsv1.ConditionTypeValid)
+	degraded := internalobs.NewCondition(obsv1.ConditionTypeDegraded, obsv1.ConditionFalse, obsv1.ReasonAsExpected, "")
+	switch {
+	case ready.Status == obsv1.ConditionFalse && !pendingReasons[ready.Reason]:
+		degraded = internalobs.NewCondition(obsv1.ConditionTypeDegraded, obsv1.ConditionTrue, ready.Reason, ready.Message)
+	case valid != nil && valid.Status == obsv1.ConditionFalse:
+		degraded = internalobs.NewCondition(obsv1.ConditionTypeDegraded, obsv1.ConditionTrue, valid.Reason, valid.Message)
+	case rolledOut && r.available < r.desired:
+		degraded = internalobs.NewCondition(obsv1.ConditionTypeDegraded, obsv1.ConditionTrue, obsv1.ReasonCollectorPodsUnavailable,
+			fmt.Sprintf("%d of %d collector pods are unavailable", r.desired-r.available, r.desired))
+	}
+
+	internalobs.SetCondition(conditions, available)
+	internalobs.SetCondition(conditions, progressing)
+	internalobs.SetCondition(conditions, degraded)
+}
+
+// collectorRollout sums the rollout of the collector, of its shards and of its aggregator
+func collectorRollout(k8Client client.Client, forwarder *obsv1.ClusterLogForwarder) rollout {
+	r := rollout{observed: true}
+	names := factory.ResourceNames(*forwarder)
+	namespace := forwarder.Namespace
+	if internalobs.DeployAsDeployment(*forwarder) {
+		r.addDeployment(k8Client, namespace, names.DaemonSetName())
+	} else {
+		r.addDaemonSet(k8Client, namespace, names.DaemonSetName())
+		for _, shard := range internalobs.CollectorShards(forwarder.Spec) {
+			r.addDaemonSet(k8Client, namespace, collector.ShardResourceNames(names, shard.Name).DaemonSetName())
+		}
+		if forwarder.Spec.Collector != nil && forwarder.Spec.Collector.Aggregator != nil {
+			aggregatorName := collector.AggregatorResourceNames(names).DaemonSetName()
+			if forwarder.Spec.Collector.Aggregator.PersistentQueue != nil {
+				r.addStatefulSet(k8Client, namespace, aggregatorName)
+			} else {
+				r.addDeployment(k8Client, namespace, aggregatorName)
+			}
+		}
+	}
+	return r
+}
+
+func (r *rollout) addDaemonSet(k8Client client.Client, namespace, name string) {
+	ds := &appsv1.DaemonSet{}
+	if !r.get(k8Client, namespace, name, ds) {
+		return
+	}
+	r.add(ds.Status.DesiredNumberScheduled, ds.Status.UpdatedNumberScheduled, ds.Status.NumberAvailable, ds.Status.ObservedGeneration >= ds.Generation)
+}
+
+func (r *rollout) addDeployment(k8Client client.Client, namespace, name string) {
+	dpl := &appsv1.Deployment{}
+	if !r.get(k8Client, namespace, name, dpl) {
+		return
+	}
+	desired := int32(1)
+	if dpl.Spec.Replicas != nil {
+		desired = *dpl.Spec.Replicas
+	}
+	r.add(desired, dpl.Status.UpdatedReplicas, dpl.Status.AvailableReplicas, dpl.Status.ObservedGeneration >= dpl.Generation)
+}
+
+func (r *rollout) addStatefulSet(k8Client client.Client, namespace, name string) {
+	sts := &appsv1.StatefulSet{}
+	if !r.get(k8Client, namespace, name, sts) {
+		return
+	}
+	desired := int32(1)
+	if sts.Spec.Replicas != nil {
+		desired = *sts.Spec.Replicas
+	}
+	r.add(desired, sts.Status.UpdatedReplicas, sts.Status.AvailableReplicas, sts.Status.ObservedGeneration >= sts.Generation)
+}
+
+// get fetches a workload of the collector.  A workload which can not be fetched has not observed its spec
+func (r *rollout) get(k8Client client.Client, namespace, name string, workload client.Object) bool {
+	err := k8Client.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: name}, workload)
+	if err != nil && !errors.IsNotFound(err) {
+		log.V(3).Error(err, "Unable to fetch the collector workload", "namespace", namespace, "name", name)
+		r.observed = false
+	}
+	return err == nil
+}
+
+func (r *rollout) add(desired, updated, available int32, observed bool) {
+	r.desired += desired
+	r.updated += updated
+	r.available += available
+	r.observed = r.observed && observed
+}
@@ -0,0 +1,109 @@
+package observability_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("#SummarizeStatus", func() {
+
+	const (
+		namespace = "openshift-logging"
+		name      = "my-forwarder"
+	)
+
+	var (
+		forwarder *obs.ClusterLogForwarder
+		ready     = internalobs.NewCondition(obs.ConditionTypeReady, obs.ConditionTrue, obs.ReasonReconciliationComplete, "")
+
+		daemonSet = func(desired, updated, available int32) *appsv1.DaemonSet {
+			return &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 2},
+				Status: appsv1.DaemonSetStatus{
+					ObservedGeneration:     2,
+					DesiredNumberScheduled: desired,
+					UpdatedNumberScheduled: updated,
+					NumberAvailable:        available,
+				},
+			}
+		}
+		summarize = func(ready metav1.Condition, ds *appsv1.DaemonSet) {
+			k8sClient := fake.NewFakeClient() //nolint
+			if ds != nil {
+				k8sClient = fake.NewFakeClient(ds) //nolint
+			}
+			observability.SummarizeStatus(k8sClient, forwarder, ready)
+		}
+		condition = func(conditionType string) *metav1.Condition {
+			return meta.FindStatusCondition(forwarder.Status.Conditions, conditionType)
+		}
+	)
+
+	BeforeEach(func() {
+		forwarder = &obs.ClusterLogForwarder{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	})
+
+	It("should be available and not progressing nor degraded when the collector is rolled out", func() {
+		summarize(ready, daemonSet(3, 3, 3))
+		Expect(condition(obs.ConditionTypeAvailable).Status).To(Equal(obs.ConditionTrue))
+		Expect(condition(obs.ConditionTypeProgressing).Reason).To(Equal(obs.ReasonRolloutComplete))
+		Expect(condition(obs.ConditionTypeProgressing).Status).To(Equal(obs.ConditionFalse))
+		Expect(condition(obs.ConditionTypeDegraded).Reason).To(Equal(obs.ReasonAsExpected))
+		Expect(condition(obs.ConditionTypeDegraded).Status).To(Equal(obs.ConditionFalse))
+	})
+
+	It("should be progressing while the collector is rolled out", func() {
+		summarize(ready, daemonSet(3, 1, 3))
+		Expect(condition(obs.ConditionTypeProgressing).Status).To(Equal(obs.ConditionTrue))
+		Expect(condition(obs.ConditionTypeProgressing).Message).To(Equal("1 of 3 collector pods are updated"))
+		Expect(condition(obs.ConditionTypeDegraded).Status).To(Equal(obs.ConditionFalse))
+	})
+
+	It("should be progressing while changes are held back before their rollout", func() {
+		pending := internalobs.NewCondition(obs.ConditionTypeReady, obs.ConditionFalse, obs.ReasonCanaryRolloutPending, "evaluating")
+		summarize(pending, daemonSet(3, 3, 3))
+		Expect(condition(obs.ConditionTypeProgressing).Status).To(Equal(obs.ConditionTrue))
+		Expect(condition(obs.ConditionTypeProgressing).Reason).To(Equal(obs.ReasonCanaryRolloutPending))
+		Expect(condition(obs.ConditionTypeDegraded).Status).To(Equal(obs.ConditionFalse))
+	})
+
+	It("should be degraded when the valid pipelines of an invalid spec are forwarded", func() {
+		forwarder.Status.Conditions = []metav1.Condition{
+			internalobs.NewCondition(obs.ConditionTypeValid, obs.ConditionFalse, obs.ReasonValidationFailure, "invalid"),
+		}
+		summarize(ready, daemonSet(3, 3, 3))
+		Expect(condition(obs.ConditionTypeAvailable).Status).To(Equal(obs.ConditionTrue))
+		Expect(condition(obs.ConditionTypeDegraded).Status).To(Equal(obs.ConditionTrue))
+		Expect(condition(obs.ConditionTypeDegraded).Reason).To(Equal(obs.ReasonValidationFailure))
+	})
+
+	It("should be degraded when collector pods are unavailable once rolled out", func() {
+		summarize(ready, daemonSet(3, 3, 2))
+		Expect(condition(obs.ConditionTypeAvailable).Status).To(Equal(obs.ConditionTrue))
+		Expect(condition(obs.ConditionTypeDegraded).Status).To(Equal(obs.ConditionTrue))
+		Expect(condition(obs.ConditionTypeDegraded).Reason).To(Equal(obs.ReasonCollectorPodsUnavailable))
+	})
+
+	It("should be degraded with the reason of the failure and unavailable without a collector", func() {
+		failure := internalobs.NewCondition(obs.ConditionTypeReady, obs.ConditionFalse, obs.ReasonValidationFailure, "invalid")
+		summarize(failure, nil)
+		Expect(condition(obs.ConditionTypeAvailable).Status).To(Equal(obs.ConditionFalse))
+		Expect(condition(obs.ConditionTypeDegraded).Status).To(Equal(obs.ConditionTrue))
+		Expect(condition(obs.ConditionTypeDegraded).Reason).To(Equal(obs.ReasonValidationFailure))
+	})
+
+	It("should be unknown when the forwarder is unmanaged", func() {
+		unmanaged := internalobs.NewCondition(obs.ConditionTypeReady, obs.ConditionUnknown, obs.ReasonManagementStateUnmanaged, "")
+		summarize(unmanaged, daemonSet(3, 3, 3))
+		for _, conditionType := range []string{obs.ConditionTypeAvailable, obs.ConditionTypeProgressing, obs.ConditionTypeDegraded} {
+			Expect(condition(conditionType).Status).To(Equal(obs.ConditionUnknown))
+		}
+	})
+})
@@ -0,0 +1,28 @@
+package observability
+
+import (
+	"fmt"
+	"strings"
+
+	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+)
+
+// ApplyPermissiveValidation removes the invalid inputs, outputs, filters and pipelines of a forwarder whose spec
+// failed validation when its validation mode is Permissive.  It returns a message describing the pipelines which are
+// not forwarded, and false when the forwarder is in Strict mode or no valid pipeline remains
+func ApplyPermissiveValidation(forwarder *obsv1.ClusterLogForwarder) (string, bool) {
+	if forwarder.Spec.Validation == nil || forwarder.Spec.Validation.Mode != obsv1.ValidationModePermissive {
+		return "", false
+	}
+	spec, removed := internalobs.PruneInvalid(*forwarder)
+	if len(spec.Pipelines) == 0 {
+		return "", false
+	}
+	forwarder.Spec = spec
+	message := "invalid inputs, outputs and filters are not forwarded"
+	if len(removed) > 0 {
+		message = fmt.Sprintf("pipelines [%s] are not forwarded: %s", strings.Join(removed, ","), message)
+	}
+	return message, true
+}
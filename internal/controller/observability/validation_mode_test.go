@@ -0,0 +1,64 @@
+package observability_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("#ApplyPermissiveValidation", func() {
+
+	var forwarder *obs.ClusterLogForwarder
+
+	BeforeEach(func() {
+		forwarder = &obs.ClusterLogForwarder{
+			Spec: obs.ClusterLogForwarderSpec{
+				Inputs:  []obs.InputSpec{{Name: "application"}},
+				Outputs: []obs.OutputSpec{{Name: "es"}, {Name: "bad"}},
+				Pipelines: []obs.PipelineSpec{
+					{Name: "good", InputRefs: []string{"application"}, OutputRefs: []string{"es"}},
+					{Name: "broken", InputRefs: []string{"application"}, OutputRefs: []string{"bad"}},
+				},
+			},
+			Status: obs.ClusterLogForwarderStatus{
+				Inputs: []metav1.Condition{
+					internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, "application", true, "", ""),
+				},
+				Outputs: []metav1.Condition{
+					internalobs.NewConditionFromPrefix(obs.ConditionTypeValidOutputPrefix, "es", true, "", ""),
+					internalobs.NewConditionFromPrefix(obs.ConditionTypeValidOutputPrefix, "bad", false, "", ""),
+				},
+				Pipelines: []metav1.Condition{
+					internalobs.NewConditionFromPrefix(obs.ConditionTypeValidPipelinePrefix, "good", true, "", ""),
+					internalobs.NewConditionFromPrefix(obs.ConditionTypeValidPipelinePrefix, "broken", true, "", ""),
+				},
+			},
+		}
+	})
+
+	It("should reject an invalid spec in Strict mode", func() {
+		_, permissive := observability.ApplyPermissiveValidation(forwarder)
+		Expect(permissive).To(BeFalse())
+		Expect(forwarder.Spec.Pipelines).To(HaveLen(2))
+	})
+
+	It("should forward the valid pipelines in Permissive mode", func() {
+		forwarder.Spec.Validation = &obs.ValidationSpec{Mode: obs.ValidationModePermissive}
+		message, permissive := observability.ApplyPermissiveValidation(forwarder)
+		Expect(permissive).To(BeTrue())
+		Expect(message).To(Equal("pipelines [broken] are not forwarded: invalid inputs, outputs and filters are not forwarded"))
+		Expect(forwarder.Spec.Outputs).To(Equal([]obs.OutputSpec{{Name: "es"}}))
+		Expect(forwarder.Spec.Pipelines).To(HaveLen(1))
+		Expect(forwarder.Spec.Pipelines[0].Name).To(Equal("good"))
+	})
+
+	It("should reject an invalid spec in Permissive mode when no valid pipeline remains", func() {
+		forwarder.Spec.Validation = &obs.ValidationSpec{Mode: obs.ValidationModePermissive}
+		forwarder.Status.Outputs[0].Status = obs.ConditionFalse
+		_, permissive := observability.ApplyPermissiveValidation(forwarder)
+		Expect(permissive).To(BeFalse())
+	})
+})
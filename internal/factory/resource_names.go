@@ -8,6 +8,7 @@ import (
 type ForwarderResourceNames struct {
 	CommonName                       string
 	SecretMetrics                    string
+	MetricsCABundle                  string
 	ConfigMap                        string
 	MetadataReaderClusterRoleBinding string
 	CaTrustBundle                    string
@@ -33,6 +34,7 @@ func ResourceNames(clf obsv1.ClusterLogForwarder) *ForwarderResourceNames {
 	return &ForwarderResourceNames{
 		CommonName:                       resBaseName,
 		SecretMetrics:                    resBaseName + "-metrics",
+		MetricsCABundle:                  resBaseName + "-metrics-ca",
 		ConfigMap:                        resBaseName + "-config",
 		MetadataReaderClusterRoleBinding: fmt.Sprintf("cluster-logging-%s-%s-metadata-reader", clf.Namespace, resBaseName),
 		ForwarderName:                    clf.Name,
@@ -0,0 +1,37 @@
+package factory
+
+import (
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewStatefulSet stubs an instance of a statefulset whose pods each claim a persistent volume from the given templates
+func NewStatefulSet(namespace, name, serviceName, component, impl string, replicas int32, podSpec core.PodSpec, claims []core.PersistentVolumeClaim, visitors ...func(o runtime.Object)) *apps.StatefulSet {
+	selectors := runtime.Selectors(name, component, impl)
+
+	annotations := map[string]string{
+		"target.workload.openshift.io/management": `{"effect": "PreferredDuringScheduling"}`,
+	}
+
+	sts := runtime.NewStatefulSet(namespace, name, visitors...)
+	sts.Spec = apps.StatefulSetSpec{
+		Replicas:    utils.GetPtr(replicas),
+		ServiceName: serviceName,
+		Selector: &metav1.LabelSelector{
+			MatchLabels: selectors,
+		},
+		Template: core.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      sts.Labels,
+				Annotations: annotations,
+			},
+			Spec: podSpec,
+		},
+		VolumeClaimTemplates: claims,
+		PodManagementPolicy:  apps.ParallelPodManagement,
+	}
+	return sts
+}
@@ -0,0 +1,34 @@
+package factory
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+)
+
+var _ = Describe("#NewStatefulSet", func() {
+
+	var (
+		statefulSet  *apps.StatefulSet
+		expSelectors = runtime.Selectors("thename", "thecomponent", "thecomponent")
+		claims       = []core.PersistentVolumeClaim{{}}
+	)
+
+	BeforeEach(func() {
+		claims[0].Name = "theclaim"
+		statefulSet = NewStatefulSet("thenamespace", "thename", "theservice", "thecomponent", "thecomponent", 2, core.PodSpec{}, claims)
+	})
+
+	It("should only include kubernetes common labels in the selector", func() {
+		Expect(statefulSet.Spec.Selector.MatchLabels).To(Equal(expSelectors), "Exp. the selector to only include kubernetes common labels")
+	})
+
+	It("should claim a volume for each pod from the templates", func() {
+		Expect(statefulSet.Spec.ServiceName).To(Equal("theservice"))
+		Expect(*statefulSet.Spec.Replicas).To(BeEquivalentTo(2))
+		Expect(statefulSet.Spec.VolumeClaimTemplates).To(HaveLen(1))
+		Expect(statefulSet.Spec.VolumeClaimTemplates[0].Name).To(Equal("theclaim"))
+	})
+})
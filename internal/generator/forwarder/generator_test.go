@@ -0,0 +1,74 @@
+package forwarder
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/tls"
+)
+
+// scaleSpec returns a spec for a multi-tenant forwarder where each tenant has its own input, filter, output and pipeline
+func scaleSpec(tenants int) obs.ClusterLogForwarderSpec {
+	spec := obs.ClusterLogForwarderSpec{}
+	for i := 0; i < tenants; i++ {
+		name := fmt.Sprintf("tenant-%d", i)
+		spec.Inputs = append(spec.Inputs, obs.InputSpec{
+			Name: name,
+			Type: obs.InputTypeApplication,
+			Application: &obs.Application{
+				Includes: []obs.NamespaceContainerSpec{{Namespace: name}},
+			},
+		})
+		spec.Filters = append(spec.Filters, obs.FilterSpec{
+			Name:            name,
+			Type:            obs.FilterTypeOpenshiftLabels,
+			OpenShiftLabels: map[string]string{"tenant": name},
+		})
+		spec.Outputs = append(spec.Outputs, obs.OutputSpec{
+			Name: name,
+			Type: obs.OutputTypeHTTP,
+			HTTP: &obs.HTTP{
+				URLSpec: obs.URLSpec{URL: fmt.Sprintf("https://%s.example.com", name)},
+			},
+		})
+		spec.Pipelines = append(spec.Pipelines, obs.PipelineSpec{
+			Name:       name,
+			InputRefs:  []string{name},
+			FilterRefs: []string{name},
+			OutputRefs: []string{name},
+		})
+	}
+	return spec
+}
+
+func generateScaleConf(tenants int) (string, error) {
+	op := framework.Options{framework.ClusterTLSProfileSpec: tls.GetClusterTLSProfileSpec(nil)}
+	return New().GenerateConf(nil, scaleSpec(tenants), "openshift-logging", "my-forwarder", factory.ForwarderResourceNames{CommonName: "collector"}, op)
+}
+
+var _ = Describe("Generating config for a forwarder with many pipelines", func() {
+
+	const tenants = 300
+
+	It("should generate a single sink for each output and only the referenced filters for each pipeline", func() {
+		conf, err := generateScaleConf(tenants)
+		Expect(err).To(BeNil())
+		Expect(regexp.MustCompile(`(?m)^\[sinks\.output_tenant_\d+\]$`).FindAllString(conf, -1)).To(HaveLen(tenants))
+		Expect(regexp.MustCompile(`(?m)^\[transforms\.pipeline_tenant_0_.*\]$`).FindAllString(conf, -1)).To(HaveLen(3), "Exp. only the viaq, tenant-0 and dedot filters for the pipeline")
+		Expect(conf).To(ContainSubstring("[transforms.pipeline_tenant_299_tenant_299_1]"))
+	})
+})
+
+func BenchmarkGenerateConf(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := generateScaleConf(300); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
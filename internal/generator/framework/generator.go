@@ -5,9 +5,19 @@ import (
 	log "github.com/ViaQ/logerr/v2/log/static"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"text/template"
 )
 
+// maxTemplateCacheSize bounds the number of parsed templates which are cached
+const maxTemplateCacheSize = 1024
+
+var (
+	templateCache     sync.Map
+	templateCacheSize int64
+)
+
 // Element is a basic unit of configuration. It wraps a golang template along with the data type to hold the data template needs. A type implementing
 type Element interface {
 	Name() string
@@ -50,7 +60,47 @@ func (g Generator) generate(es []Element) (string, error) {
 	if len(es) == 0 {
 		return "", nil
 	}
-	t := template.New("generate")
+	b := &bytes.Buffer{}
+	for i, e := range es {
+		if e == nil || e == Nil {
+			continue
+		}
+		t, err := g.parse(e.Template())
+		if err != nil {
+			log.V(0).Error(err, "Error parsing template", "element", e, "name", e.Name(), "template", e.Template())
+			log.V(3).Error(err, "Error parsing template", "element", e)
+			panic(err)
+		}
+		err = t.ExecuteTemplate(b, e.Name(), e)
+		if err != nil {
+			log.V(0).Error(err, "Error in conf generation")
+			return "error in conf generation", err
+		}
+		if i < len(es)-1 {
+			b.Write([]byte("\n"))
+		}
+	}
+	return strings.TrimRight(b.String(), "\n"), nil
+}
+
+// parse returns the parsed template for the given text.  Element templates are static so parsed templates are
+// cached to avoid parsing the same template for every instance of an element when there are many inputs,
+// pipelines and outputs
+func (g Generator) parse(text string) (*template.Template, error) {
+	if t, found := templateCache.Load(text); found {
+		return t.(*template.Template), nil
+	}
+	t, err := template.New("generate").Funcs(g.funcs()).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	if atomic.AddInt64(&templateCacheSize, 1) <= maxTemplateCacheSize {
+		templateCache.Store(text, t)
+	}
+	return t, nil
+}
+
+func (g Generator) funcs() template.FuncMap {
 	f := template.FuncMap{
 		"compose": g.generate,
 		"compose_one": func(e Element) (string, error) {
@@ -77,29 +127,7 @@ func (g Generator) generate(es []Element) (string, error) {
 		},
 	}
 	f["optional"] = f["kv"]
-	t.Funcs(f)
-	b := &bytes.Buffer{}
-	for i, e := range es {
-		if e == nil || e == Nil {
-			continue
-		}
-		var err error
-		t, err = t.Parse(e.Template())
-		if err != nil {
-			log.V(0).Error(err, "Error parsing template", "element", e, "name", e.Name(), "template", e.Template())
-			log.V(3).Error(err, "Error parsing template", "element", e)
-			panic(err)
-		}
-		err = t.ExecuteTemplate(b, e.Name(), e)
-		if err != nil {
-			log.V(0).Error(err, "Error in conf generation")
-			return "error in conf generation", err
-		}
-		if i < len(es)-1 {
-			b.Write([]byte("\n"))
-		}
-	}
-	return strings.TrimRight(b.String(), "\n"), nil
+	return f
 }
 
 // MergeElements merges multiple arrays of Elements into a single array of Element
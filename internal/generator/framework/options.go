@@ -12,6 +12,18 @@ const (
 
 	URL                                 = "url"
 	OptionServiceAccountTokenSecretName = "serviceAccountTokenSecretName"
+	OptionWorkloadMetrics               = "workloadMetrics"
+	OptionConfigMaps                    = "configMaps"
+	OptionResumedOutputs                = "resumedOutputs"
+	OptionInfrastructureNamespaces      = "infrastructureNamespaces"
+	// OptionAggregatorAddress is the address of the aggregator to which the collectors forward the collected logs
+	OptionAggregatorAddress = "aggregatorAddress"
+	// OptionAggregator generates the config of the aggregator which receives the logs forwarded by the collectors
+	OptionAggregator = "aggregator"
+	// OptionAggregatorQueue queues the logs of the outputs of the aggregator on its persistent volume
+	OptionAggregatorQueue = "aggregatorQueue"
+	// OptionMeshTLSOrigination sends the logs to the outputs over plain connections which are secured by the mesh
+	OptionMeshTLSOrigination = "meshTLSOrigination"
 )
 
 // Options is a map of Options used to customize the config generation. E.g. Debugging, legacy config generation
@@ -4,6 +4,7 @@ package url
 import (
 	"fmt"
 	log "github.com/ViaQ/logerr/v2/log/static"
+	"net"
 	"net/url"
 	"strings"
 )
@@ -54,6 +55,26 @@ func PlainScheme(scheme string) string {
 	return scheme
 }
 
+// defaultPorts are the default ports of the secure schemes which do not require an explicit port
+var defaultPorts = map[string]string{
+	"https": "443",
+}
+
+// PlainURL returns the URL with the plain, non-TLS, version of its scheme.  The port of a secure URL is made explicit so
+// the plain URL addresses the same port as the secure URL.
+// Example: PlainURL("https://example.com/logs") == "http://example.com:443/logs"
+func PlainURL(urlString string) string {
+	u, err := Parse(urlString)
+	if err != nil || !IsTLSScheme(u.Scheme) {
+		return urlString
+	}
+	if port, found := defaultPorts[strings.ToLower(u.Scheme)]; found && u.Port() == "" {
+		u.Host = net.JoinHostPort(u.Hostname(), port)
+	}
+	u.Scheme = PlainScheme(u.Scheme)
+	return u.String()
+}
+
 // Stubs for net/url types/functions so it's not necessary to import it as well.
 
 type URL = url.URL
@@ -0,0 +1,154 @@
+package aggregator
+
+import (
+	"fmt"
+	"sort"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	// SinkID is the ID of the sink forwarding the collected logs to the aggregator
+	SinkID = "output_aggregator"
+	// SourceID is the ID of the source of the aggregator receiving the logs forwarded by the collectors
+	SourceID = "input_aggregator"
+	// RouteID is the ID of the transform of the aggregator routing the received logs by the input which collected them
+	RouteID = "input_aggregator_route"
+
+	// serviceCAFile is the CA of the service serving certificate of the aggregator
+	serviceCAFile = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+	inputField    = "._internal.aggregator_input"
+)
+
+// Address returns the address of the service of the aggregator of a forwarder
+func Address(namespace, aggregatorName string) string {
+	return fmt.Sprintf("%s.%s.svc:%d", aggregatorName, namespace, constants.AggregatorPort)
+}
+
+type Sink struct {
+	ID            string
+	Inputs        string
+	Address       string
+	TlsMinVersion string
+	CipherSuites  string
+}
+
+func (s Sink) Name() string {
+	return "aggregatorSinkTemplate"
+}
+
+func (s Sink) Template() string {
+	return `{{define "` + s.Name() + `" -}}
+[sinks.{{.ID}}]
+type = "vector"
+inputs = {{.Inputs}}
+address = "{{.Address}}"
+compression = true
+
+[sinks.{{.ID}}.tls]
+enabled = true
+ca_file = "` + serviceCAFile + `"
+min_tls_version = "{{.TlsMinVersion}}"
+ciphersuites = "{{.CipherSuites}}"
+{{end}}`
+}
+
+type Source struct {
+	ID            string
+	Address       string
+	TlsMinVersion string
+	CipherSuites  string
+}
+
+func (s Source) Name() string {
+	return "aggregatorSourceTemplate"
+}
+
+func (s Source) Template() string {
+	return `{{define "` + s.Name() + `" -}}
+[sources.{{.ID}}]
+type = "vector"
+address = "{{.Address}}"
+
+[sources.{{.ID}}.tls]
+enabled = true
+key_file = "/etc/collector/metrics/tls.key"
+crt_file = "/etc/collector/metrics/tls.crt"
+min_tls_version = "{{.TlsMinVersion}}"
+ciphersuites = "{{.CipherSuites}}"
+{{end}}`
+}
+
+// Forward returns the elements of the collectors which tag the logs of each input with the name of the input and
+// forward them to the aggregator at the given address
+func Forward(inputs map[string]helpers.InputComponent, address string, op framework.Options) []framework.Element {
+	names := sortedNames(inputs)
+	els := []framework.Element{}
+	ids := []string{}
+	for _, name := range names {
+		id := helpers.MakeInputID(name, "aggregator")
+		els = append(els, elements.Remap{
+			ComponentID: id,
+			Inputs:      helpers.MakeInputs(inputs[name].InputIDs()...),
+			VRL:         fmt.Sprintf("%s = %q", inputField, name),
+		})
+		ids = append(ids, id)
+	}
+	minTlsVersion, cipherSuites := framework.TLSProfileInfo(op, obs.OutputSpec{}, ",")
+	return append(els, Sink{
+		ID:            SinkID,
+		Inputs:        helpers.MakeInputs(ids...),
+		Address:       address,
+		TlsMinVersion: minTlsVersion,
+		CipherSuites:  cipherSuites,
+	})
+}
+
+// Receive returns the elements of the aggregator which receive the logs forwarded by the collectors and the
+// components of the given inputs which route the received logs by the input which collected them
+func Receive(inputNames []string, op framework.Options) ([]framework.Element, map[string]helpers.InputComponent) {
+	routes := map[string]string{}
+	components := map[string]helpers.InputComponent{}
+	for _, name := range inputNames {
+		route := helpers.FormatComponentID(name)
+		routes[route] = fmt.Sprintf("'%s == %q'", inputField, name)
+		components[name] = inputComponent{helpers.MakeRouteInputID(RouteID, route)}
+	}
+	minTlsVersion, cipherSuites := framework.TLSProfileInfo(op, obs.OutputSpec{}, ",")
+	return []framework.Element{
+		Source{
+			ID:            SourceID,
+			Address:       fmt.Sprintf("%s:%d", helpers.ListenOnAllLocalInterfacesAddress(), constants.AggregatorPort),
+			TlsMinVersion: minTlsVersion,
+			CipherSuites:  cipherSuites,
+		},
+		elements.Route{
+			ComponentID: RouteID,
+			Desc:        "Route the logs forwarded by the collectors by the input which collected them",
+			Inputs:      helpers.MakeInputs(SourceID),
+			Routes:      routes,
+		},
+	}, components
+}
+
+// inputComponent is the route of the logs of an input received by the aggregator
+type inputComponent struct {
+	id string
+}
+
+func (i inputComponent) InputIDs() []string {
+	return []string{i.id}
+}
+
+func sortedNames(inputs map[string]helpers.InputComponent) []string {
+	names := make([]string, 0, len(inputs))
+	for name := range inputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/openshift/cluster-logging-operator/internal/factory"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/aggregator"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/input"
@@ -14,6 +15,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/metrics"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/pipeline"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/source"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -62,30 +64,73 @@ func Conf(secrets map[string]*corev1.Secret, clfspec obs.ClusterLogForwarderSpec
 	// Init inputs, outputs, pipelines
 	inputMap := map[string]*input.Input{}
 	inputCompMap := map[string]helpers.InputComponent{}
-	for _, i := range clfspec.Inputs {
-		a := input.NewInput(i, secrets, namespace, resNames, op)
-		inputMap[i.Name] = a
-		inputCompMap[i.Name] = a
+	_, aggregate := op[framework.OptionAggregator]
+	aggregatorAddress, forward := utils.GetOption(op, framework.OptionAggregatorAddress, "")
+	sections := framework.Section{}
+	if aggregate {
+		// the aggregator receives the logs of the inputs collected and forwarded by the collectors
+		names := make([]string, 0, len(clfspec.Inputs))
+		for _, i := range clfspec.Inputs {
+			names = append(names, i.Name)
+		}
+		sections.Elements, inputCompMap = aggregator.Receive(names, op)
+	} else {
+		for _, i := range clfspec.Inputs {
+			a := input.NewInput(i, secrets, namespace, resNames, op)
+			inputMap[i.Name] = a
+			inputCompMap[i.Name] = a
+		}
+	}
+	for _, i := range sortAdapters(inputMap) {
+		sections.Elements = append(sections.Elements, i.Elements()...)
+	}
+	if forward {
+		// the pipelines and outputs are generated for the aggregator to which the collected logs are forwarded
+		sections.Elements = append(sections.Elements, aggregator.Forward(inputCompMap, aggregatorAddress, op)...)
+		clfspec.Pipelines = nil
+		clfspec.Outputs = nil
 	}
 
+	if _, found := op[framework.OptionMeshTLSOrigination]; found {
+		// the collector sends the logs over plain connections which are secured by the mesh
+		clfspec.Outputs = output.MeshSecured(clfspec.Outputs)
+	}
 	outputMap := map[string]*output.Output{}
+	sinkMap := map[string]*output.Output{}
+	duplicateOutputs := internalobs.Outputs(clfspec.Outputs).Duplicates()
+	// the persistent queue of the aggregator is shared by the sinks of the outputs
+	queue, queued := utils.GetOption(op, framework.OptionAggregatorQueue, obs.AggregatorPersistentQueueSpec{})
+	var queueSize int64
+	if aggregate && queued {
+		queueSize = output.QueueSize(queue.Size.Value(), len(clfspec.Outputs)-len(duplicateOutputs))
+	}
 	for _, spec := range clfspec.Outputs {
+		// identical outputs share a single sink and the connections to the destination
+		if _, found := duplicateOutputs[spec.Name]; found {
+			continue
+		}
 		o := output.NewOutput(spec, secrets, op)
+		o.QueueTo(queueSize, queue.Retention)
 		outputMap[spec.Name] = o
+		sinkMap[spec.Name] = o
+	}
+	for name, first := range duplicateOutputs {
+		sinkMap[name] = outputMap[first]
 	}
 
-	filters := filter.NewInternalFilterMap(internalobs.FilterMap(clfspec))
+	filters := filter.NewInternalFilterMap(internalobs.FilterMap(clfspec), op)
 	pipelineMap := map[string]*pipeline.Pipeline{}
+	duplicates := internalobs.Pipelines(clfspec.Pipelines).Duplicates()
 	for i, p := range clfspec.Pipelines {
-		a := pipeline.NewPipeline(i, p, inputCompMap, outputMap, filters, clfspec.Inputs)
+		// identical pipelines would only deliver the same records more than once
+		if _, found := duplicates[p.Name]; found {
+			continue
+		}
+		a := pipeline.NewPipeline(i, p, inputCompMap, sinkMap, filters, clfspec.Inputs)
 		pipelineMap[p.Name] = a
 	}
 
 	// generate sections, deferring input wiring to config generation
-	sections := framework.Section{}
-	for _, i := range sortAdapters(inputMap) {
-		sections.Elements = append(sections.Elements, i.Elements()...)
-	}
 	for _, p := range sortAdapters(pipelineMap) {
 		sections.Elements = append(sections.Elements, p.Elements()...)
 	}
@@ -93,6 +138,20 @@ func Conf(secrets map[string]*corev1.Secret, clfspec obs.ClusterLogForwarderSpec
 		sections.Elements = append(sections.Elements, o.Elements()...)
 	}
 
+	metricsInputs := []string{source.InternalMetricsSourceName}
+	for _, p := range sortAdapters(pipelineMap) {
+		metricsInputs = append(metricsInputs, p.MetricsIDs()...)
+	}
+	if !aggregate {
+		metricsInputs = append(metricsInputs, input.ThrottleMetricsIDs(clfspec.Inputs)...)
+	}
+	if _, found := op[framework.OptionWorkloadMetrics]; found && !aggregate {
+		if ids := input.ContainerSourceIDs(clfspec.Inputs); len(ids) > 0 {
+			sections.Elements = append(sections.Elements, metrics.WorkloadMetrics(ids)...)
+			metricsInputs = append(metricsInputs, metrics.WorkloadMetricsTransformName)
+		}
+	}
+
 	minTlsVersion, cipherSuites := framework.TLSProfileInfo(op, obs.OutputSpec{}, ",")
 	return []framework.Section{
 		{
@@ -105,7 +164,7 @@ func Conf(secrets map[string]*corev1.Secret, clfspec obs.ClusterLogForwarderSpec
 		sections,
 		{
 			Elements: []framework.Element{
-				metrics.AddNodeNameToMetric(metrics.AddNodenameToMetricTransformName, []string{source.InternalMetricsSourceName}),
+				metrics.AddNodeNameToMetric(metrics.AddNodenameToMetricTransformName, metricsInputs),
 				metrics.PrometheusOutput(metrics.PrometheusOutputSinkName, []string{metrics.AddNodenameToMetricTransformName}, minTlsVersion, cipherSuites),
 			},
 		},
@@ -112,6 +112,240 @@ var _ = Describe("Testing Complete Config Generation", func() {
 					},
 				},
 			}),
+		Entry("with duplicate pipelines merged into a single pipeline", "container.toml", nil,
+			obs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{
+					{
+						Name: "mytestapp",
+						Type: obs.InputTypeApplication,
+						Application: &obs.Application{
+							Includes: []obs.NamespaceContainerSpec{
+								{Namespace: "test-ns"},
+							},
+						},
+					},
+					{
+						Name: "myinfra",
+						Type: obs.InputTypeInfrastructure,
+						Infrastructure: &obs.Infrastructure{
+							Sources: []obs.InfrastructureSource{obs.InfrastructureSourceContainer},
+						},
+					},
+				},
+				Pipelines: []obs.PipelineSpec{
+					{
+						InputRefs: []string{
+							"myinfra",
+							"mytestapp",
+						},
+						OutputRefs: []string{outputName},
+						Name:       "mypipeline",
+						FilterRefs: []string{"my-labels"},
+					},
+					{
+						InputRefs: []string{
+							"mytestapp",
+							"myinfra",
+						},
+						OutputRefs: []string{outputName},
+						Name:       "anidenticalpipeline",
+						FilterRefs: []string{"my-labels"},
+					},
+				},
+				Outputs: []obs.OutputSpec{
+					kafkaOutput,
+				},
+				Filters: []obs.FilterSpec{
+					{
+						Name:            "my-labels",
+						Type:            obs.FilterTypeOpenshiftLabels,
+						OpenShiftLabels: map[string]string{"key1": "value1", "key2": "value2"},
+					},
+				},
+			}),
+		Entry("with identical outputs merged into a single sink", "container.toml", nil,
+			obs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{
+					{
+						Name: "mytestapp",
+						Type: obs.InputTypeApplication,
+						Application: &obs.Application{
+							Includes: []obs.NamespaceContainerSpec{
+								{Namespace: "test-ns"},
+							},
+						},
+					},
+					{
+						Name: "myinfra",
+						Type: obs.InputTypeInfrastructure,
+						Infrastructure: &obs.Infrastructure{
+							Sources: []obs.InfrastructureSource{obs.InfrastructureSourceContainer},
+						},
+					},
+				},
+				Pipelines: []obs.PipelineSpec{
+					{
+						InputRefs: []string{
+							"myinfra",
+							"mytestapp",
+						},
+						OutputRefs: []string{outputName, "an-identical-kafka-receiver"},
+						Name:       "mypipeline",
+						FilterRefs: []string{"my-labels"},
+					},
+				},
+				Outputs: []obs.OutputSpec{
+					kafkaOutput,
+					func() obs.OutputSpec {
+						o := *kafkaOutput.DeepCopy()
+						o.Name = "an-identical-kafka-receiver"
+						return o
+					}(),
+				},
+				Filters: []obs.FilterSpec{
+					{
+						Name:            "my-labels",
+						Type:            obs.FilterTypeOpenshiftLabels,
+						OpenShiftLabels: map[string]string{"key1": "value1", "key2": "value2"},
+					},
+				},
+			}),
+		Entry("with workload metrics enabled", "workload_metrics.toml", framework.Options{
+			framework.ClusterTLSProfileSpec: tls.GetClusterTLSProfileSpec(nil),
+			framework.OptionWorkloadMetrics: "true",
+		},
+			obs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{
+					{
+						Name: "mytestapp",
+						Type: obs.InputTypeApplication,
+						Application: &obs.Application{
+							Includes: []obs.NamespaceContainerSpec{
+								{Namespace: "test-ns"},
+							},
+						},
+					},
+					{
+						Name: "myinfra",
+						Type: obs.InputTypeInfrastructure,
+						Infrastructure: &obs.Infrastructure{
+							Sources: []obs.InfrastructureSource{obs.InfrastructureSourceContainer},
+						},
+					},
+				},
+				Pipelines: []obs.PipelineSpec{
+					{
+						InputRefs: []string{
+							"myinfra",
+							"mytestapp",
+						},
+						OutputRefs: []string{outputName},
+						Name:       "mypipeline",
+						FilterRefs: []string{"my-labels"},
+					},
+				},
+				Outputs: []obs.OutputSpec{
+					kafkaOutput,
+				},
+				Filters: []obs.FilterSpec{
+					{
+						Name:            "my-labels",
+						Type:            obs.FilterTypeOpenshiftLabels,
+						OpenShiftLabels: map[string]string{"key1": "value1", "key2": "value2"},
+					},
+				},
+			}),
+		Entry("with logs forwarded to an aggregator", "aggregator_forward.toml", framework.Options{
+			framework.ClusterTLSProfileSpec:   tls.GetClusterTLSProfileSpec(nil),
+			framework.OptionAggregatorAddress: "my-forwarder-aggregator.openshift-logging.svc:24240",
+		},
+			obs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{
+					{
+						Name: "mytestapp",
+						Type: obs.InputTypeApplication,
+						Application: &obs.Application{
+							Includes: []obs.NamespaceContainerSpec{
+								{Namespace: "test-ns"},
+							},
+						},
+					},
+					{
+						Name: "myinfra",
+						Type: obs.InputTypeInfrastructure,
+						Infrastructure: &obs.Infrastructure{
+							Sources: []obs.InfrastructureSource{obs.InfrastructureSourceContainer},
+						},
+					},
+				},
+				Pipelines: []obs.PipelineSpec{
+					{
+						InputRefs: []string{
+							"myinfra",
+							"mytestapp",
+						},
+						OutputRefs: []string{outputName},
+						Name:       "mypipeline",
+						FilterRefs: []string{"my-labels"},
+					},
+				},
+				Outputs: []obs.OutputSpec{
+					kafkaOutput,
+				},
+				Filters: []obs.FilterSpec{
+					{
+						Name:            "my-labels",
+						Type:            obs.FilterTypeOpenshiftLabels,
+						OpenShiftLabels: map[string]string{"key1": "value1", "key2": "value2"},
+					},
+				},
+			}),
+		Entry("as the aggregator of the logs forwarded by the collectors", "aggregator.toml", framework.Options{
+			framework.ClusterTLSProfileSpec: tls.GetClusterTLSProfileSpec(nil),
+			framework.OptionAggregator:      "true",
+			framework.OptionWorkloadMetrics: "true",
+		},
+			obs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{
+					{
+						Name: "mytestapp",
+						Type: obs.InputTypeApplication,
+						Application: &obs.Application{
+							Includes: []obs.NamespaceContainerSpec{
+								{Namespace: "test-ns"},
+							},
+						},
+					},
+					{
+						Name: "myinfra",
+						Type: obs.InputTypeInfrastructure,
+						Infrastructure: &obs.Infrastructure{
+							Sources: []obs.InfrastructureSource{obs.InfrastructureSourceContainer},
+						},
+					},
+				},
+				Pipelines: []obs.PipelineSpec{
+					{
+						InputRefs: []string{
+							"myinfra",
+							"mytestapp",
+						},
+						OutputRefs: []string{outputName},
+						Name:       "mypipeline",
+						FilterRefs: []string{"my-labels"},
+					},
+				},
+				Outputs: []obs.OutputSpec{
+					kafkaOutput,
+				},
+				Filters: []obs.FilterSpec{
+					{
+						Name:            "my-labels",
+						Type:            obs.FilterTypeOpenshiftLabels,
+						OpenShiftLabels: map[string]string{"key1": "value1", "key2": "value2"},
+					},
+				},
+			}),
 		Entry("with complex spec", "complex.toml", nil,
 			obs.ClusterLogForwarderSpec{
 				Inputs: []obs.InputSpec{
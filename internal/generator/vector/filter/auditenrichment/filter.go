@@ -0,0 +1,50 @@
+package auditenrichment
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+// VRL flattens the user of API server audit events into the actor of the record.
+// Bound service account tokens identify the pod of the actor, and the workload is derived from
+// the name of the pod without the suffixes generated by replicasets, statefulsets and daemonsets.
+var VRL = fmt.Sprintf(`if .log_type == %q && (.log_source == %q || .log_source == %q) {
+  _user = .impersonatedUser || .user
+  .actor.username = _user.username
+  .actor.uid = _user.uid
+  .actor.groups = _user.groups
+  if .impersonatedUser != null { .actor.impersonated_by = .user.username }
+  for_each(object(_user.extra) ?? {}) -> |key, value| {
+    _key = "extra_" + replace(key, r'[^a-zA-Z0-9_]', "_")
+    .actor = set!(.actor, [_key], join(value, ",") ?? value)
+  }
+  _sa = parse_regex(string(_user.username) ?? "", r'^system:serviceaccount:(?P<namespace>[^:]+):(?P<name>[^:]+)$') ?? null
+  if _sa != null {
+    .actor.serviceaccount_namespace = _sa.namespace
+    .actor.serviceaccount_name = _sa.name
+    _pod = _user.extra."authentication.kubernetes.io/pod-name"[0]
+    if is_string(_pod) {
+      .actor.pod_name = _pod
+      .actor.workload = replace(string!(_pod), r'(-[a-z0-9]{6,10})?-[a-z0-9]{5}$|-[0-9]+$', "")
+    }
+  }
+  _agent = parse_regex(string(.userAgent) ?? "", r'^(?P<name>[^/ ]+)(/(?P<version>[^ ]+))?') ?? null
+  if _agent != null {
+    .actor.user_agent = .userAgent
+    .actor.user_agent_name = _agent.name
+    .actor.user_agent_version = _agent.version
+  }
+  .actor = compact(.actor)
+}`, obs.InputTypeAudit, obs.AuditSourceKube, obs.AuditSourceOpenShift)
+
+type Filter struct{}
+
+// NewFilter returns an audit enrichment filter
+func NewFilter() *Filter {
+	return &Filter{}
+}
+
+func (f *Filter) VRL() (string, error) {
+	return VRL, nil
+}
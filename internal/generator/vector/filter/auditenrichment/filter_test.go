@@ -0,0 +1,48 @@
+package auditenrichment
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("audit enrichment filter", func() {
+
+	Context("#VRL", func() {
+		It("should generate VRL which flattens the user of API server audit events into the actor", func() {
+			vrl, err := NewFilter().VRL()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vrl).To(matchers.EqualTrimLines(`
+if .log_type == "audit" && (.log_source == "kubeAPI" || .log_source == "openshiftAPI") {
+  _user = .impersonatedUser || .user
+  .actor.username = _user.username
+  .actor.uid = _user.uid
+  .actor.groups = _user.groups
+  if .impersonatedUser != null { .actor.impersonated_by = .user.username }
+  for_each(object(_user.extra) ?? {}) -> |key, value| {
+    _key = "extra_" + replace(key, r'[^a-zA-Z0-9_]', "_")
+    .actor = set!(.actor, [_key], join(value, ",") ?? value)
+  }
+  _sa = parse_regex(string(_user.username) ?? "", r'^system:serviceaccount:(?P<namespace>[^:]+):(?P<name>[^:]+)$') ?? null
+  if _sa != null {
+    .actor.serviceaccount_namespace = _sa.namespace
+    .actor.serviceaccount_name = _sa.name
+    _pod = _user.extra."authentication.kubernetes.io/pod-name"[0]
+    if is_string(_pod) {
+      .actor.pod_name = _pod
+      .actor.workload = replace(string!(_pod), r'(-[a-z0-9]{6,10})?-[a-z0-9]{5}$|-[0-9]+$', "")
+    }
+  }
+  _agent = parse_regex(string(.userAgent) ?? "", r'^(?P<name>[^/ ]+)(/(?P<version>[^ ]+))?') ?? null
+  if _agent != null {
+    .actor.user_agent = .userAgent
+    .actor.user_agent_name = _agent.name
+    .actor.user_agent_version = _agent.version
+  }
+  .actor = compact(.actor)
+}
+`))
+		})
+	})
+
+})
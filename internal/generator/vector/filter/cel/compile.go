@@ -0,0 +1,553 @@
+package cel
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// RecordVariable is the name of the variable which references the log record in an expression
+const RecordVariable = "record"
+
+var unquotedSegment = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+// kind is the type of a compiled expression as far as it is known when the expression is compiled.  The fields of
+// the record are of any kind since their type is only known when a record is evaluated
+type kind int
+
+const (
+	kindAny kind = iota
+	kindBool
+	kindString
+	kindNumber
+	kindList
+	kindNull
+)
+
+// node is a compiled expression.  Guards are the conditions which must hold for the VRL of the node to be evaluated
+// without an error (e.g. the field of the record is a string).  They are folded into the first boolean expression
+// which uses the node so that a record whose fields are not of the expected type does not match
+type node struct {
+	vrl    string
+	kind   kind
+	guards []string
+	// path is set when the node references a field of the record
+	path bool
+	// literal is the unquoted value of a string literal
+	literal *string
+}
+
+// Compile translates a CEL expression over the log record to a VRL condition.  A subset of CEL is supported:
+//
+//   - fields of the record (e.g. `record.kubernetes.namespace_name`, `record.kubernetes.labels["app"]`)
+//   - string, number, boolean and null literals, and lists of literals
+//   - the operators `&&`, `||`, `!`, `==`, `!=`, `<`, `<=`, `>`, `>=` and `in`
+//   - the macro `has()` and the functions `size()`, `startsWith()`, `endsWith()`, `contains()` and `matches()`
+//
+// A record whose fields are missing or not of the type expected by an operator or function does not match the
+// operator or function instead of failing the evaluation
+func Compile(expression string) (string, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return "", err
+	}
+	p := &parser{tokens: tokens}
+	n, err := p.parseOr()
+	if err != nil {
+		return "", err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return "", fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+	}
+	n, err = asBool(n)
+	if err != nil {
+		return "", err
+	}
+	return n.vrl, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value string
+	pos   int
+}
+
+var operators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")", "[", "]", ",", ".", "?", ":", "+", "-", "*", "/", "%"}
+
+func tokenize(expression string) ([]token, error) {
+	var tokens []token
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '_' || unicode.IsLetter(r):
+			start := i
+			for i < len(runes) && (runes[i] == '_' || unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[start:i]), pos: start})
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == 'e' || runes[i] == 'E' ||
+				((runes[i] == '-' || runes[i] == '+') && (runes[i-1] == 'e' || runes[i-1] == 'E'))) {
+				i++
+			}
+			text := string(runes[start:i])
+			if _, err := strconv.ParseFloat(text, 64); err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", text, start)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: text, value: text, pos: start})
+		case r == '"' || r == '\'':
+			start := i
+			value, next, err := unquote(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			i = next
+			tokens = append(tokens, token{kind: tokenString, text: string(runes[start:i]), value: value, pos: start})
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(string(runes[i:]), op) {
+					tokens = append(tokens, token{kind: tokenOperator, text: op, pos: i})
+					i += len([]rune(op))
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at position %d", r, i)
+			}
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, text: "end of expression", pos: len(runes)}), nil
+}
+
+// unquote returns the value of the string literal which starts at the given position and the position following it
+func unquote(runes []rune, start int) (string, int, error) {
+	quote := runes[start]
+	value := strings.Builder{}
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case quote:
+			return value.String(), i + 1, nil
+		case '\\':
+			i++
+			if i == len(runes) {
+				break
+			}
+			switch runes[i] {
+			case 'n':
+				value.WriteRune('\n')
+			case 't':
+				value.WriteRune('\t')
+			case 'r':
+				value.WriteRune('\r')
+			case '\\', '"', '\'':
+				value.WriteRune(runes[i])
+			default:
+				return "", 0, fmt.Errorf("unsupported escape sequence \\%c at position %d", runes[i], i-1)
+			}
+		default:
+			value.WriteRune(runes[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string at position %d", start)
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) accept(op string) bool {
+	if t := p.peek(); t.kind == tokenOperator && t.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(op string) error {
+	if !p.accept(op) {
+		t := p.peek()
+		return fmt.Errorf("expected %q but found %q at position %d", op, t.text, t.pos)
+	}
+	return nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	return p.parseLogical("||", p.parseAnd)
+}
+
+func (p *parser) parseAnd() (node, error) {
+	return p.parseLogical("&&", p.parseRelation)
+}
+
+func (p *parser) parseLogical(op string, operand func() (node, error)) (node, error) {
+	left, err := operand()
+	if err != nil {
+		return node{}, err
+	}
+	if t := p.peek(); t.kind != tokenOperator || t.text != op {
+		return left, nil
+	}
+	if left, err = asBool(left); err != nil {
+		return node{}, err
+	}
+	operands := []string{left.vrl}
+	for p.accept(op) {
+		right, err := operand()
+		if err != nil {
+			return node{}, err
+		}
+		if right, err = asBool(right); err != nil {
+			return node{}, err
+		}
+		operands = append(operands, right.vrl)
+	}
+	return node{vrl: "(" + strings.Join(operands, " "+op+" ") + ")", kind: kindBool}, nil
+}
+
+func (p *parser) parseRelation() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return node{}, err
+	}
+	t := p.peek()
+	switch {
+	case t.kind == tokenIdent && t.text == "in":
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return node{}, err
+		}
+		return in(left, right)
+	case t.kind == tokenOperator && (t.text == "==" || t.text == "!="):
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return node{}, err
+		}
+		return boolean(fmt.Sprintf("(%s %s %s)", left.vrl, t.text, right.vrl), left, right), nil
+	case t.kind == tokenOperator && (t.text == "<" || t.text == "<=" || t.text == ">" || t.text == ">="):
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return node{}, err
+		}
+		if left, err = asNumber(left); err != nil {
+			return node{}, err
+		}
+		if right, err = asNumber(right); err != nil {
+			return node{}, err
+		}
+		return boolean(fmt.Sprintf("(%s %s %s)", left.vrl, t.text, right.vrl), left, right), nil
+	case t.kind == tokenOperator && (t.text == "?" || t.text == "+" || t.text == "-" || t.text == "*" || t.text == "/" || t.text == "%"):
+		return node{}, fmt.Errorf("operator %q at position %d is not supported", t.text, t.pos)
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.accept("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return node{}, err
+		}
+		if operand, err = asBool(operand); err != nil {
+			return node{}, err
+		}
+		return node{vrl: "!" + operand.vrl, kind: kindBool}, nil
+	}
+	if p.accept("-") {
+		t := p.next()
+		if t.kind != tokenNumber {
+			return node{}, fmt.Errorf("operator \"-\" at position %d is only supported for numbers", t.pos)
+		}
+		return node{vrl: "-" + t.value, kind: kindNumber}, nil
+	}
+	return p.parseMember()
+}
+
+func (p *parser) parseMember() (node, error) {
+	n, err := p.parsePrimary()
+	if err != nil {
+		return node{}, err
+	}
+	for {
+		switch {
+		case p.accept("."):
+			t := p.next()
+			if t.kind != tokenIdent {
+				return node{}, fmt.Errorf("expected a field or function name at position %d", t.pos)
+			}
+			if p.accept("(") {
+				args, err := p.parseArgs(")")
+				if err != nil {
+					return node{}, err
+				}
+				if n, err = method(t, n, args); err != nil {
+					return node{}, err
+				}
+				continue
+			}
+			if !n.path {
+				return node{}, fmt.Errorf("field %q at position %d must be selected from the record", t.text, t.pos)
+			}
+			n = field(n, t.text)
+		case p.accept("["):
+			t := p.next()
+			if !n.path || (t.kind != tokenString && t.kind != tokenNumber) {
+				return node{}, fmt.Errorf("only fields of the record may be indexed by a string or number literal at position %d", t.pos)
+			}
+			if err := p.expect("]"); err != nil {
+				return node{}, err
+			}
+			if t.kind == tokenNumber {
+				index, err := strconv.Atoi(t.value)
+				if err != nil {
+					return node{}, fmt.Errorf("invalid index %q at position %d", t.value, t.pos)
+				}
+				n = node{vrl: fmt.Sprintf("%s[%d]", n.vrl, index), path: true}
+			} else {
+				n = field(n, t.value)
+			}
+		default:
+			return n, nil
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenString:
+		value := t.value
+		return node{vrl: quote(value), kind: kindString, literal: &value}, nil
+	case tokenNumber:
+		return node{vrl: t.value, kind: kindNumber}, nil
+	case tokenIdent:
+		switch t.text {
+		case RecordVariable:
+			return node{vrl: ".", path: true}, nil
+		case "true", "false":
+			return node{vrl: t.text, kind: kindBool}, nil
+		case "null":
+			return node{vrl: "null", kind: kindNull}, nil
+		}
+		if p.accept("(") {
+			args, err := p.parseArgs(")")
+			if err != nil {
+				return node{}, err
+			}
+			return function(t, args)
+		}
+		return node{}, fmt.Errorf("undeclared reference %q at position %d, fields must be selected from %q", t.text, t.pos, RecordVariable)
+	case tokenOperator:
+		switch t.text {
+		case "(":
+			n, err := p.parseOr()
+			if err != nil {
+				return node{}, err
+			}
+			return n, p.expect(")")
+		case "[":
+			items, err := p.parseArgs("]")
+			if err != nil {
+				return node{}, err
+			}
+			values := []string{}
+			for _, item := range items {
+				if item.kind == kindAny || item.kind == kindList || len(item.guards) > 0 {
+					return node{}, fmt.Errorf("lists at position %d may only contain literals", t.pos)
+				}
+				values = append(values, item.vrl)
+			}
+			return node{vrl: "[" + strings.Join(values, ", ") + "]", kind: kindList}, nil
+		}
+	}
+	return node{}, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+}
+
+func (p *parser) parseArgs(closing string) ([]node, error) {
+	var args []node
+	if p.accept(closing) {
+		return args, nil
+	}
+	for {
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.accept(closing) {
+			return args, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// function translates a global function call
+func function(name token, args []node) (node, error) {
+	switch name.text {
+	case "has":
+		if len(args) != 1 || !args[0].path || args[0].vrl == "." {
+			return node{}, fmt.Errorf("has() at position %d requires a field of the record", name.pos)
+		}
+		return node{vrl: fmt.Sprintf("exists(%s)", args[0].vrl), kind: kindBool}, nil
+	case "size":
+		if len(args) != 1 {
+			return node{}, fmt.Errorf("size() at position %d requires one argument", name.pos)
+		}
+		return size(name, args[0])
+	}
+	return node{}, fmt.Errorf("function %q at position %d is not supported", name.text, name.pos)
+}
+
+// method translates a function called on a receiver
+func method(name token, receiver node, args []node) (node, error) {
+	switch name.text {
+	case "size":
+		if len(args) != 0 {
+			return node{}, fmt.Errorf("size() at position %d does not take arguments", name.pos)
+		}
+		return size(name, receiver)
+	case "startsWith", "endsWith", "contains", "matches":
+		if len(args) != 1 {
+			return node{}, fmt.Errorf("%s() at position %d requires one argument", name.text, name.pos)
+		}
+		s, err := asString(receiver)
+		if err != nil {
+			return node{}, err
+		}
+		if name.text == "matches" {
+			if args[0].literal == nil {
+				return node{}, fmt.Errorf("matches() at position %d requires a string literal", name.pos)
+			}
+			if _, err := regexp.Compile(*args[0].literal); err != nil {
+				return node{}, fmt.Errorf("matches() at position %d has an invalid regular expression: %v", name.pos, err)
+			}
+			return boolean(fmt.Sprintf("match(%s, r'%s')", s.vrl, strings.ReplaceAll(*args[0].literal, "'", `\'`)), s), nil
+		}
+		arg, err := asString(args[0])
+		if err != nil {
+			return node{}, err
+		}
+		functions := map[string]string{"startsWith": "starts_with", "endsWith": "ends_with", "contains": "contains"}
+		return boolean(fmt.Sprintf("%s(%s, %s)", functions[name.text], s.vrl, arg.vrl), s, arg), nil
+	}
+	return node{}, fmt.Errorf("function %q at position %d is not supported", name.text, name.pos)
+}
+
+func size(name token, n node) (node, error) {
+	switch n.kind {
+	case kindString, kindList:
+		return node{vrl: fmt.Sprintf("length(%s)", n.vrl), kind: kindNumber, guards: n.guards}, nil
+	case kindAny:
+		guard := fmt.Sprintf("(is_string(%[1]s) || is_array(%[1]s) || is_object(%[1]s))", n.vrl)
+		return node{vrl: fmt.Sprintf("length!(%s)", n.vrl), kind: kindNumber, guards: append(append([]string{}, n.guards...), guard)}, nil
+	}
+	return node{}, fmt.Errorf("size() at position %d requires a string, list or field of the record", name.pos)
+}
+
+func in(item, list node) (node, error) {
+	switch list.kind {
+	case kindList:
+		return boolean(fmt.Sprintf("includes(%s, %s)", list.vrl, item.vrl), item, list), nil
+	case kindAny:
+		guarded := node{vrl: fmt.Sprintf("array!(%s)", list.vrl), guards: append(append([]string{}, list.guards...), fmt.Sprintf("is_array(%s)", list.vrl))}
+		return boolean(fmt.Sprintf("includes(%s, %s)", guarded.vrl, item.vrl), item, guarded), nil
+	}
+	return node{}, fmt.Errorf("operator \"in\" requires a list or field of the record")
+}
+
+// boolean returns a boolean expression whose evaluation is guarded by the guards of its operands
+func boolean(vrl string, operands ...node) node {
+	guards := []string{}
+	for _, o := range operands {
+		guards = append(guards, o.guards...)
+	}
+	if len(guards) == 0 {
+		return node{vrl: vrl, kind: kindBool}
+	}
+	return node{vrl: "(" + strings.Join(append(guards, vrl), " && ") + ")", kind: kindBool}
+}
+
+func asBool(n node) (node, error) {
+	switch n.kind {
+	case kindBool:
+		return n, nil
+	case kindAny:
+		return boolean(fmt.Sprintf("(%s == true)", n.vrl), n), nil
+	}
+	return node{}, fmt.Errorf("expression %s must be a boolean", n.vrl)
+}
+
+func asString(n node) (node, error) {
+	switch n.kind {
+	case kindString:
+		return n, nil
+	case kindAny:
+		return node{vrl: fmt.Sprintf("string!(%s)", n.vrl), kind: kindString, guards: append(append([]string{}, n.guards...), fmt.Sprintf("is_string(%s)", n.vrl))}, nil
+	}
+	return node{}, fmt.Errorf("expression %s must be a string", n.vrl)
+}
+
+func asNumber(n node) (node, error) {
+	switch n.kind {
+	case kindNumber:
+		return n, nil
+	case kindAny:
+		guard := fmt.Sprintf("(is_integer(%[1]s) || is_float(%[1]s))", n.vrl)
+		return node{vrl: fmt.Sprintf("to_float!(%s)", n.vrl), kind: kindNumber, guards: append(append([]string{}, n.guards...), guard)}, nil
+	}
+	return node{}, fmt.Errorf("expression %s must be a number", n.vrl)
+}
+
+// field returns the VRL path of a field selected from a path
+func field(parent node, name string) node {
+	segment := name
+	if !unquotedSegment.MatchString(name) {
+		segment = quote(name)
+	}
+	if parent.vrl == "." {
+		return node{vrl: "." + segment, path: true}
+	}
+	return node{vrl: parent.vrl + "." + segment, path: true}
+}
+
+// quote returns a VRL string literal
+func quote(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`)
+	return `"` + replacer.Replace(value) + `"`
+}
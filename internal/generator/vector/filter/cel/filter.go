@@ -0,0 +1,26 @@
+package cel
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+type Filter struct {
+	spec obs.CELFilterSpec
+}
+
+// NewFilter returns a cel filter
+func NewFilter(spec *obs.CELFilterSpec) *Filter {
+	return &Filter{*spec}
+}
+
+func (f *Filter) VRL() (string, error) {
+	condition, err := Compile(f.spec.Expression)
+	if err != nil {
+		return "", err
+	}
+	// Vector's transform.Filter keeps logs that match the condition
+	if f.spec.Action == obs.CELFilterActionDrop {
+		return "!" + condition, nil
+	}
+	return condition, nil
+}
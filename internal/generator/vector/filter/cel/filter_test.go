@@ -0,0 +1,51 @@
+package cel
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("cel filter", func() {
+
+	Context("#VRL", func() {
+		It("should keep the records matching the expression", func() {
+			spec := &obs.CELFilterSpec{Expression: `record.kubernetes.namespace_name.startsWith("team-a") && record.level == "error"`}
+			Expect(NewFilter(spec).VRL()).To(Equal(`((is_string(.kubernetes.namespace_name) && starts_with(string!(.kubernetes.namespace_name), "team-a")) && (.level == "error"))`))
+		})
+		It("should drop the records matching the expression", func() {
+			spec := &obs.CELFilterSpec{Expression: `record.level == "debug"`, Action: obs.CELFilterActionDrop}
+			Expect(NewFilter(spec).VRL()).To(Equal(`!(.level == "debug")`))
+		})
+	})
+
+	DescribeTable("#Compile", func(expression, exp string) {
+		Expect(Compile(expression)).To(Equal(exp))
+	},
+		Entry("with a quoted field", `record.kubernetes.labels["app.kubernetes.io/name"] == 'web'`, `(.kubernetes.labels."app.kubernetes.io/name" == "web")`),
+		Entry("with a list", `record.level in ["error", "critical"]`, `includes(["error", "critical"], .level)`),
+		Entry("with a field of the record as list", `"admin" in record.groups`, `(is_array(.groups) && includes(array!(.groups), "admin"))`),
+		Entry("with a numeric comparison", `record.status >= 500`, `((is_integer(.status) || is_float(.status)) && (to_float!(.status) >= 500))`),
+		Entry("with has and negation", `has(record.trace_id) || !(record.message.contains("health"))`,
+			`(exists(.trace_id) || !(is_string(.message) && contains(string!(.message), "health")))`),
+		Entry("with matches", `record.message.matches("^GET /api/.*'x'")`, `(is_string(.message) && match(string!(.message), r'^GET /api/.*\'x\''))`),
+		Entry("with size", `size(record.message) > 1000`,
+			`((is_string(.message) || is_array(.message) || is_object(.message)) && (length!(.message) > 1000))`),
+		Entry("with a boolean field", `record.audit`, `(.audit == true)`),
+	)
+
+	DescribeTable("#Compile invalid expressions", func(expression, exp string) {
+		_, err := Compile(expression)
+		Expect(err).To(MatchError(MatchRegexp(exp)))
+	},
+		Entry("with an undeclared reference", `level == "error"`, `undeclared reference "level"`),
+		Entry("with an unsupported function", `record.message.lowerAscii() == "x"`, `function "lowerAscii" .* is not supported`),
+		Entry("with an unsupported operator", `record.a + 1 == 2`, `operator "\+" .* is not supported`),
+		Entry("with a non boolean result", `"error"`, `must be a boolean`),
+		Entry("with an ordering of strings", `record.level > "error"`, `must be a number`),
+		Entry("with an unterminated string", `record.level == "error`, `unterminated string`),
+		Entry("with an invalid regular expression", `record.message.matches("(")`, `invalid regular expression`),
+		Entry("with a trailing token", `record.level == "error" )`, `unexpected "\)"`),
+	)
+})
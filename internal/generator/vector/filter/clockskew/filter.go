@@ -0,0 +1,93 @@
+package clockskew
+
+import (
+	"fmt"
+	"time"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	// defaultMaxPast is the maximum age, in seconds, of the timestamp of a record when not specified
+	defaultMaxPast = int64(24 * time.Hour / time.Second)
+	// defaultMaxFuture is the maximum time, in seconds, the timestamp of a record may be ahead when not specified
+	defaultMaxFuture = int64(5 * time.Minute / time.Second)
+)
+
+// MetricsID returns the ID of the component generating the metrics of the clock skew filter with the given ID
+func MetricsID(id string) string {
+	return id + "_metrics"
+}
+
+// NewTransform returns a factory of the transform which detects records with a skewed timestamp
+func NewTransform(spec *obs.ClockSkewFilterSpec) func(id string, inputs ...string) framework.Element {
+	if spec == nil {
+		spec = &obs.ClockSkewFilterSpec{}
+	}
+	return func(id string, inputs ...string) framework.Element {
+		return Transform{
+			Remap: elements.Remap{
+				ComponentID: id,
+				Inputs:      helpers.MakeInputs(inputs...),
+				VRL:         VRL(*spec),
+			},
+			MetricsID: MetricsID(id),
+		}
+	}
+}
+
+// VRL returns the VRL which annotates or corrects records with a skewed timestamp
+func VRL(spec obs.ClockSkewFilterSpec) string {
+	maxPast, maxFuture := defaultMaxPast, defaultMaxFuture
+	if spec.MaxPast != nil {
+		maxPast = int64(*spec.MaxPast)
+	}
+	if spec.MaxFuture != nil {
+		maxFuture = int64(*spec.MaxFuture)
+	}
+	correct := ""
+	if spec.Action == obs.ClockSkewActionCorrect {
+		correct = `
+    .original_timestamp = ."@timestamp"
+    ."@timestamp" = _now`
+	}
+	return fmt.Sprintf(`_ts = ."@timestamp"
+if is_string(_ts) { _ts = parse_timestamp(string!(_ts), "%%+") ?? null }
+if is_timestamp(_ts) {
+  _now = now()
+  _skew = to_unix_timestamp(_now) - to_unix_timestamp(timestamp!(_ts))
+  if _skew > %d || _skew < -%d {
+    .clock_skew_seconds = _skew%s
+  }
+}`, maxPast, maxFuture, correct)
+}
+
+// Transform is the remap transform of the filter and the log_to_metric transform counting the skewed records
+type Transform struct {
+	Remap     elements.Remap
+	MetricsID string
+}
+
+func (t Transform) Name() string {
+	return "clockSkewTemplate"
+}
+
+func (t Transform) Template() string {
+	return t.Remap.Template() + `{{define "` + t.Name() + `" -}}
+{{template "remapTemplate" .Remap}}
+[transforms.{{.MetricsID}}]
+type = "log_to_metric"
+inputs = ["{{.Remap.ComponentID}}"]
+
+[[transforms.{{.MetricsID}}.metrics]]
+type = "counter"
+field = "clock_skew_seconds"
+name = "collector_clock_skewed_records_total"
+
+[transforms.{{.MetricsID}}.metrics.tags]
+log_type = "{{"{{log_type}}"}}"
+{{end}}`
+}
@@ -0,0 +1,70 @@
+package clockskew
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("clock skew filter", func() {
+
+	Context("#VRL", func() {
+		It("should generate VRL which annotates records outside of the default bounds", func() {
+			Expect(VRL(obs.ClockSkewFilterSpec{})).To(matchers.EqualTrimLines(`
+_ts = ."@timestamp"
+if is_string(_ts) { _ts = parse_timestamp(string!(_ts), "%+") ?? null }
+if is_timestamp(_ts) {
+  _now = now()
+  _skew = to_unix_timestamp(_now) - to_unix_timestamp(timestamp!(_ts))
+  if _skew > 86400 || _skew < -300 {
+    .clock_skew_seconds = _skew
+  }
+}
+`))
+		})
+		It("should generate VRL which corrects the timestamp of records outside of the configured bounds", func() {
+			maxPast, maxFuture := time.Duration(3600), time.Duration(60)
+			vrl := VRL(obs.ClockSkewFilterSpec{MaxPast: &maxPast, MaxFuture: &maxFuture, Action: obs.ClockSkewActionCorrect})
+			Expect(vrl).To(ContainSubstring(`if _skew > 3600 || _skew < -60 {`))
+			Expect(vrl).To(ContainSubstring(`.original_timestamp = ."@timestamp"`))
+			Expect(vrl).To(ContainSubstring(`."@timestamp" = _now`))
+		})
+	})
+
+	Context("#NewTransform", func() {
+		It("should generate a remap and a log_to_metric transform counting the skewed records", func() {
+			Expect(`
+[transforms.pipeline_my_skew_1]
+type = "remap"
+inputs = ["pipeline_my_viaq_0"]
+source = '''
+  _ts = ."@timestamp"
+  if is_string(_ts) { _ts = parse_timestamp(string!(_ts), "%+") ?? null }
+  if is_timestamp(_ts) {
+    _now = now()
+    _skew = to_unix_timestamp(_now) - to_unix_timestamp(timestamp!(_ts))
+    if _skew > 86400 || _skew < -300 {
+      .clock_skew_seconds = _skew
+    }
+  }
+'''
+
+[transforms.pipeline_my_skew_1_metrics]
+type = "log_to_metric"
+inputs = ["pipeline_my_skew_1"]
+
+[[transforms.pipeline_my_skew_1_metrics.metrics]]
+type = "counter"
+field = "clock_skew_seconds"
+name = "collector_clock_skewed_records_total"
+
+[transforms.pipeline_my_skew_1_metrics.metrics.tags]
+log_type = "{{log_type}}"
+`).To(matchers.EqualConfigFrom(NewTransform(nil)("pipeline_my_skew_1", "pipeline_my_viaq_0")))
+		})
+	})
+
+})
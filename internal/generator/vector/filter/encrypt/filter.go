@@ -0,0 +1,43 @@
+package encrypt
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+// nonceSizes are the sizes in bytes of the nonce (IV) required by each algorithm
+var nonceSizes = map[obs.EncryptAlgorithm]int{
+	obs.EncryptAlgorithmXChaCha20Poly1305: 24,
+	obs.EncryptAlgorithmAES256CBC:         16,
+}
+
+type Filter struct {
+	spec obs.EncryptFilterSpec
+}
+
+// NewFilter returns an encrypt filter
+func NewFilter(spec *obs.EncryptFilterSpec) *Filter {
+	return &Filter{*spec}
+}
+
+func (f *Filter) VRL() (string, error) {
+	algorithm := f.spec.Algorithm
+	if algorithm == "" {
+		algorithm = obs.EncryptAlgorithmXChaCha20Poly1305
+	}
+	vrl := []string{
+		fmt.Sprintf("_key = decode_base64!(%q)", helpers.SecretFrom(f.spec.Key)),
+	}
+	for _, field := range f.spec.Fields {
+		vrl = append(vrl, fmt.Sprintf(`if exists(%[1]s) && %[1]s != null {
+  _value = %[1]s
+  if !is_string(_value) { _value = encode_json(_value) }
+  _nonce = random_bytes(%[2]d)
+  %[1]s = encode_base64(_nonce + encrypt!(to_string!(_value), %[3]q, _key, iv: _nonce))
+}`, field, nonceSizes[algorithm], algorithm))
+	}
+	return strings.Join(vrl, "\n"), nil
+}
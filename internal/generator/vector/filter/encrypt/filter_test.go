@@ -0,0 +1,47 @@
+package encrypt
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("encrypt filter", func() {
+
+	Context("#VRL", func() {
+		It("should generate VRL which encrypts each field with a random nonce", func() {
+			spec := &obs.EncryptFilterSpec{
+				Fields: []obs.FieldPath{".message", `.kubernetes.labels."app.kubernetes.io/name"`},
+				Key:    &obs.SecretReference{SecretName: "field-key", Key: "key"},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+_key = decode_base64!("SECRET[kubernetes_secret.field-key/key]")
+if exists(.message) && .message != null {
+  _value = .message
+  if !is_string(_value) { _value = encode_json(_value) }
+  _nonce = random_bytes(24)
+  .message = encode_base64(_nonce + encrypt!(to_string!(_value), "XCHACHA20-POLY1305", _key, iv: _nonce))
+}
+if exists(.kubernetes.labels."app.kubernetes.io/name") && .kubernetes.labels."app.kubernetes.io/name" != null {
+  _value = .kubernetes.labels."app.kubernetes.io/name"
+  if !is_string(_value) { _value = encode_json(_value) }
+  _nonce = random_bytes(24)
+  .kubernetes.labels."app.kubernetes.io/name" = encode_base64(_nonce + encrypt!(to_string!(_value), "XCHACHA20-POLY1305", _key, iv: _nonce))
+}
+`))
+		})
+		It("should use an IV sized for the selected algorithm", func() {
+			spec := &obs.EncryptFilterSpec{
+				Fields:    []obs.FieldPath{".structured"},
+				Algorithm: obs.EncryptAlgorithmAES256CBC,
+				Key:       &obs.SecretReference{SecretName: "field-key", Key: "key"},
+			}
+			vrl, err := NewFilter(spec).VRL()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vrl).To(ContainSubstring(`_nonce = random_bytes(16)`))
+			Expect(vrl).To(ContainSubstring(`"AES-256-CBC-PKCS7", _key, iv: _nonce`))
+		})
+	})
+
+})
@@ -8,11 +8,27 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/parse"
 
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/clockskew"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/drop"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/encrypt"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/grok"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/logmetrics"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/namespaceparsers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/openshift"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/prune"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/redact"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/rollup"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/sanitize"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/schedule"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/timestamp"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/tracecontext"
 
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/apiaudit"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/auditenrichment"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/cel"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/vrl"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
 )
 
 // InternalFilterSpec is a wrapper to allow separation of public and internal filters
@@ -28,6 +44,9 @@ type InternalFilterSpec struct {
 
 	//TranformFactory takes an id, inputs and returns an Element
 	TranformFactory func(id string, inputs ...string) framework.Element
+
+	// MetricsID returns the ID of the component generating the metrics of the transform with the given ID, if any
+	MetricsID func(id string) string
 }
 
 // RemapFilter is a remap transform that provides VRL script
@@ -36,7 +55,8 @@ type RemapFilter interface {
 	VRL() (string, error)
 }
 
-func NewInternalFilterMap(filters map[string]*obs.FilterSpec) map[string]*InternalFilterSpec {
+func NewInternalFilterMap(filters map[string]*obs.FilterSpec, op framework.Options) map[string]*InternalFilterSpec {
+	configMaps, _ := utils.GetOption(op, framework.OptionConfigMaps, map[string]*corev1.ConfigMap{})
 	internalFilters := map[string]*InternalFilterSpec{}
 	for _, f := range filters {
 		internalFilter := &InternalFilterSpec{FilterSpec: f}
@@ -47,8 +67,41 @@ func NewInternalFilterMap(filters map[string]*obs.FilterSpec) map[string]*Intern
 			internalFilter.RemapFilter = drop.NewFilter(f.DropTestsSpec)
 		case obs.FilterTypePrune:
 			internalFilter.RemapFilter = prune.NewFilter(f.PruneFilterSpec)
+		case obs.FilterTypeSchedule:
+			internalFilter.RemapFilter = schedule.NewFilter(f.Schedule)
+		case obs.FilterTypeEncrypt:
+			internalFilter.RemapFilter = encrypt.NewFilter(f.Encrypt)
+		case obs.FilterTypeSanitize:
+			internalFilter.RemapFilter = sanitize.NewFilter(f.Sanitize)
+		case obs.FilterTypeRedact:
+			internalFilter.RemapFilter = redact.NewFilter(f.Redact)
+		case obs.FilterTypeTimestamp:
+			internalFilter.RemapFilter = timestamp.NewFilter(f.Timestamp)
+		case obs.FilterTypeClockSkew:
+			internalFilter.SuppliesTransform = true
+			internalFilter.TranformFactory = clockskew.NewTransform(f.ClockSkew)
+			internalFilter.MetricsID = clockskew.MetricsID
+		case obs.FilterTypeLogMetrics:
+			internalFilter.SuppliesTransform = true
+			internalFilter.TranformFactory = logmetrics.NewTransform(f.LogMetrics)
+			internalFilter.MetricsID = logmetrics.MetricsID
+		case obs.FilterTypeRollup:
+			internalFilter.SuppliesTransform = true
+			internalFilter.TranformFactory = rollup.NewTransform(f.Rollup)
 		case obs.FilterTypeKubeAPIAudit:
 			internalFilter.RemapFilter = apiaudit.NewFilter(f.KubeAPIAudit)
+		case obs.FilterTypeAuditEnrichment:
+			internalFilter.RemapFilter = auditenrichment.NewFilter()
+		case obs.FilterTypeTraceContext:
+			internalFilter.RemapFilter = tracecontext.NewFilter()
+		case obs.FilterTypeNamespaceParsers:
+			internalFilter.RemapFilter = namespaceparsers.NewFilter(f.NamespaceParsers, configMaps)
+		case obs.FilterTypeGrok:
+			internalFilter.RemapFilter = grok.NewFilter(f.Grok)
+		case obs.FilterTypeCEL:
+			internalFilter.RemapFilter = cel.NewFilter(f.CEL)
+		case obs.FilterTypeVRL:
+			internalFilter.RemapFilter = vrl.NewFilter(f.VRL)
 		case obs.FilterTypeParse:
 			internalFilter.RemapFilter = parse.NewParseFilter()
 		case obs.FilterTypeDetectMultiline:
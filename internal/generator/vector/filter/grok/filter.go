@@ -0,0 +1,43 @@
+package grok
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+const (
+	defaultField = obs.FieldPath(".message")
+)
+
+type Filter struct {
+	spec obs.GrokFilterSpec
+}
+
+// NewFilter returns a grok filter
+func NewFilter(spec *obs.GrokFilterSpec) *Filter {
+	return &Filter{*spec}
+}
+
+func (f *Filter) VRL() (string, error) {
+	field := f.spec.Field
+	if field == "" {
+		field = defaultField
+	}
+	patterns := []string{}
+	for _, p := range f.spec.Patterns {
+		patterns = append(patterns, fmt.Sprintf("%q", p))
+	}
+	referenced := aliases(f.spec.Patterns, f.spec.CustomPatterns)
+	entries := []string{}
+	for _, name := range sortedKeys(referenced) {
+		entries = append(entries, fmt.Sprintf("  %q: %q", name, referenced[name]))
+	}
+	aliasesVRL := "{}"
+	if len(entries) > 0 {
+		aliasesVRL = "{\n" + strings.Join(entries, ",\n") + "\n}"
+	}
+	return fmt.Sprintf(`_parsed = parse_groks(to_string(%s) ?? "", patterns: [%s], aliases: %s) ?? null
+if _parsed != null { .structured = _parsed }`, field, strings.Join(patterns, ", "), aliasesVRL), nil
+}
@@ -0,0 +1,44 @@
+package grok
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("grok filter", func() {
+
+	Context("#VRL", func() {
+		It("should generate VRL which parses the message with the patterns", func() {
+			Expect(NewFilter(&obs.GrokFilterSpec{
+				Patterns: []string{`%{LOGLEVEL:level} %{GREEDYDATA:msg}`},
+			}).VRL()).To(matchers.EqualTrimLines(`
+_parsed = parse_groks(to_string(.message) ?? "", patterns: ["%{LOGLEVEL:level} %{GREEDYDATA:msg}"], aliases: {}) ?? null
+if _parsed != null { .structured = _parsed }
+`))
+		})
+		It("should only include the library and custom patterns referenced by the patterns", func() {
+			Expect(NewFilter(&obs.GrokFilterSpec{
+				Field:          ".raw",
+				Patterns:       []string{`%{nginx_error}`, `%{my_prefix} %{GREEDYDATA:msg}`},
+				CustomPatterns: map[string]string{"my_prefix": `%{my_level}:`, "my_level": `%{LOGLEVEL:level}`, "unused": `%{WORD}`},
+			}).VRL()).To(matchers.EqualTrimLines(`
+_parsed = parse_groks(to_string(.raw) ?? "", patterns: ["%{nginx_error}", "%{my_prefix} %{GREEDYDATA:msg}"], aliases: {
+  "my_level": "%{LOGLEVEL:level}",
+  "my_prefix": "%{my_level}:",
+  "nginx_error": "%{nginx_error_time:time} \\[%{LOGLEVEL:level}\\] %{POSINT:pid}#%{NUMBER:tid}: (?:\\*%{NUMBER:connection_id} )?%{GREEDYDATA:error}",
+  "nginx_error_time": "%{YEAR}/%{MONTHNUM}/%{MONTHDAY} %{TIME}"
+}) ?? null
+if _parsed != null { .structured = _parsed }
+`))
+		})
+	})
+
+	Context("#References", func() {
+		It("should return the names of the referenced patterns", func() {
+			Expect(References(`%{apache_common} "%{DATA:referrer}" %{WORD}`)).To(Equal([]string{"apache_common", "DATA", "WORD"}))
+		})
+	})
+
+})
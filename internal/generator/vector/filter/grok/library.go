@@ -0,0 +1,64 @@
+package grok
+
+import (
+	"regexp"
+	"sort"
+)
+
+// Library is the shipped library of patterns which can be referenced by the patterns of a grok filter
+var Library = map[string]string{
+	"apache_common":    `%{IPORHOST:client} %{USER:ident} %{USER:auth} \[%{HTTPDATE:timestamp}\] "(?:%{WORD:method} %{NOTSPACE:request}(?: HTTP/%{NUMBER:http_version})?|%{DATA:raw_request})" %{NUMBER:status} (?:%{NUMBER:bytes}|-)`,
+	"apache_combined":  `%{apache_common} "%{DATA:referrer}" "%{DATA:agent}"`,
+	"nginx_access":     `%{IPORHOST:remote_addr} - %{USER:remote_user} \[%{HTTPDATE:time_local}\] "%{WORD:method} %{NOTSPACE:request} HTTP/%{NUMBER:http_version}" %{NUMBER:status} %{NUMBER:body_bytes_sent} "%{DATA:http_referer}" "%{DATA:http_user_agent}"`,
+	"nginx_error":      `%{nginx_error_time:time} \[%{LOGLEVEL:level}\] %{POSINT:pid}#%{NUMBER:tid}: (?:\*%{NUMBER:connection_id} )?%{GREEDYDATA:error}`,
+	"nginx_error_time": `%{YEAR}/%{MONTHNUM}/%{MONTHDAY} %{TIME}`,
+	"haproxy_http":     `%{IPORHOST:client_ip}:%{INT:client_port} \[%{haproxy_date:accept_date}\] %{NOTSPACE:frontend_name} %{NOTSPACE:backend_name}/%{NOTSPACE:server_name} %{INT:time_request}/%{INT:time_queue}/%{INT:time_backend_connect}/%{INT:time_backend_response}/%{NOTSPACE:time_duration} %{INT:http_status_code} %{NOTSPACE:bytes_read} %{NOTSPACE:captured_request_cookie} %{NOTSPACE:captured_response_cookie} %{NOTSPACE:termination_state} %{INT:actconn}/%{INT:feconn}/%{INT:beconn}/%{INT:srvconn}/%{NOTSPACE:retries} %{INT:srv_queue}/%{INT:backend_queue} "%{DATA:http_request}"`,
+	"haproxy_tcp":      `%{IPORHOST:client_ip}:%{INT:client_port} \[%{haproxy_date:accept_date}\] %{NOTSPACE:frontend_name} %{NOTSPACE:backend_name}/%{NOTSPACE:server_name} %{INT:time_queue}/%{INT:time_backend_connect}/%{NOTSPACE:time_duration} %{NOTSPACE:bytes_read} %{NOTSPACE:termination_state} %{INT:actconn}/%{INT:feconn}/%{INT:beconn}/%{INT:srvconn}/%{NOTSPACE:retries} %{INT:srv_queue}/%{INT:backend_queue}`,
+	"haproxy_date":     `%{MONTHDAY}/%{MONTH}/%{YEAR}:%{TIME}`,
+	"postgres":         `%{TIMESTAMP_ISO8601:timestamp} %{WORD:timezone} \[%{POSINT:pid}\] %{WORD:level}:  %{GREEDYDATA:statement}`,
+}
+
+// referenceRE matches the references to other patterns, e.g. `%{nginx_access}` or `%{WORD:method}`
+var referenceRE = regexp.MustCompile(`%\{([a-zA-Z0-9_]+)(?::[^}]*)?\}`)
+
+// References returns the names of the patterns referenced by a pattern
+func References(pattern string) (names []string) {
+	for _, match := range referenceRE.FindAllStringSubmatch(pattern, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// aliases returns the library and custom patterns referenced by the patterns, including their own references
+func aliases(patterns []string, custom map[string]string) map[string]string {
+	found := map[string]string{}
+	pending := append([]string{}, patterns...)
+	for len(pending) > 0 {
+		pattern := pending[0]
+		pending = pending[1:]
+		for _, name := range References(pattern) {
+			if _, done := found[name]; done {
+				continue
+			}
+			alias, isCustom := custom[name]
+			if !isCustom {
+				var inLibrary bool
+				if alias, inLibrary = Library[name]; !inLibrary {
+					continue
+				}
+			}
+			found[name] = alias
+			pending = append(pending, alias)
+		}
+	}
+	return found
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys(m map[string]string) (keys []string) {
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,79 @@
+package logmetrics
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	defaultField = obs.FieldPath(".message")
+)
+
+// MetricsID returns the ID of the component generating the metrics of the log metrics filter with the given ID
+func MetricsID(id string) string {
+	return id + "_metrics"
+}
+
+// NewTransform returns a factory of the transform which counts the records matching the patterns of the metrics
+func NewTransform(spec *obs.LogMetricsFilterSpec) func(id string, inputs ...string) framework.Element {
+	return func(id string, inputs ...string) framework.Element {
+		return Transform{
+			Remap: elements.Remap{
+				ComponentID: id,
+				Inputs:      helpers.MakeInputs(inputs...),
+				VRL:         VRL(*spec),
+			},
+			MetricsID: MetricsID(id),
+			Metrics:   spec.Metrics,
+		}
+	}
+}
+
+// VRL returns the VRL which marks the records matching the pattern of each metric in the internal context
+func VRL(spec obs.LogMetricsFilterSpec) string {
+	vrl := []string{`._internal.log_metrics_namespace = to_string(.kubernetes.namespace_name) ?? ""`}
+	for _, m := range spec.Metrics {
+		field := m.Field
+		if field == "" {
+			field = defaultField
+		}
+		vrl = append(vrl, fmt.Sprintf(`if match(to_string(%s) ?? "", r'%s') { ._internal.log_metrics.%s = 1 }`, field, m.Pattern, m.Name))
+	}
+	return strings.Join(vrl, "\n")
+}
+
+// Transform is the remap transform of the filter and the log_to_metric transform counting the matching records
+type Transform struct {
+	Remap     elements.Remap
+	MetricsID string
+	Metrics   []obs.LogMetric
+}
+
+func (t Transform) Name() string {
+	return "logMetricsTemplate"
+}
+
+func (t Transform) Template() string {
+	return t.Remap.Template() + `{{define "` + t.Name() + `" -}}
+{{template "remapTemplate" .Remap}}
+[transforms.{{.MetricsID}}]
+type = "log_to_metric"
+inputs = ["{{.Remap.ComponentID}}"]
+{{- range .Metrics}}
+
+[[transforms.{{$.MetricsID}}.metrics]]
+type = "counter"
+field = "_internal.log_metrics.{{.Name}}"
+name = "{{.Name}}"
+
+[transforms.{{$.MetricsID}}.metrics.tags]
+log_type = "{{"{{log_type}}"}}"
+namespace = "{{"{{_internal.log_metrics_namespace}}"}}"
+{{- end}}
+{{end}}`
+}
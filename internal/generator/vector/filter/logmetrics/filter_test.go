@@ -0,0 +1,66 @@
+package logmetrics
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("log metrics filter", func() {
+
+	var spec = obs.LogMetricsFilterSpec{
+		Metrics: []obs.LogMetric{
+			{Name: "oom_errors_total", Pattern: `(?i)out of memory`},
+			{Name: "panics_total", Field: ".structured.level", Pattern: `^panic$`},
+		},
+	}
+
+	Context("#VRL", func() {
+		It("should generate VRL which marks the records matching each metric", func() {
+			Expect(VRL(spec)).To(matchers.EqualTrimLines(`
+._internal.log_metrics_namespace = to_string(.kubernetes.namespace_name) ?? ""
+if match(to_string(.message) ?? "", r'(?i)out of memory') { ._internal.log_metrics.oom_errors_total = 1 }
+if match(to_string(.structured.level) ?? "", r'^panic$') { ._internal.log_metrics.panics_total = 1 }
+`))
+		})
+	})
+
+	Context("#NewTransform", func() {
+		It("should generate a remap and a log_to_metric transform with a counter for each metric", func() {
+			Expect(`
+[transforms.pipeline_my_errors_1]
+type = "remap"
+inputs = ["pipeline_my_viaq_0"]
+source = '''
+  ._internal.log_metrics_namespace = to_string(.kubernetes.namespace_name) ?? ""
+  if match(to_string(.message) ?? "", r'(?i)out of memory') { ._internal.log_metrics.oom_errors_total = 1 }
+  if match(to_string(.structured.level) ?? "", r'^panic$') { ._internal.log_metrics.panics_total = 1 }
+'''
+
+[transforms.pipeline_my_errors_1_metrics]
+type = "log_to_metric"
+inputs = ["pipeline_my_errors_1"]
+
+[[transforms.pipeline_my_errors_1_metrics.metrics]]
+type = "counter"
+field = "_internal.log_metrics.oom_errors_total"
+name = "oom_errors_total"
+
+[transforms.pipeline_my_errors_1_metrics.metrics.tags]
+log_type = "{{log_type}}"
+namespace = "{{_internal.log_metrics_namespace}}"
+
+[[transforms.pipeline_my_errors_1_metrics.metrics]]
+type = "counter"
+field = "_internal.log_metrics.panics_total"
+name = "panics_total"
+
+[transforms.pipeline_my_errors_1_metrics.metrics.tags]
+log_type = "{{log_type}}"
+namespace = "{{_internal.log_metrics_namespace}}"
+`).To(matchers.EqualConfigFrom(NewTransform(&spec)("pipeline_my_errors_1", "pipeline_my_viaq_0")))
+		})
+	})
+
+})
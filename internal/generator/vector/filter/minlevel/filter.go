@@ -0,0 +1,44 @@
+package minlevel
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+// MinLevel is the name of the filter added to pipelines with a minimum level
+const MinLevel = "minLevel"
+
+// aliases are the values of non-normalized levels matching a log level
+var aliases = map[obs.LogLevel][]string{
+	obs.LogLevelWarn: {"warning"},
+}
+
+// New returns a filter transform which keeps records with the given level or higher and audit records
+func New(id string, minLevel obs.LogLevel, inputs ...string) framework.Element {
+	return elements.Filter{
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(inputs...),
+		Condition:   Condition(minLevel),
+	}
+}
+
+// Condition returns the VRL condition matching records with the given level or higher
+func Condition(minLevel obs.LogLevel) string {
+	levels := []string{}
+	found := false
+	for _, level := range obs.LogLevels {
+		found = found || level == minLevel
+		if found {
+			levels = append(levels, fmt.Sprintf("%q", level))
+			for _, alias := range aliases[level] {
+				levels = append(levels, fmt.Sprintf("%q", alias))
+			}
+		}
+	}
+	return fmt.Sprintf(`.log_type == "audit" || includes([%s], downcase(to_string(.level) ?? ""))`, strings.Join(levels, ","))
+}
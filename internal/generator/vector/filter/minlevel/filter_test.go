@@ -0,0 +1,26 @@
+package minlevel
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("minLevel filter", func() {
+
+	It("should keep audit records and records with the minimum level or higher", func() {
+		Expect(`
+[transforms.pipeline_mypipeline_minlevel_1]
+type = "filter"
+inputs = ["pipeline_mypipeline_viaq_0"]
+condition = '''
+.log_type == "audit" || includes(["warn","warning","error","critical","alert","emergency"], downcase(to_string(.level) ?? ""))
+'''
+`).To(matchers.EqualConfigFrom(New("pipeline_mypipeline_minlevel_1", obs.LogLevelWarn, "pipeline_mypipeline_viaq_0")))
+	})
+
+	It("should only keep the most severe records for the highest level", func() {
+		Expect(Condition(obs.LogLevelEmergency)).To(Equal(`.log_type == "audit" || includes(["emergency"], downcase(to_string(.level) ?? ""))`))
+	})
+})
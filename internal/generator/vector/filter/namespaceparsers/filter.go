@@ -0,0 +1,76 @@
+package namespaceparsers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type Filter struct {
+	spec       obs.NamespaceParsersFilterSpec
+	configMaps map[string]*corev1.ConfigMap
+}
+
+// NewFilter returns a filter parsing the messages of containers with the parsers registered in the configmap of the spec
+func NewFilter(spec *obs.NamespaceParsersFilterSpec, configMaps map[string]*corev1.ConfigMap) *Filter {
+	return &Filter{*spec, configMaps}
+}
+
+func (f *Filter) VRL() (string, error) {
+	configMap, found := f.configMaps[f.spec.ConfigMapName]
+	if !found {
+		return "", fmt.Errorf("configmap[%s] not found", f.spec.ConfigMapName)
+	}
+	parsers, err := internalobs.NamespaceParsers(configMap)
+	if err != nil {
+		return "", err
+	}
+	vrl := []string{
+		`if .log_source == "container" {`,
+		fmt.Sprintf(`  _parser = .kubernetes.annotations.%q`, constants.AnnotationLogParser),
+		`  _parsed = null`,
+	}
+	for _, p := range parsers {
+		vrl = append(vrl,
+			fmt.Sprintf(`  if _parser == %q && .kubernetes.namespace_name == %q {`, p.Name, p.Namespace),
+			fmt.Sprintf(`    _parsed = %s ?? null`, parse(p)),
+			`  }`,
+		)
+	}
+	vrl = append(vrl,
+		`  if _parsed != null { .structured = _parsed }`,
+		`}`,
+	)
+	return strings.Join(vrl, "\n"), nil
+}
+
+// parse returns the VRL expression parsing the message with a parser
+func parse(p internalobs.NamespaceParser) string {
+	message := `string(.message) ?? ""`
+	switch p.Type {
+	case internalobs.NamespaceParserTypeGrok:
+		return fmt.Sprintf(`parse_grok(%s, %q)`, message, p.Pattern)
+	case internalobs.NamespaceParserTypeJSONPointer:
+		return fmt.Sprintf(`get(parse_json(%s) ?? {}, [%s])`, message, strings.Join(pointerSegments(p.Pattern), ", "))
+	default:
+		return fmt.Sprintf(`parse_regex(%s, r'%s')`, message, p.Pattern)
+	}
+}
+
+// pointerSegments returns the VRL path segments of a JSON pointer, using indexes for numeric segments
+func pointerSegments(pointer string) (segments []string) {
+	for _, segment := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		segment = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+		if index, err := strconv.Atoi(segment); err == nil && index >= 0 {
+			segments = append(segments, strconv.Itoa(index))
+		} else {
+			segments = append(segments, strconv.Quote(segment))
+		}
+	}
+	return segments
+}
@@ -0,0 +1,50 @@
+package namespaceparsers
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("namespace parsers filter", func() {
+
+	var (
+		spec       = &obs.NamespaceParsersFilterSpec{ConfigMapName: "parsers"}
+		configMaps = map[string]*corev1.ConfigMap{
+			"parsers": runtime.NewConfigMap("openshift-logging", "parsers", map[string]string{
+				"nginx":  `{namespace: web, type: regex, pattern: '^(?P<client>\S+) (?P<request>.*)$'}`,
+				"java":   `{namespace: billing, type: grok, pattern: '%{TIMESTAMP_ISO8601:time} %{LOGLEVEL:level} %{GREEDYDATA:msg}'}`,
+				"events": `{namespace: billing, type: jsonPointer, pattern: /events/0/a~1b}`,
+			}),
+		}
+	)
+
+	Context("#VRL", func() {
+		It("should generate VRL which parses the messages of each namespace with the parser selected by the pod annotation", func() {
+			Expect(NewFilter(spec, configMaps).VRL()).To(matchers.EqualTrimLines(`
+if .log_source == "container" {
+  _parser = .kubernetes.annotations."observability.openshift.io/log-parser"
+  _parsed = null
+  if _parser == "events" && .kubernetes.namespace_name == "billing" {
+    _parsed = get(parse_json(string(.message) ?? "") ?? {}, ["events", 0, "a/b"]) ?? null
+  }
+  if _parser == "java" && .kubernetes.namespace_name == "billing" {
+    _parsed = parse_grok(string(.message) ?? "", "%{TIMESTAMP_ISO8601:time} %{LOGLEVEL:level} %{GREEDYDATA:msg}") ?? null
+  }
+  if _parser == "nginx" && .kubernetes.namespace_name == "web" {
+    _parsed = parse_regex(string(.message) ?? "", r'^(?P<client>\S+) (?P<request>.*)$') ?? null
+  }
+  if _parsed != null { .structured = _parsed }
+}
+`))
+		})
+		It("should fail when the configmap is not found", func() {
+			_, err := NewFilter(&obs.NamespaceParsersFilterSpec{ConfigMapName: "missing"}, configMaps).VRL()
+			Expect(err).To(MatchError("configmap[missing] not found"))
+		})
+	})
+
+})
@@ -17,6 +17,15 @@ match1 = parse_regex(.message, r'type=(?P<type>[^ ]+)') ?? {}
 envelop = {}
 envelop |= {"type": match1.type}
 
+match3 = parse_regex(.message, r'msg=audit\([^)]+\):\s*(?P<data>.*)$') ?? {}
+if exists(match3.data) {
+  # Enriched fields are separated by a GS character and user space messages are nested in msg='...'
+  data = replace(string!(match3.data), "\u{1d}", " ")
+  data = replace(replace(data, "msg='", ""), "'", "")
+  fields = parse_key_value(data, field_delimiter: " ", key_value_delimiter: "=", accept_standalone_key: false) ?? {}
+  envelop |= filter(fields) -> |key, _value| { includes(["syscall","success","exit","pid","ppid","uid","auid","euid","gid","ses","comm","exe","key","op","acct","res","terminal","addr"], key) }
+}
+
 match2, err = parse_regex(.message, r'msg=audit\((?P<ts_record>[^ ]+)\):')
 if err == null {
   sp, err = split(match2.ts_record,":")
@@ -29,6 +38,25 @@ if err == null {
 } else {
   log("could not parse host audit msg. err=" + err, rate_limit_secs: 0)
 }
+`
+
+	// ParseOVNACLLogs parses the ACL fields of OVN audit logs (e.g. ...|acl_log(ovn_pinctrl0)|INFO|name="NP:ns:Ingress", verdict=drop, severity=alert, direction=to-lport: tcp,...)
+	ParseOVNACLLogs = `
+acl = parse_regex(.message, r'acl_log\([^)]*\)\|[^|]*\|name="(?P<name>[^"]*)", verdict=(?P<verdict>[^,]+), severity=(?P<severity>[^,:]+)(, direction=(?P<direction>[^:]+))?: (?P<flow>.*)$') ?? null
+if acl != null {
+  flow = parse_key_value(string!(acl.flow), field_delimiter: ",", key_value_delimiter: "=", accept_standalone_key: true) ?? {}
+  .ovn.acl = compact({
+    "name": acl.name,
+    "verdict": acl.verdict,
+    "severity": acl.severity,
+    "direction": acl.direction,
+    "protocol": split(string!(acl.flow), ",")[0],
+    "src_ip": flow.nw_src || flow.ipv6_src,
+    "dst_ip": flow.nw_dst || flow.ipv6_dst,
+    "src_port": flow.tp_src,
+    "dst_port": flow.tp_dst
+  })
+}
 `
 )
 
@@ -104,6 +132,7 @@ if .log_type == "%s" && .log_source == "%s" {
 			ClusterID,
 			RemoveFile,
 			RemoveSourceType,
+			ParseOVNACLLogs,
 			FixLogLevel,
 			FixHostname,
 			FixTimestampField,
@@ -108,7 +108,7 @@ func hasContainerSource(inputSpecs []obs.InputSpec) bool {
 		if i.Type == obs.InputTypeApplication {
 			return true
 		}
-		if i.Type == obs.InputTypeInfrastructure && i.Infrastructure != nil && (len(i.Infrastructure.Sources) == 0 || set.New(i.Infrastructure.Sources...).Has(obs.InfrastructureSourceContainer)) {
+		if i.Type == obs.InputTypeInfrastructure && i.Infrastructure != nil && (len(i.Infrastructure.Sources) == 0 || set.New(i.Infrastructure.Sources...).HasAny(obs.InfrastructureSourceContainer, obs.InfrastructureSourceLogging)) {
 			return true
 		}
 	}
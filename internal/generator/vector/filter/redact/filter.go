@@ -0,0 +1,45 @@
+package redact
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+const DefaultReplacement = "[REDACTED]"
+
+var DefaultFields = []obs.FieldPath{".message"}
+
+type Filter struct {
+	spec obs.RedactFilterSpec
+}
+
+// NewFilter returns a redact filter. A nil spec redacts nothing
+func NewFilter(spec *obs.RedactFilterSpec) *Filter {
+	if spec == nil {
+		spec = &obs.RedactFilterSpec{}
+	}
+	return &Filter{*spec}
+}
+
+func (f *Filter) VRL() (string, error) {
+	fields := f.spec.Fields
+	if len(fields) == 0 {
+		fields = DefaultFields
+	}
+	replacement := f.spec.Replacement
+	if replacement == "" {
+		replacement = DefaultReplacement
+	}
+	vrl := []string{}
+	for _, field := range fields {
+		lines := []string{fmt.Sprintf("if is_string(%s) {", field)}
+		for _, pattern := range f.spec.Patterns {
+			lines = append(lines, fmt.Sprintf("  %[1]s = replace(%[1]s, r'%[2]s', %[3]q)", field, pattern, replacement))
+		}
+		lines = append(lines, "}")
+		vrl = append(vrl, strings.Join(lines, "\n"))
+	}
+	return strings.Join(vrl, "\n"), nil
+}
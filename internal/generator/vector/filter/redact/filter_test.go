@@ -0,0 +1,40 @@
+package redact
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("redact filter", func() {
+	Context("#VRL", func() {
+		It("should generate VRL which masks the matches in the message when the fields are not configured", func() {
+			spec := &obs.RedactFilterSpec{
+				Patterns: []string{`\b(?:\d[ -]?){13,16}\b`},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if is_string(.message) {
+  .message = replace(.message, r'\b(?:\d[ -]?){13,16}\b', "[REDACTED]")
+}
+`))
+		})
+		It("should generate VRL which replaces the matches of each pattern in each field", func() {
+			spec := &obs.RedactFilterSpec{
+				Fields:      []obs.FieldPath{".message", `.kubernetes.annotations."example.com/token"`},
+				Patterns:    []string{`\b(?:\d[ -]?){13,16}\b`, `(?i)password=\S+`},
+				Replacement: "****",
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if is_string(.message) {
+  .message = replace(.message, r'\b(?:\d[ -]?){13,16}\b', "****")
+  .message = replace(.message, r'(?i)password=\S+', "****")
+}
+if is_string(.kubernetes.annotations."example.com/token") {
+  .kubernetes.annotations."example.com/token" = replace(.kubernetes.annotations."example.com/token", r'\b(?:\d[ -]?){13,16}\b', "****")
+  .kubernetes.annotations."example.com/token" = replace(.kubernetes.annotations."example.com/token", r'(?i)password=\S+', "****")
+}
+`))
+		})
+	})
+})
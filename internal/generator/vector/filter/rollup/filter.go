@@ -0,0 +1,97 @@
+package rollup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	// defaultWindow is the duration, in seconds, of the windows when not specified
+	defaultWindow = int64(time.Minute / time.Second)
+
+	// CountField is the field of the summary records holding the number of records of the group
+	CountField = "count"
+)
+
+// FieldsID returns the ID of the remap transform reducing the records of the rollup filter with the given ID to the
+// fields of the summary records
+func FieldsID(id string) string {
+	return id + "_fields"
+}
+
+// NewTransform returns a factory of the transforms which replace the records of each window with summary records
+func NewTransform(spec *obs.RollupFilterSpec) func(id string, inputs ...string) framework.Element {
+	return func(id string, inputs ...string) framework.Element {
+		window := defaultWindow
+		if spec.Window != nil {
+			window = int64(*spec.Window)
+		}
+		groupBy := []string{".log_type"}
+		for _, f := range spec.GroupBy {
+			groupBy = append(groupBy, string(f))
+		}
+		sums := []string{CountField}
+		for _, s := range spec.Sums {
+			sums = append(sums, s.Name)
+		}
+		return Transform{
+			Remap: elements.Remap{
+				ComponentID: FieldsID(id),
+				Inputs:      helpers.MakeInputs(inputs...),
+				VRL:         VRL(*spec),
+			},
+			ComponentID: id,
+			GroupBy:     helpers.MakeInputs(groupBy...),
+			WindowMs:    window * 1000,
+			Sums:        sums,
+		}
+	}
+}
+
+// VRL returns the VRL which replaces a record with the fields of its summary record
+func VRL(spec obs.RollupFilterSpec) string {
+	vrl := []string{
+		fmt.Sprintf(`_rollup = {"@timestamp": now(), "log_type": .log_type, "log_source": .log_source, "hostname": .hostname, "openshift": .openshift, %q: 1}`, CountField),
+	}
+	for _, f := range spec.GroupBy {
+		vrl = append(vrl, fmt.Sprintf("_rollup%s = %s", f, f))
+	}
+	for _, s := range spec.Sums {
+		vrl = append(vrl, fmt.Sprintf("_rollup.%s = to_float(%s) ?? 0.0", s.Name, s.Field))
+	}
+	return strings.Join(append(vrl, ". = _rollup"), "\n")
+}
+
+// Transform is the remap transform of the filter and the reduce transform merging the records of each group
+type Transform struct {
+	Remap       elements.Remap
+	ComponentID string
+	GroupBy     string
+	WindowMs    int64
+	Sums        []string
+}
+
+func (t Transform) Name() string {
+	return "rollupTemplate"
+}
+
+func (t Transform) Template() string {
+	return t.Remap.Template() + `{{define "` + t.Name() + `" -}}
+{{template "remapTemplate" .Remap}}
+[transforms.{{.ComponentID}}]
+type = "reduce"
+inputs = ["{{.Remap.ComponentID}}"]
+group_by = {{.GroupBy}}
+expire_after_ms = {{.WindowMs}}
+end_every_period_ms = {{.WindowMs}}
+{{- range .Sums}}
+merge_strategies.{{.}} = "sum"
+{{- end}}
+{{end}}`
+}
@@ -0,0 +1,80 @@
+package rollup
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("rollup filter", func() {
+
+	var (
+		window = time.Duration(300)
+		spec   = obs.RollupFilterSpec{
+			Window:  &window,
+			GroupBy: []obs.FieldPath{".kubernetes.namespace_name", ".structured.status"},
+			Sums:    []obs.RollupSum{{Name: "bytes", Field: ".structured.bytes"}},
+		}
+	)
+
+	Context("#VRL", func() {
+		It("should generate VRL which reduces the records to the fields of the summary records", func() {
+			Expect(VRL(spec)).To(matchers.EqualTrimLines(`
+_rollup = {"@timestamp": now(), "log_type": .log_type, "log_source": .log_source, "hostname": .hostname, "openshift": .openshift, "count": 1}
+_rollup.kubernetes.namespace_name = .kubernetes.namespace_name
+_rollup.structured.status = .structured.status
+_rollup.bytes = to_float(.structured.bytes) ?? 0.0
+. = _rollup
+`))
+		})
+	})
+
+	Context("#NewTransform", func() {
+		It("should generate a remap and a reduce transform summing the records of each group", func() {
+			Expect(`
+[transforms.pipeline_my_rollup_1_fields]
+type = "remap"
+inputs = ["pipeline_my_viaq_0"]
+source = '''
+  _rollup = {"@timestamp": now(), "log_type": .log_type, "log_source": .log_source, "hostname": .hostname, "openshift": .openshift, "count": 1}
+  _rollup.kubernetes.namespace_name = .kubernetes.namespace_name
+  _rollup.structured.status = .structured.status
+  _rollup.bytes = to_float(.structured.bytes) ?? 0.0
+  . = _rollup
+'''
+
+[transforms.pipeline_my_rollup_1]
+type = "reduce"
+inputs = ["pipeline_my_rollup_1_fields"]
+group_by = [".kubernetes.namespace_name",".log_type",".structured.status"]
+expire_after_ms = 300000
+end_every_period_ms = 300000
+merge_strategies.count = "sum"
+merge_strategies.bytes = "sum"
+`).To(matchers.EqualConfigFrom(NewTransform(&spec)("pipeline_my_rollup_1", "pipeline_my_viaq_0")))
+		})
+
+		It("should group the records by log type every minute by default", func() {
+			Expect(`
+[transforms.pipeline_my_rollup_1_fields]
+type = "remap"
+inputs = ["pipeline_my_viaq_0"]
+source = '''
+  _rollup = {"@timestamp": now(), "log_type": .log_type, "log_source": .log_source, "hostname": .hostname, "openshift": .openshift, "count": 1}
+  . = _rollup
+'''
+
+[transforms.pipeline_my_rollup_1]
+type = "reduce"
+inputs = ["pipeline_my_rollup_1_fields"]
+group_by = [".log_type"]
+expire_after_ms = 60000
+end_every_period_ms = 60000
+merge_strategies.count = "sum"
+`).To(matchers.EqualConfigFrom(NewTransform(&obs.RollupFilterSpec{})("pipeline_my_rollup_1", "pipeline_my_viaq_0")))
+		})
+	})
+})
@@ -0,0 +1,53 @@
+package sanitize
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+const (
+	DefaultCharset = "UTF-8"
+
+	// controlCharacters matches the ASCII control characters other than tab, line feed and carriage return
+	controlCharacters = `r'[\x00-\x08\x0B\x0C\x0E-\x1F\x7F]'`
+)
+
+var DefaultFields = []obs.FieldPath{".message"}
+
+type Filter struct {
+	spec obs.SanitizeFilterSpec
+}
+
+// NewFilter returns a sanitize filter. A nil spec sanitizes the default fields
+func NewFilter(spec *obs.SanitizeFilterSpec) *Filter {
+	if spec == nil {
+		spec = &obs.SanitizeFilterSpec{}
+	}
+	return &Filter{*spec}
+}
+
+func (f *Filter) VRL() (string, error) {
+	fields := f.spec.Fields
+	if len(fields) == 0 {
+		fields = DefaultFields
+	}
+	charset := f.spec.Charset
+	if charset == "" {
+		charset = DefaultCharset
+	}
+	vrl := []string{}
+	for _, field := range fields {
+		lines := []string{
+			fmt.Sprintf("if is_string(%s) {", field),
+			fmt.Sprintf("  %[1]s = decode_charset!(%[1]s, %[2]q)", field, charset),
+		}
+		if f.spec.RemoveControlCharacters {
+			lines = append(lines, fmt.Sprintf("  %[1]s = replace(%[1]s, %[2]s, \"\")", field, controlCharacters))
+		}
+		lines = append(lines, "}")
+		vrl = append(vrl, strings.Join(lines, "\n"))
+	}
+	return strings.Join(vrl, "\n"), nil
+}
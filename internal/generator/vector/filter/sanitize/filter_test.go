@@ -0,0 +1,39 @@
+package sanitize
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("sanitize filter", func() {
+
+	Context("#VRL", func() {
+		It("should generate VRL which decodes the message as UTF-8 when not configured", func() {
+			Expect(NewFilter(nil).VRL()).To(matchers.EqualTrimLines(`
+if is_string(.message) {
+  .message = decode_charset!(.message, "UTF-8")
+}
+`))
+		})
+		It("should generate VRL which decodes each field from the charset and removes control characters", func() {
+			spec := &obs.SanitizeFilterSpec{
+				Fields:                  []obs.FieldPath{".message", `.kubernetes.labels."app.kubernetes.io/name"`},
+				Charset:                 "ISO-8859-1",
+				RemoveControlCharacters: true,
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+if is_string(.message) {
+  .message = decode_charset!(.message, "ISO-8859-1")
+  .message = replace(.message, r'[\x00-\x08\x0B\x0C\x0E-\x1F\x7F]', "")
+}
+if is_string(.kubernetes.labels."app.kubernetes.io/name") {
+  .kubernetes.labels."app.kubernetes.io/name" = decode_charset!(.kubernetes.labels."app.kubernetes.io/name", "ISO-8859-1")
+  .kubernetes.labels."app.kubernetes.io/name" = replace(.kubernetes.labels."app.kubernetes.io/name", r'[\x00-\x08\x0B\x0C\x0E-\x1F\x7F]', "")
+}
+`))
+		})
+	})
+
+})
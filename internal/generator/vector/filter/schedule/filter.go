@@ -0,0 +1,85 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+const (
+	defaultTimeZone = "UTC"
+)
+
+// weekdays are the days of the week in order
+var weekdays = []obs.Weekday{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+type Filter struct {
+	spec obs.ScheduleFilterSpec
+}
+
+// NewFilter returns a schedule filter
+func NewFilter(spec *obs.ScheduleFilterSpec) *Filter {
+	return &Filter{*spec}
+}
+
+func (f *Filter) VRL() (string, error) {
+	timeZone := f.spec.TimeZone
+	if timeZone == "" {
+		timeZone = defaultTimeZone
+	}
+	windows := []string{}
+	for _, w := range f.spec.Windows {
+		start, end := toHHMM(w.Start), toHHMM(w.End)
+		switch {
+		case start <= end:
+			windows = append(windows, window(w.Days, fmt.Sprintf("_time >= %d && _time < %d", start, end)))
+		case len(w.Days) == 0:
+			// The window spans midnight
+			windows = append(windows, window(nil, fmt.Sprintf("(_time >= %d || _time < %d)", start, end)))
+		default:
+			// The window spans midnight so the time after midnight belongs to the window of the previous day
+			windows = append(windows,
+				window(w.Days, fmt.Sprintf("_time >= %d", start)),
+				window(nextDays(w.Days), fmt.Sprintf("_time < %d", end)))
+		}
+	}
+
+	// Vector's transform.Filter keeps logs that match the condition
+	return fmt.Sprintf(`_now = now()
+_day = format_timestamp!(_now, format: "%%a", timezone: %q)
+_time = to_int!(format_timestamp!(_now, format: "%%H%%M", timezone: %q))
+%s`, timeZone, timeZone, strings.Join(windows, " || ")), nil
+}
+
+// window returns the condition of a time range on the given days, or every day when none are given
+func window(days []obs.Weekday, timeRange string) string {
+	if len(days) == 0 {
+		return "(" + timeRange + ")"
+	}
+	quoted := []string{}
+	for _, d := range days {
+		quoted = append(quoted, fmt.Sprintf("%q", d))
+	}
+	return fmt.Sprintf("(includes([%s], _day) && %s)", strings.Join(quoted, ","), timeRange)
+}
+
+// nextDays returns the days following each of the given days
+func nextDays(days []obs.Weekday) []obs.Weekday {
+	next := []obs.Weekday{}
+	for _, d := range days {
+		for i, weekday := range weekdays {
+			if weekday == d {
+				next = append(next, weekdays[(i+1)%len(weekdays)])
+			}
+		}
+	}
+	return next
+}
+
+// toHHMM converts a HH:MM time to its HHMM integer representation
+func toHHMM(hhmm string) int {
+	value, _ := strconv.Atoi(strings.ReplaceAll(hhmm, ":", ""))
+	return value
+}
@@ -0,0 +1,70 @@
+package schedule
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("schedule filter", func() {
+
+	Context("#VRL", func() {
+		It("should generate valid VRL for keeping records within the time windows", func() {
+			spec := &obs.ScheduleFilterSpec{
+				TimeZone: "America/New_York",
+				Windows: []obs.TimeWindow{
+					{
+						Days:  []obs.Weekday{"Mon", "Tue", "Wed", "Thu", "Fri"},
+						Start: "09:00",
+						End:   "17:30",
+					},
+					{
+						Start: "22:00",
+						End:   "06:00",
+					},
+				},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+_now = now()
+_day = format_timestamp!(_now, format: "%a", timezone: "America/New_York")
+_time = to_int!(format_timestamp!(_now, format: "%H%M", timezone: "America/New_York"))
+(includes(["Mon","Tue","Wed","Thu","Fri"], _day) && _time >= 900 && _time < 1730) || ((_time >= 2200 || _time < 600))
+`))
+		})
+		It("should keep the time after midnight of a window spanning midnight on the day following its days", func() {
+			spec := &obs.ScheduleFilterSpec{
+				Windows: []obs.TimeWindow{
+					{
+						Days:  []obs.Weekday{"Fri", "Sun"},
+						Start: "22:00",
+						End:   "06:00",
+					},
+				},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+_now = now()
+_day = format_timestamp!(_now, format: "%a", timezone: "UTC")
+_time = to_int!(format_timestamp!(_now, format: "%H%M", timezone: "UTC"))
+(includes(["Fri","Sun"], _day) && _time >= 2200) || (includes(["Sat","Mon"], _day) && _time < 600)
+`))
+		})
+		It("should default the time zone to UTC", func() {
+			spec := &obs.ScheduleFilterSpec{
+				Windows: []obs.TimeWindow{
+					{
+						Start: "00:00",
+						End:   "08:00",
+					},
+				},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+_now = now()
+_day = format_timestamp!(_now, format: "%a", timezone: "UTC")
+_time = to_int!(format_timestamp!(_now, format: "%H%M", timezone: "UTC"))
+(_time >= 0 && _time < 800)
+`))
+		})
+	})
+
+})
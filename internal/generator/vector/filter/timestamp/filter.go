@@ -0,0 +1,50 @@
+package timestamp
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+const (
+	defaultField    = obs.FieldPath(".message")
+	defaultTimeZone = "UTC"
+)
+
+type Filter struct {
+	spec obs.TimestampFilterSpec
+}
+
+// NewFilter returns a timestamp filter
+func NewFilter(spec *obs.TimestampFilterSpec) *Filter {
+	return &Filter{*spec}
+}
+
+func (f *Filter) VRL() (string, error) {
+	field := f.spec.Field
+	if field == "" {
+		field = defaultField
+	}
+	timeZone := f.spec.TimeZone
+	if timeZone == "" {
+		timeZone = defaultTimeZone
+	}
+	vrl := []string{fmt.Sprintf("_tz = %q", timeZone)}
+	for _, ns := range f.spec.NamespaceTimeZones {
+		vrl = append(vrl, fmt.Sprintf(`if .kubernetes.namespace_name == %q { _tz = %q }`, ns.Namespace, ns.TimeZone))
+	}
+	vrl = append(vrl, fmt.Sprintf("_value = to_string(%s) ?? null", field))
+	if f.spec.Pattern != "" {
+		vrl = append(vrl, fmt.Sprintf(`if _value != null { _value = parse_regex(string!(_value), r'%s').timestamp ?? null }`, f.spec.Pattern))
+	}
+	parsers := []string{}
+	for _, format := range f.spec.Formats {
+		parsers = append(parsers, fmt.Sprintf("parse_timestamp(string!(_value), %q, timezone: _tz)", format))
+	}
+	vrl = append(vrl, fmt.Sprintf(`if _value != null {
+  _ts = %s ?? null
+  if _ts != null { ."@timestamp" = _ts }
+}`, strings.Join(parsers, " ?? ")))
+	return strings.Join(vrl, "\n"), nil
+}
@@ -0,0 +1,49 @@
+package timestamp
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("timestamp filter", func() {
+
+	Context("#VRL", func() {
+		It("should generate VRL which parses the message in UTC when not configured", func() {
+			spec := &obs.TimestampFilterSpec{
+				Formats: []string{"%Y-%m-%d %H:%M:%S"},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+_tz = "UTC"
+_value = to_string(.message) ?? null
+if _value != null {
+  _ts = parse_timestamp(string!(_value), "%Y-%m-%d %H:%M:%S", timezone: _tz) ?? null
+  if _ts != null { ."@timestamp" = _ts }
+}
+`))
+		})
+		It("should generate VRL which extracts the timestamp and tries each format with the time zone of the namespace", func() {
+			spec := &obs.TimestampFilterSpec{
+				Field:    ".structured.log",
+				Pattern:  `^\[(?P<timestamp>[^\]]+)\]`,
+				Formats:  []string{"%d/%b/%Y:%H:%M:%S %z", "%Y-%m-%d %H:%M:%S"},
+				TimeZone: "America/New_York",
+				NamespaceTimeZones: []obs.NamespaceTimeZone{
+					{Namespace: "legacy", TimeZone: "Europe/Berlin"},
+				},
+			}
+			Expect(NewFilter(spec).VRL()).To(matchers.EqualTrimLines(`
+_tz = "America/New_York"
+if .kubernetes.namespace_name == "legacy" { _tz = "Europe/Berlin" }
+_value = to_string(.structured.log) ?? null
+if _value != null { _value = parse_regex(string!(_value), r'^\[(?P<timestamp>[^\]]+)\]').timestamp ?? null }
+if _value != null {
+  _ts = parse_timestamp(string!(_value), "%d/%b/%Y:%H:%M:%S %z", timezone: _tz) ?? parse_timestamp(string!(_value), "%Y-%m-%d %H:%M:%S", timezone: _tz) ?? null
+  if _ts != null { ."@timestamp" = _ts }
+}
+`))
+		})
+	})
+
+})
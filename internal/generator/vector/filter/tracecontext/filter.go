@@ -0,0 +1,29 @@
+package tracecontext
+
+// VRL extracts the trace context of a record into the `trace_id`, `span_id` and `trace_flags` fields.
+// The W3C traceparent of the structured record or the message is preferred over the common trace and span ID fields
+// of the structured record, which are preferred over `trace_id=` and `span_id=` like values of the message.
+const VRL = `if .trace_id == null {
+  _msg = string(.message) ?? ""
+  _tp = parse_regex(string(.structured.traceparent) ?? _msg, r'(?i)\b00-(?P<trace_id>[0-9a-f]{32})-(?P<span_id>[0-9a-f]{16})-(?P<trace_flags>[0-9a-f]{2})\b') ?? {}
+  _trace_id = _tp.trace_id || .structured.trace_id || .structured.traceId || .structured.traceID
+  if _trace_id == null { _trace_id = parse_regex(_msg, r'(?i)\btrace[_-]?id\W{1,4}(?P<id>[0-9a-f]{16,32})\b').id ?? null }
+  _span_id = _tp.span_id || .structured.span_id || .structured.spanId || .structured.spanID
+  if _span_id == null { _span_id = parse_regex(_msg, r'(?i)\bspan[_-]?id\W{1,4}(?P<id>[0-9a-f]{16})\b').id ?? null }
+  if _trace_id != null {
+    .trace_id = downcase(to_string(_trace_id) ?? "")
+    if _span_id != null { .span_id = downcase(to_string(_span_id) ?? "") }
+    if _tp.trace_flags != null { .trace_flags = _tp.trace_flags }
+  }
+}`
+
+type Filter struct{}
+
+// NewFilter returns a trace context filter
+func NewFilter() *Filter {
+	return &Filter{}
+}
+
+func (f *Filter) VRL() (string, error) {
+	return VRL, nil
+}
@@ -0,0 +1,30 @@
+package tracecontext
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("trace context filter", func() {
+
+	Context("#VRL", func() {
+		var vrl string
+		BeforeEach(func() {
+			var err error
+			vrl, err = NewFilter().VRL()
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("should not replace the trace context of records which already have one", func() {
+			Expect(vrl).To(HavePrefix(`if .trace_id == null {`))
+		})
+		It("should prefer the W3C traceparent of the structured record or the message", func() {
+			Expect(vrl).To(ContainSubstring(`_tp = parse_regex(string(.structured.traceparent) ?? _msg, r'(?i)\b00-(?P<trace_id>[0-9a-f]{32})-(?P<span_id>[0-9a-f]{16})-(?P<trace_flags>[0-9a-f]{2})\b') ?? {}`))
+			Expect(vrl).To(ContainSubstring(`_trace_id = _tp.trace_id || .structured.trace_id || .structured.traceId || .structured.traceID`))
+		})
+		It("should fall back to the trace and span IDs of the message", func() {
+			Expect(vrl).To(ContainSubstring(`if _trace_id == null { _trace_id = parse_regex(_msg, r'(?i)\btrace[_-]?id\W{1,4}(?P<id>[0-9a-f]{16,32})\b').id ?? null }`))
+			Expect(vrl).To(ContainSubstring(`if _span_id == null { _span_id = parse_regex(_msg, r'(?i)\bspan[_-]?id\W{1,4}(?P<id>[0-9a-f]{16})\b').id ?? null }`))
+		})
+	})
+
+})
@@ -0,0 +1,23 @@
+package vrl
+
+import (
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+type Filter struct {
+	spec obs.VRLFilterSpec
+}
+
+// NewFilter returns a vrl filter
+func NewFilter(spec *obs.VRLFilterSpec) *Filter {
+	return &Filter{*spec}
+}
+
+func (f *Filter) VRL() (string, error) {
+	if err := Validate(f.spec.Source); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(f.spec.Source), nil
+}
@@ -0,0 +1,45 @@
+package vrl
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("vrl filter", func() {
+
+	It("should generate the source of the filter", func() {
+		spec := &obs.VRLFilterSpec{Source: `
+.structured = parse_json(.message) ?? {}
+if .structured.level == "debug" { abort }
+`}
+		Expect(NewFilter(spec).VRL()).To(Equal(".structured = parse_json(.message) ?? {}\nif .structured.level == \"debug\" { abort }"))
+	})
+
+	DescribeTable("#Validate valid sources", func(source string) {
+		Expect(Validate(source)).To(Succeed())
+	},
+		Entry("with a string containing delimiters", `.message = "a ) b # c"`),
+		Entry("with a comment containing delimiters", "# don't ) \n.a = 1"),
+		Entry("with escaped quotes", `.a = "say \"hi\""`),
+		Entry("with raw and regex strings", `.a = match(string!(.b), r'^\d+$') || s'it\'s' == .c`),
+		Entry("with a timestamp literal", `.a = t'2021-02-11T10:32:50.553955473Z'`),
+		Entry("with nested blocks", `if exists(.a) { .b = {"c": [1, 2]} } else { .b = null }`),
+	)
+
+	DescribeTable("#Validate invalid sources", func(source, exp string) {
+		Expect(Validate(source)).To(MatchError(MatchRegexp(exp)))
+	},
+		Entry("when empty", " \n", `must not be empty`),
+		Entry("when too long", strings.Repeat("#", MaxLength+1), `must not be longer than 4096`),
+		Entry("with an unterminated string", ".a = 1\n.b = \"c", `line 2: unterminated string`),
+		Entry("with an unclosed block", `if true { .a = 1`, `unclosed '{'`),
+		Entry("with an unexpected delimiter", ".a = (1]", `line 1: unexpected ']'`),
+		Entry("with a single quoted string", `.a = 'b'`, `unexpected "'"`),
+		Entry("with a quote after an identifier", `.a = bar'b'`, `unexpected "'"`),
+		Entry("with triple quotes", `.a = r'''`, `must not contain`),
+	)
+})
@@ -0,0 +1,85 @@
+package vrl
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxLength is the maximum length of the source of a vrl filter
+const MaxLength = 4096
+
+var closing = map[rune]rune{'(': ')', '[': ']', '{': '}'}
+
+// Validate checks the source of a vrl filter for the syntax errors which can be detected without compiling the program:
+// unterminated strings, unbalanced delimiters and sequences which would break the configuration of the collector.
+// Type errors, e.g. unhandled fallible functions, are only detected by the collector
+func Validate(source string) error {
+	if strings.TrimSpace(source) == "" {
+		return fmt.Errorf("source must not be empty")
+	}
+	if len(source) > MaxLength {
+		return fmt.Errorf("source must not be longer than %d characters", MaxLength)
+	}
+	if strings.Contains(source, "'''") {
+		return fmt.Errorf("source must not contain \"'''\"")
+	}
+	chars := []rune(source)
+	stack := []rune{}
+	line := 1
+	for i := 0; i < len(chars); i++ {
+		c := chars[i]
+		switch {
+		case c == '\n':
+			line++
+		case c == '#':
+			for i+1 < len(chars) && chars[i+1] != '\n' {
+				i++
+			}
+		case c == '"':
+			end, err := skipString(chars, i, '"', line)
+			if err != nil {
+				return err
+			}
+			line += strings.Count(string(chars[i:end]), "\n")
+			i = end
+		case c == '\'':
+			if i == 0 || !strings.ContainsRune("rst", chars[i-1]) || (i > 1 && isIdentifier(chars[i-2])) {
+				return fmt.Errorf("line %d: unexpected \"'\", only raw (r''), regex (s'') and timestamp (t'') literals are quoted with single quotes", line)
+			}
+			end, err := skipString(chars, i, '\'', line)
+			if err != nil {
+				return err
+			}
+			line += strings.Count(string(chars[i:end]), "\n")
+			i = end
+		case closing[c] != 0:
+			stack = append(stack, c)
+		case c == ')' || c == ']' || c == '}':
+			if len(stack) == 0 || closing[stack[len(stack)-1]] != c {
+				return fmt.Errorf("line %d: unexpected %q", line, c)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if len(stack) > 0 {
+		return fmt.Errorf("unclosed %q", stack[len(stack)-1])
+	}
+	return nil
+}
+
+// skipString returns the index of the quote terminating the string starting at the given index
+func skipString(chars []rune, start int, quote rune, line int) (int, error) {
+	for i := start + 1; i < len(chars); i++ {
+		switch chars[i] {
+		case '\\':
+			i++
+		case quote:
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("line %d: unterminated string", line)
+}
+
+func isIdentifier(c rune) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
@@ -2,6 +2,7 @@ package input
 
 import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
 	generator "github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
@@ -11,45 +12,59 @@ import (
 
 func NewViaqReceiverSource(spec obs.InputSpec, resNames factory.ForwarderResourceNames, secrets helpers.Secrets, op generator.Options) ([]generator.Element, []string) {
 	base := helpers.MakeInputID(spec.Name)
-	tlsConfig := receiverTLS(base, spec.Receiver.TLS, secrets, op)
+	tlsConfig := receiverTLS(base, *spec.Receiver, secrets, op)
 
 	var els []generator.Element
 	metaID := helpers.MakeID(base, "meta")
 
 	switch spec.Receiver.Type {
 	case obs.ReceiverTypeSyslog:
+		format, formatID := source.NewReceiverFormatTransform(base, base, spec.Receiver)
 		els = append(els,
 			source.NewSyslogSource(base, resNames.GenerateInputServiceName(spec.Name), spec),
 			tlsConfig,
-			NewLogSourceAndType(metaID, obs.InfrastructureSourceNode, obs.InputTypeInfrastructure, base),
+			format,
+			NewLogSourceAndType(metaID, obs.InfrastructureSourceNode, obs.InputTypeInfrastructure, formatID),
 		)
 	case obs.ReceiverTypeHTTP:
 		el, id := source.NewHttpSource(base, resNames.GenerateInputServiceName(spec.Name), spec)
-		split, splitID := source.NewSplitTransform(base, id)
-		items, itemsID := source.NewItemsTransform(base, splitID)
-		els = append(els,
-			el,
-			tlsConfig,
-			split,
-			items,
-			NewLogSourceAndType(metaID, obs.AuditSourceKube, obs.InputTypeAudit, itemsID),
-		)
+		allowed, allowedID := source.NewAllowedCIDRsTransform(base, id, spec.Receiver)
+		if spec.Receiver.HTTP.Format == obs.HTTPReceiverFormatKubeAPIAudit {
+			split, splitID := source.NewSplitTransform(base, allowedID)
+			items, itemsID := source.NewItemsTransform(base, splitID)
+			els = append(els,
+				el,
+				tlsConfig,
+				allowed,
+				split,
+				items,
+				NewLogSourceAndType(metaID, obs.AuditSourceKube, obs.InputTypeAudit, itemsID),
+			)
+		} else {
+			format, formatID := source.NewReceiverFormatTransform(base, allowedID, spec.Receiver)
+			els = append(els,
+				el,
+				tlsConfig,
+				allowed,
+				format,
+				NewLogSourceAndType(metaID, obs.InfrastructureSourceNode, obs.InputTypeInfrastructure, formatID),
+			)
+		}
 	}
 	return els, []string{metaID}
 }
 
-func receiverTLS(id string, spec *obs.InputTLSSpec, secrets helpers.Secrets, op generator.Options) generator.Element {
-	if spec == nil {
+func receiverTLS(id string, spec obs.ReceiverSpec, secrets helpers.Secrets, op generator.Options) generator.Element {
+	if spec.TLS == nil {
 		return generator.Nil
 	}
 	tlsSpec := &obs.OutputTLSSpec{
-		TLSSpec: obs.TLSSpec{
-			CA:            spec.CA,
-			Certificate:   spec.Certificate,
-			Key:           spec.Key,
-			KeyPassphrase: spec.KeyPassphrase,
-		},
+		TLSSpec: internalobs.ReceiverTLS(spec),
 	}
 	template := tls.New(id, tlsSpec, secrets, op, generator.Option{Name: tls.Component, Value: "sources"}, generator.Option{Name: tls.IncludeEnabled, Value: ""})
+	if conf, ok := template.(tls.TLSConf); ok && spec.ClientAuth != nil && spec.ClientAuth.CA != nil {
+		conf.VerifyCertificate = true
+		return conf
+	}
 	return template
 }
@@ -9,9 +9,11 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/source"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/set"
+	"path"
 	"regexp"
 )
 
@@ -22,20 +24,22 @@ const (
 
 var (
 	//// TODO: Remove ES/Kibana from excludes
-	loggingExcludes = source.NewContainerPathGlobBuilder().
-			AddOther(
-			fmt.Sprintf(nsPodPathFmt, constants.OpenshiftNS, constants.LogfilesmetricexporterName),
-			fmt.Sprintf(nsPodPathFmt, constants.OpenshiftNS, constants.ElasticsearchName),
-			fmt.Sprintf(nsPodPathFmt, constants.OpenshiftNS, constants.KibanaName),
-		).AddOther(
+	loggingStackPods = []string{
+		fmt.Sprintf(nsPodPathFmt, constants.OpenshiftNS, constants.LogfilesmetricexporterName),
+		fmt.Sprintf(nsPodPathFmt, constants.OpenshiftNS, constants.ElasticsearchName),
+		fmt.Sprintf(nsPodPathFmt, constants.OpenshiftNS, constants.KibanaName),
 		fmt.Sprintf(nsContainerPathFmt, constants.OpenshiftNS, "loki*"),
 		fmt.Sprintf(nsContainerPathFmt, constants.OpenshiftNS, "gateway"),
 		fmt.Sprintf(nsContainerPathFmt, constants.OpenshiftNS, "opa"),
-	).AddExtensions(excludeExtensions...).
-		Build()
+	}
+	loggingExcludes = source.NewContainerPathGlobBuilder().
+			AddOther(loggingStackPods...).
+			AddExtensions(excludeExtensions...).
+			Build()
 	excludeExtensions = []string{"gz", "tmp", "log.*"}
-	infraNamespaces   = []string{"default", "openshift*", "kube*"}
-	infraNSRegex      = regexp.MustCompile(`^(?P<default>default)|(?P<openshift>openshift.*)|(?P<kube>kube.*)$`)
+	// previousLogsExcludeExtensions collects the uncompressed rotated files which may hold the final lines of a container
+	previousLogsExcludeExtensions = []string{"gz", "tmp"}
+	infraNSRegex                  = regexp.MustCompile(`^(?P<default>default)|(?P<openshift>openshift.*)|(?P<kube>kube.*)$`)
 )
 
 // NewSource creates an input adapter to generate config for ViaQ sources to collect logs excluding the
@@ -43,6 +47,7 @@ var (
 func NewSource(input obs.InputSpec, collectorNS string, resNames factory.ForwarderResourceNames, secrets helpers.Secrets, op framework.Options) ([]framework.Element, []string) {
 	els := []framework.Element{}
 	ids := []string{}
+	extraInfraNamespaces, _ := utils.GetOption(op, framework.OptionInfrastructureNamespaces, []string{})
 	switch input.Type {
 	case obs.InputTypeApplication:
 		ib := source.NewContainerPathGlobBuilder()
@@ -60,7 +65,7 @@ func NewSource(input obs.InputSpec, collectorNS string, resNames factory.Forward
 				}
 			}
 			// Need to remove any of the default excluded infra namespaces if they are part of the includes
-			excludesList := pruneInfraNS(appIncludes)
+			excludesList := append(pruneInfraNS(appIncludes), pruneIncludedNS(extraInfraNamespaces, appIncludes)...)
 			for _, ns := range excludesList {
 				ncs := source.NamespaceContainer{
 					Namespace: ns,
@@ -78,7 +83,7 @@ func NewSource(input obs.InputSpec, collectorNS string, resNames factory.Forward
 			}
 		} else {
 			// Need to remove any of the default excluded infra namespaces if they are part of the includes
-			excludesList := pruneInfraNS(appIncludes)
+			excludesList := append(pruneInfraNS(appIncludes), pruneIncludedNS(extraInfraNamespaces, appIncludes)...)
 			for _, ns := range excludesList {
 				ncs := source.NamespaceContainer{
 					Namespace: ns,
@@ -86,9 +91,13 @@ func NewSource(input obs.InputSpec, collectorNS string, resNames factory.Forward
 				eb.AddCombined(ncs)
 			}
 		}
-		eb.AddExtensions(excludeExtensions...)
+		if capturePreviousLogs(input) {
+			eb.AddExtensions(previousLogsExcludeExtensions...)
+		} else {
+			eb.AddExtensions(excludeExtensions...)
+		}
 		includes := ib.Build()
-		excludes := eb.Build(infraNamespaces...)
+		excludes := eb.Build(internalobs.InfrastructureNamespaces...)
 		return NewContainerSource(input, collectorNS, includes, excludes, obs.InputTypeApplication, obs.InfrastructureSourceContainer)
 	case obs.InputTypeInfrastructure:
 		sources := set.Set[obs.InfrastructureSource]{}
@@ -101,11 +110,21 @@ func NewSource(input obs.InputSpec, collectorNS string, resNames factory.Forward
 			}
 		}
 		if sources.Has(obs.InfrastructureSourceContainer) {
-			infraIncludes := source.NewContainerPathGlobBuilder().AddNamespaces(infraNamespaces...).Build()
-			cels, cids := NewContainerSource(input, collectorNS, infraIncludes, loggingExcludes, obs.InputTypeInfrastructure, obs.InfrastructureSourceContainer)
+			infraIncludes := source.NewContainerPathGlobBuilder().AddNamespaces(internalobs.InfrastructureNamespaces...).AddNamespaces(extraInfraNamespaces...).Build()
+			infraExcludes := loggingExcludes
+			if sources.Has(obs.InfrastructureSourceLogging) {
+				// logs of the logging stack are only collected by the logging source
+				infraExcludes = source.NewContainerPathGlobBuilder().AddOther(loggingStackPaths(collectorNS)...).AddExtensions(excludeExtensions...).Build()
+			}
+			cels, cids := NewContainerSource(input, collectorNS, infraIncludes, infraExcludes, obs.InputTypeInfrastructure, obs.InfrastructureSourceContainer)
 			els = append(els, cels...)
 			ids = append(ids, cids...)
 		}
+		if sources.Has(obs.InfrastructureSourceLogging) {
+			lels, lids := NewLoggingSource(input, collectorNS)
+			els = append(els, lels...)
+			ids = append(ids, lids...)
+		}
 		if sources.Has(obs.InfrastructureSourceNode) {
 			jels, jids := NewJournalSource(input)
 			els = append(els, jels...)
@@ -151,20 +170,58 @@ func NewSource(input obs.InputSpec, collectorNS string, resNames factory.Forward
 
 // NewContainerSource generates config elements and the id reference of this input and normalizes
 func NewContainerSource(spec obs.InputSpec, namespace, includes, excludes string, logType obs.InputType, logSource interface{}) ([]framework.Element, []string) {
-	base := helpers.MakeInputID(spec.Name, "container")
+	return newKubernetesLogsSource(spec, helpers.MakeInputID(spec.Name, "container"), "Logs from containers (including openshift containers)", includes, excludes, logType, logSource)
+}
+
+// NewLoggingSource generates config elements and the id reference of the container logs of the logging stack
+// which are normalized as container logs
+func NewLoggingSource(spec obs.InputSpec, collectorNS string) ([]framework.Element, []string) {
+	includes := source.NewContainerPathGlobBuilder().AddOther(loggingStackPaths(collectorNS)...).Build()
+	excludes := source.NewContainerPathGlobBuilder().AddExtensions(excludeExtensions...).Build()
+	return newKubernetesLogsSource(spec, helpers.MakeInputID(spec.Name, "logging"), "Logs from containers of the logging stack", includes, excludes, obs.InputTypeInfrastructure, obs.InfrastructureSourceContainer)
+}
+
+// loggingStackPaths returns the paths of the container logs of the logging stack including the collectors
+// deployed to the given namespace and the operator
+func loggingStackPaths(collectorNS string) []string {
+	return append([]string{
+		fmt.Sprintf(nsContainerPathFmt, collectorNS, constants.CollectorName),
+		fmt.Sprintf(nsPodPathFmt, constants.OpenshiftNS, constants.ClusterLoggingOperator),
+	}, loggingStackPods...)
+}
+
+func newKubernetesLogsSource(spec obs.InputSpec, base, desc, includes, excludes string, logType obs.InputType, logSource interface{}) ([]framework.Element, []string) {
 	var selector *metav1.LabelSelector
 	if spec.Application != nil {
 		selector = spec.Application.Selector
 	}
+	k8sLogs := source.KubernetesLogs{
+		ComponentID:        base,
+		Desc:               desc,
+		IncludePaths:       includes,
+		ExcludePaths:       excludes,
+		ExtraLabelSelector: source.LabelSelectorFrom(selector),
+	}
+	if spec.Application != nil && spec.Application.Tuning != nil && spec.Application.Tuning.PartialLines != nil {
+		partialLines := spec.Application.Tuning.PartialLines
+		k8sLogs.DisablePartialMerge = partialLines.DisableMerge
+		if partialLines.MaxMergedSize != nil {
+			k8sLogs.MaxMergedLineBytes = partialLines.MaxMergedSize.Value()
+		}
+	}
+	k8sLogs.CapturePreviousLogs = capturePreviousLogs(spec)
+	if spec.Application != nil && spec.Application.Tuning != nil {
+		tuning := spec.Application.Tuning
+		if tuning.MaxReadBytes != nil {
+			k8sLogs.MaxReadBytes = tuning.MaxReadBytes.Value()
+		}
+		if tuning.RotateWait != nil {
+			k8sLogs.RotateWaitSecs = int64(*tuning.RotateWait)
+		}
+	}
 	metaID := helpers.MakeID(base, "meta")
 	el := []framework.Element{
-		source.KubernetesLogs{
-			ComponentID:        base,
-			Desc:               "Logs from containers (including openshift containers)",
-			IncludePaths:       includes,
-			ExcludePaths:       excludes,
-			ExtraLabelSelector: source.LabelSelectorFrom(selector),
-		},
+		k8sLogs,
 		NewLogSourceAndType(metaID, logSource, logType, base),
 	}
 	inputID := metaID
@@ -178,6 +235,25 @@ func NewContainerSource(spec obs.InputSpec, namespace, includes, excludes string
 	return el, []string{inputID}
 }
 
+// ContainerSourceIDs returns the IDs of the sources which collect container logs for the given inputs
+func ContainerSourceIDs(inputs []obs.InputSpec) []string {
+	ids := []string{}
+	for _, input := range inputs {
+		switch input.Type {
+		case obs.InputTypeApplication:
+			ids = append(ids, helpers.MakeInputID(input.Name, "container"))
+		case obs.InputTypeInfrastructure:
+			if input.Infrastructure == nil || len(input.Infrastructure.Sources) == 0 || set.New(input.Infrastructure.Sources...).Has(obs.InfrastructureSourceContainer) {
+				ids = append(ids, helpers.MakeInputID(input.Name, "container"))
+			}
+			if input.Infrastructure != nil && set.New(input.Infrastructure.Sources...).Has(obs.InfrastructureSourceLogging) {
+				ids = append(ids, helpers.MakeInputID(input.Name, "logging"))
+			}
+		}
+	}
+	return ids
+}
+
 // pruneInfraNS returns a pruned infra namespace list depending on which infra namespaces were included
 // since the exclusion list includes all infra namespaces by default
 // Example:
@@ -197,7 +273,7 @@ func pruneInfraNS(includes []string) []string {
 		}
 	}
 
-	infraNSSet := sets.NewString(infraNamespaces...)
+	infraNSSet := sets.NewString(internalobs.InfrastructureNamespaces...)
 	// Remove infra namespace depending on the named capture group
 	for k := range foundInfraNamespaces {
 		switch k {
@@ -211,3 +287,30 @@ func pruneInfraNS(includes []string) []string {
 	}
 	return infraNSSet.List()
 }
+
+// pruneIncludedNS returns the namespace globs which do not match any of the explicitly included namespaces
+// Example:
+// Include: ["platform-monitoring"]
+// Namespaces: ["platform-*", "vendor-*"]
+// Final namespaces: ["vendor-*"]
+func pruneIncludedNS(namespaces, includes []string) []string {
+	pruned := []string{}
+	for _, ns := range namespaces {
+		included := false
+		for _, in := range includes {
+			if matched, _ := path.Match(ns, in); matched || ns == in {
+				included = true
+				break
+			}
+		}
+		if !included {
+			pruned = append(pruned, ns)
+		}
+	}
+	return pruned
+}
+
+// capturePreviousLogs evaluates if the input collects the final lines of terminated containers
+func capturePreviousLogs(spec obs.InputSpec) bool {
+	return spec.Application != nil && spec.Application.Tuning != nil && spec.Application.Tuning.CapturePreviousLogs
+}
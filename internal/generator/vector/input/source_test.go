@@ -2,8 +2,11 @@ package input
 
 import (
 	"fmt"
+	"time"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/onsi/ginkgo"
@@ -12,6 +15,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
 )
 
@@ -74,6 +78,43 @@ var _ = Describe("inputs", func() {
 		},
 			"application_with_throttle.toml",
 		),
+		Entry("with an application input with partial lines tuning should generate a container source without merging", obs.InputSpec{
+			Name: string(obs.InputTypeApplication),
+			Type: obs.InputTypeApplication,
+			Application: &obs.Application{
+				Tuning: &obs.ContainerInputTuningSpec{
+					PartialLines: &obs.PartialLinesSpec{
+						DisableMerge:  true,
+						MaxMergedSize: utils.GetPtr(resource.MustParse("1Mi")),
+					},
+				},
+			},
+		},
+			"application_with_partial_lines.toml",
+		),
+		Entry("with an application input capturing previous logs should generate a container source collecting rotated files", obs.InputSpec{
+			Name: string(obs.InputTypeApplication),
+			Type: obs.InputTypeApplication,
+			Application: &obs.Application{
+				Tuning: &obs.ContainerInputTuningSpec{
+					CapturePreviousLogs: true,
+				},
+			},
+		},
+			"application_with_previous_logs.toml",
+		),
+		Entry("with an application input with rotation tuning should generate a container source with read and rotation settings", obs.InputSpec{
+			Name: string(obs.InputTypeApplication),
+			Type: obs.InputTypeApplication,
+			Application: &obs.Application{
+				Tuning: &obs.ContainerInputTuningSpec{
+					MaxReadBytes: utils.GetPtr(resource.MustParse("8Mi")),
+					RotateWait:   utils.GetPtr(time.Duration(30)),
+				},
+			},
+		},
+			"application_with_rotation_tuning.toml",
+		),
 		Entry("with an application that specs including a container from all namespaces", obs.InputSpec{
 			Name: "my-app",
 			Type: obs.InputTypeApplication,
@@ -271,6 +312,24 @@ var _ = Describe("inputs", func() {
 		},
 			"infrastructure_journal.toml",
 		),
+		Entry("with an infrastructure input for logging should generate only a logging stack container source", obs.InputSpec{
+			Name: "myinfra",
+			Type: obs.InputTypeInfrastructure,
+			Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceLogging},
+			},
+		},
+			"infrastructure_logging.toml",
+		),
+		Entry("with an infrastructure input for containers and logging should exclude the logging stack from the container source", obs.InputSpec{
+			Name: "myinfra",
+			Type: obs.InputTypeInfrastructure,
+			Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceContainer, obs.InfrastructureSourceLogging},
+			},
+		},
+			"infrastructure_container_logging.toml",
+		),
 		Entry("with an audit input should generate file sources", obs.InputSpec{
 			Name:  string(obs.InputTypeAudit),
 			Type:  obs.InputTypeAudit,
@@ -395,5 +454,217 @@ var _ = Describe("inputs", func() {
 		},
 			"receiver_syslog_tls_from_configmap.toml",
 		),
+		Entry("with a syslog receiver with client authentication", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeSyslog,
+				Port: 12345,
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+				ClientAuth: &obs.ReceiverClientAuthSpec{
+					CA: &obs.ValueReference{
+						Key:           "ca.crt",
+						ConfigMapName: "client-ca",
+					},
+					AllowedCIDRs: []string{"10.0.0.0/16", "192.168.1.0/24"},
+				},
+			},
+		},
+			"receiver_syslog_client_auth.toml",
+		),
+		Entry("with an http receiver with allowed CIDRs", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatKubeAPIAudit,
+				},
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+				ClientAuth: &obs.ReceiverClientAuthSpec{
+					AllowedCIDRs: []string{"10.0.0.0/16", "fd00::/8"},
+				},
+			},
+		},
+			"receiver_http_audit_allowed_cidrs.toml",
+		),
+		Entry("with a syslog receiver for RFC5424 records with CEF messages", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeSyslog,
+				Port: 12345,
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+				Syslog: &obs.SyslogReceiver{
+					RFC:           obs.SyslogRFC5424,
+					MessageFormat: obs.SyslogMessageFormatCEF,
+				},
+			},
+		},
+			"receiver_syslog_rfc5424_cef.toml",
+		),
+		Entry("with a syslog receiver for LEEF messages", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeSyslog,
+				Port: 12345,
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+				Syslog: &obs.SyslogReceiver{
+					MessageFormat: obs.SyslogMessageFormatLEEF,
+				},
+			},
+		},
+			"receiver_syslog_leef.toml",
+		),
+		Entry("with an http receiver for JSON records", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatJSON,
+				},
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+			},
+		},
+			"receiver_http_json.toml",
+		),
+		Entry("with an http receiver for CEF records", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatCEF,
+				},
+				TLS: &obs.InputTLSSpec{
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: secretName,
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: secretName,
+					},
+				},
+			},
+		},
+			"receiver_http_cef.toml",
+		),
+	)
+
+	DescribeTable("#NewSource with additional infrastructure namespaces", func(input obs.InputSpec, expFile string) {
+		exp, err := tomlContent.ReadFile(expFile)
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", expFile, err))
+		}
+		clf := obs.ClusterLogForwarder{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      constants.SingletonName,
+				Namespace: constants.OpenshiftNS,
+			},
+		}
+		op := framework.Options{
+			framework.OptionInfrastructureNamespaces: []string{"platform-*", "vendor-agent"},
+		}
+		conf, _ := NewSource(input, constants.OpenshiftNS, *factory.ResourceNames(clf), secrets, op)
+		Expect(string(exp)).To(EqualConfigFrom(conf))
+	},
+		Entry("with an application input should exclude the additional namespaces", obs.InputSpec{
+			Name: string(obs.InputTypeApplication),
+			Type: obs.InputTypeApplication,
+		},
+			"application_with_infra_namespaces.toml",
+		),
+		Entry("with an application input that includes an additional namespace should only exclude the others", obs.InputSpec{
+			Name: string(obs.InputTypeApplication),
+			Type: obs.InputTypeApplication,
+			Application: &obs.Application{
+				Includes: []obs.NamespaceContainerSpec{
+					{Namespace: "platform-monitoring"},
+				},
+			},
+		},
+			"application_includes_infra_namespace.toml",
+		),
+		Entry("with an infrastructure input for containers should include the additional namespaces", obs.InputSpec{
+			Name: "myinfra",
+			Type: obs.InputTypeInfrastructure,
+			Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceContainer},
+			},
+		},
+			"infrastructure_container_with_infra_namespaces.toml",
+		),
 	)
 })
+
+var _ = Describe("#ThrottleMetricsIDs", func() {
+	It("should return the IDs of the components counting the records of the throttled inputs", func() {
+		inputs := []obs.InputSpec{
+			{
+				Name: "throttled",
+				Type: obs.InputTypeApplication,
+				Application: &obs.Application{
+					Tuning: &obs.ContainerInputTuningSpec{
+						RateLimitPerContainer: &obs.LimitSpec{MaxRecordsPerSecond: 10},
+					},
+				},
+			},
+			{Name: "unthrottled", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+		}
+		Expect(ThrottleMetricsIDs(inputs)).To(Equal([]string{
+			"input_throttled_container_throttle_received",
+			"input_throttled_container_throttle_sent",
+		}))
+	})
+})
@@ -1,20 +1,87 @@
 package input
 
 import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/normalize"
 )
 
 const (
 	perContainerLimitKeyField = `"{{ file }}"`
+
+	// ThrottleReceivedMetric is the counter of the container log records received by a throttle for each namespace
+	ThrottleReceivedMetric = "throttle_received_events_total"
+	// ThrottleSentMetric is the counter of the container log records passed by a throttle for each namespace
+	ThrottleSentMetric = "throttle_sent_events_total"
 )
 
+// ThrottleLogToMetric counts the container log records of each namespace
+type ThrottleLogToMetric struct {
+	ID     string
+	Inputs string
+	Metric string
+}
+
+func (t ThrottleLogToMetric) Name() string {
+	return "throttleLogToMetricTemplate"
+}
+
+func (t ThrottleLogToMetric) Template() string {
+	return `{{define "` + t.Name() + `" -}}
+[transforms.{{.ID}}]
+type = "log_to_metric"
+inputs = {{.Inputs}}
+
+[[transforms.{{.ID}}.metrics]]
+type = "counter"
+field = "message"
+name = "{{.Metric}}"
+
+[transforms.{{.ID}}.metrics.tags]
+kubernetes_namespace_name = "{{"{{kubernetes.namespace_name}}"}}"
+{{end}}`
+}
+
+// AddThrottleToInput limits the rate of records collected from each container. The records received and passed by
+// the throttle are counted for each namespace, instead of the throttle counting the records it discards for each
+// container file, so the operator can attribute the discarded records to namespaces without a series for every file
 func AddThrottleToInput(id, input string, maxRecordsPerSec int64) []Element {
-	throttleKey := perContainerLimitKeyField
-	return normalize.NewThrottle(
-		id,
-		[]string{input},
-		maxRecordsPerSec,
-		throttleKey,
-	)
+	received, sent := throttleMetricsIDs(id)
+	return []Element{
+		normalize.Throttle{
+			ComponentID: id,
+			Inputs:      helpers.MakeInputs(input),
+			Threshold:   maxRecordsPerSec,
+			KeyField:    perContainerLimitKeyField,
+		},
+		ThrottleLogToMetric{
+			ID:     received,
+			Inputs: helpers.MakeInputs(input),
+			Metric: ThrottleReceivedMetric,
+		},
+		ThrottleLogToMetric{
+			ID:     sent,
+			Inputs: helpers.MakeInputs(id),
+			Metric: ThrottleSentMetric,
+		},
+	}
+}
+
+// ThrottleMetricsIDs returns the IDs of the components counting the records received and passed by the throttles
+// of the given inputs
+func ThrottleMetricsIDs(inputs []obs.InputSpec) []string {
+	ids := []string{}
+	for _, input := range inputs {
+		if _, found := internalobs.MaxRecordsPerSecond(input); found {
+			received, sent := throttleMetricsIDs(helpers.MakeID(helpers.MakeInputID(input.Name, "container"), "throttle"))
+			ids = append(ids, received, sent)
+		}
+	}
+	return ids
+}
+
+func throttleMetricsIDs(throttleID string) (string, string) {
+	return helpers.MakeID(throttleID, "received"), helpers.MakeID(throttleID, "sent")
 }
@@ -0,0 +1,24 @@
+package normalize
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+// NewMaxAge returns a filter which drops records whose timestamp is older than maxAge. The filter follows the
+// normalization of the records, which moves their timestamp to `@timestamp`.  Records without a parsable timestamp
+// are kept
+func NewMaxAge(id string, inputs []string, maxAge time.Duration) []framework.Element {
+	return []framework.Element{
+		elements.Filter{
+			Desc:        "Drop records older than the max age",
+			ComponentID: id,
+			Inputs:      helpers.MakeInputs(inputs...),
+			Condition:   fmt.Sprintf(`to_unix_timestamp(timestamp(."@timestamp") ?? parse_timestamp(string(."@timestamp") ?? "", "%%+") ?? now()) >= to_unix_timestamp(now()) - %d`, int64(maxAge.Seconds())),
+		},
+	}
+}
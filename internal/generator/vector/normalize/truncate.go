@@ -0,0 +1,39 @@
+package normalize
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	// TruncatedField is the record field which marks records whose message was truncated
+	TruncatedField = "truncated"
+
+	// backOff moves the truncation point back by one byte when it falls on a UTF-8 continuation byte (10xxxxxx)
+	backOff = `  if match(encode_base16(slice!(_message, _size, _size + 1)), r'^[89ab]') { _size = _size - 1 }
+`
+)
+
+// NewTruncate returns a remap which truncates messages longer than maxSize bytes and marks their records.  Messages are
+// truncated at the last UTF-8 character boundary within maxSize bytes
+func NewTruncate(id string, inputs []string, maxSize int64) []framework.Element {
+	return []framework.Element{
+		elements.Remap{
+			Desc:        "Truncate messages larger than the max record size",
+			ComponentID: id,
+			Inputs:      helpers.MakeInputs(inputs...),
+			VRL: fmt.Sprintf(`if is_string(.message) && length!(.message) > %[1]d {
+  _message = string!(.message)
+  _size = %[1]d
+  # back off from the continuation bytes of a multibyte UTF-8 character instead of splitting it
+%[3]s  .message = slice!(_message, 0, _size)
+  .%[2]s = true
+}`, maxSize, TruncatedField, strings.Repeat(backOff, utf8.UTFMax-1)),
+		},
+	}
+}
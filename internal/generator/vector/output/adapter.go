@@ -5,7 +5,9 @@ import (
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	generator "github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	nhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	corev1 "k8s.io/api/core/v1"
+	"slices"
 )
 
 // Output is an adapter between CLF and Config generation
@@ -15,6 +17,10 @@ type Output struct {
 	op       generator.Options
 	secrets  map[string]*corev1.Secret
 	tuning   internalobs.Tuning
+	ordered  bool
+	// queueSize is the size of the disk buffer of the output on the persistent volume of the aggregator
+	queueSize      int64
+	queueRetention obs.QueueRetentionPolicy
 }
 
 func NewOutput(spec obs.OutputSpec, secrets map[string]*corev1.Secret, op generator.Options) *Output {
@@ -30,8 +36,27 @@ func (o *Output) Elements() []generator.Element {
 	if o == nil {
 		return []generator.Element{}
 	}
-	el := New(o.spec, o.inputIDs, o.secrets, o, o.op)
-	return el
+	if o.spec.Paused {
+		return NewPaused(o.spec, o.inputIDs)
+	}
+	inputs := o.inputIDs
+	el := []generator.Element{}
+	if resumed, _ := utils.GetOption(o.op, generator.OptionResumedOutputs, []string{}); slices.Contains(resumed, o.spec.Name) {
+		var spoolID string
+		el, spoolID = NewSpoolReader(o.spec, inputs)
+		inputs = append(slices.Clone(inputs), spoolID)
+	}
+	return append(el, New(o.spec, inputs, o.secrets, o, o.op)...)
+}
+
+// QueueTo queues the records of the output in a disk buffer of the given size on the persistent volume of the
+// aggregator, applying the retention policy when the buffer is full
+func (o *Output) QueueTo(size int64, retention obs.QueueRetentionPolicy) {
+	if o == nil {
+		return
+	}
+	o.queueSize = size
+	o.queueRetention = retention
 }
 
 // AddInputFrom adds an input to an output regardless if the "input"
@@ -40,7 +65,19 @@ func (o *Output) AddInputFrom(n nhelpers.InputComponent) {
 	if o == nil {
 		return
 	}
-	o.inputIDs = append(o.inputIDs, n.InputIDs()...)
+	for _, id := range n.InputIDs() {
+		if !slices.Contains(o.inputIDs, id) {
+			o.inputIDs = append(o.inputIDs, id)
+		}
+	}
+}
+
+// PreserveOrdering configures the output to deliver records in the order they are received
+func (o *Output) PreserveOrdering() {
+	if o == nil {
+		return
+	}
+	o.ordered = true
 }
 
 func (o Output) Inputs() []string {
@@ -18,3 +18,11 @@ type SinkConfig interface {
 	// SetCompression of the sink
 	SetCompression(algo string)
 }
+
+// OrderedSinkConfig is implemented by sinks which require additional root level configuration to preserve
+// the order of records (e.g. partitioning)
+type OrderedSinkConfig interface {
+
+	// PreserveOrdering of records written by the sink
+	PreserveOrdering()
+}
@@ -8,6 +8,7 @@ import (
 
 const (
 	CodecJSON              = "json"
+	CodecText              = "text"
 	TimeStampFormatRFC3339 = "rfc3339"
 )
 
@@ -0,0 +1,39 @@
+package integrity
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	// SignatureField is the record field with the HMAC of the record
+	SignatureField = "signature"
+
+	defaultAlgorithm = obs.IntegrityAlgorithmSHA256
+)
+
+// New returns a remap which signs each record with an HMAC of the record without its signature and any
+// fields internal to the collector
+func New(id string, inputs []string, spec *obs.IntegritySpec) Element {
+	return Remap{
+		Desc:        "Sign records with an HMAC",
+		ComponentID: id,
+		Inputs:      vectorhelpers.MakeInputs(inputs...),
+		VRL:         VRL(spec),
+	}
+}
+
+// VRL returns VRL which adds the HMAC of a record as its signature
+func VRL(spec *obs.IntegritySpec) string {
+	algorithm := spec.Algorithm
+	if algorithm == "" {
+		algorithm = defaultAlgorithm
+	}
+	return fmt.Sprintf(`_payload = remove!(., ["_internal"])
+_payload = remove!(_payload, [%[1]q])
+.%[1]s = encode_base64(hmac(encode_json(_payload), %[2]q, algorithm: %[3]q))`, SignatureField, vectorhelpers.SecretFrom(spec.Key), algorithm)
+}
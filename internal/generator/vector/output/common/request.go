@@ -14,7 +14,10 @@ type Request struct {
 	RetryInitialBackoffSec helpers.OptionalPair
 	RetryMaxDurationSec    helpers.OptionalPair
 	Concurrency            helpers.OptionalPair
+	MaxConcurrencyLimit    helpers.OptionalPair
 	TimeoutSecs            helpers.OptionalPair
+	RateLimitDurationSecs  helpers.OptionalPair
+	RateLimitNum           helpers.OptionalPair
 	headers                map[string]string
 }
 
@@ -27,7 +30,10 @@ func NewRequest(id string, s ConfigStrategy) *Request {
 		RetryInitialBackoffSec: helpers.NewOptionalPair("retry_initial_backoff_secs", nil),
 		RetryMaxDurationSec:    helpers.NewOptionalPair("retry_max_duration_secs", nil),
 		Concurrency:            helpers.NewOptionalPair("concurrency", nil),
+		MaxConcurrencyLimit:    helpers.NewOptionalPair("adaptive_concurrency.max_concurrency_limit", nil),
 		TimeoutSecs:            helpers.NewOptionalPair("timeout_secs", nil),
+		RateLimitDurationSecs:  helpers.NewOptionalPair("rate_limit_duration_secs", nil),
+		RateLimitNum:           helpers.NewOptionalPair("rate_limit_num", nil),
 	}
 	if s != nil {
 		r = s.VisitRequest(r)
@@ -44,7 +50,10 @@ func (r *Request) isEmpty() bool {
 		r.RetryMaxDurationSec.String()+
 		r.RetryAttempts.String()+
 		r.Concurrency.String()+
-		r.TimeoutSecs.String() == ""
+		r.MaxConcurrencyLimit.String()+
+		r.TimeoutSecs.String()+
+		r.RateLimitDurationSecs.String()+
+		r.RateLimitNum.String() == ""
 }
 
 func (r *Request) Template() string {
@@ -57,7 +66,10 @@ func (r *Request) Template() string {
 {{ .RetryInitialBackoffSec }}
 {{ .RetryMaxDurationSec }}
 {{ .Concurrency }}
+{{ .MaxConcurrencyLimit }}
 {{ .TimeoutSecs }}
+{{ .RateLimitDurationSecs }}
+{{ .RateLimitNum }}
 {{kv .Headers }}
 {{end}}
 `
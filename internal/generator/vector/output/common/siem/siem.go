@@ -0,0 +1,135 @@
+package siem
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
+	"github.com/openshift/cluster-logging-operator/version"
+)
+
+const (
+	DefaultVendor  = "Red Hat"
+	DefaultProduct = "OpenShift Logging"
+
+	defaultEventID = `{.log_type||"log"}`
+	defaultName    = `{.log_source||"log"}`
+
+	// leefDelimiter is the hex representation of the tab which delimits LEEF attributes
+	leefDelimiter = "x09"
+
+	// severityFromLevel maps the level of a record to a severity between 0 and 10
+	severityFromLevel = `
+level = downcase(to_string(.level) ?? "")
+severity = if includes(["emerg", "emergency", "alert", "crit", "critical"], level) {
+  "10"
+} else if includes(["err", "error"], level) {
+  "7"
+} else if includes(["warn", "warning"], level) {
+  "5"
+} else if level == "notice" {
+  "4"
+} else if includes(["debug", "trace"], level) {
+  "1"
+} else {
+  "3"
+}
+`
+)
+
+// DefaultFields are the fields of an event when a format does not define them
+func DefaultFields(formatType obs.SIEMFormatType) map[string]string {
+	host := "dvchost"
+	if formatType == obs.SIEMFormatLEEF {
+		host = "identHostName"
+	}
+	return map[string]string{
+		"msg": `{.message||""}`,
+		host:  `{.hostname||""}`,
+	}
+}
+
+// VRL returns VRL which encodes a record as an event of the format and assigns it to the target path
+func VRL(spec *obs.SIEMFormat, target string) string {
+	vrls := []string{}
+	severity := "severity"
+	if spec.Severity == "" {
+		vrls = append(vrls, strings.TrimSpace(severityFromLevel))
+	} else {
+		severity = commontemplate.TransformUserTemplateToVRL(spec.Severity)
+	}
+	fields := spec.Fields
+	if len(fields) == 0 {
+		fields = DefaultFields(spec.Type)
+	}
+	eventID := commontemplate.TransformUserTemplateToVRL(orDefault(spec.EventID, defaultEventID))
+
+	var header, delimiter string
+	var values []string
+	switch spec.Type {
+	case obs.SIEMFormatLEEF:
+		header = fmt.Sprintf("%q + %s + %q", leefHeader(spec), escapeHeader(eventID), "|"+leefDelimiter+"|")
+		delimiter = "\t"
+		values = append(values, fmt.Sprintf("%q + %s", "sev=", severity))
+		for _, key := range sortedKeys(fields) {
+			values = append(values, fmt.Sprintf("%q + %s", key+"=", escapeLEEFValue(commontemplate.TransformUserTemplateToVRL(fields[key]))))
+		}
+	default:
+		name := commontemplate.TransformUserTemplateToVRL(orDefault(spec.Name, defaultName))
+		header = fmt.Sprintf("%q + %s + \"|\" + %s + \"|\" + %s + \"|\"", cefHeader(spec), escapeHeader(eventID), escapeHeader(name), escapeHeader(severity))
+		delimiter = " "
+		for _, key := range sortedKeys(fields) {
+			values = append(values, fmt.Sprintf("%q + %s", key+"=", escapeCEFValue(commontemplate.TransformUserTemplateToVRL(fields[key]))))
+		}
+	}
+	vrls = append(vrls, fmt.Sprintf("%s = %s + %s", target, header, strings.Join(values, fmt.Sprintf(" + %q + ", delimiter))))
+	return strings.Join(vrls, "\n")
+}
+
+func cefHeader(spec *obs.SIEMFormat) string {
+	return fmt.Sprintf("CEF:0|%s|%s|%s|", escapeStaticHeader(orDefault(spec.Vendor, DefaultVendor)),
+		escapeStaticHeader(orDefault(spec.Product, DefaultProduct)), escapeStaticHeader(orDefault(spec.ProductVersion, version.Version)))
+}
+
+func leefHeader(spec *obs.SIEMFormat) string {
+	return fmt.Sprintf("LEEF:2.0|%s|%s|%s|", escapeStaticHeader(orDefault(spec.Vendor, DefaultVendor)),
+		escapeStaticHeader(orDefault(spec.Product, DefaultProduct)), escapeStaticHeader(orDefault(spec.ProductVersion, version.Version)))
+}
+
+// escapeStaticHeader escapes the backslashes and pipes of a static header field
+func escapeStaticHeader(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `|`, `\|`).Replace(value)
+}
+
+// escapeHeader escapes the backslashes and pipes of a header field
+func escapeHeader(expr string) string {
+	return fmt.Sprintf(`replace(replace(%s, "\\", "\\\\"), "|", "\\|")`, expr)
+}
+
+// escapeCEFValue escapes the backslashes, equal signs and line breaks of a CEF extension value
+func escapeCEFValue(expr string) string {
+	return fmt.Sprintf(`replace(replace(replace(replace(%s, "\\", "\\\\"), "=", "\\="), "\n", "\\n"), "\r", "\\r")`, expr)
+}
+
+// escapeLEEFValue replaces the tabs and line breaks of a LEEF attribute value which would otherwise delimit it
+func escapeLEEFValue(expr string) string {
+	return fmt.Sprintf(`replace(replace(replace(%s, "\t", " "), "\n", " "), "\r", " ")`, expr)
+}
+
+func orDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+func sortedKeys(fields map[string]string) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
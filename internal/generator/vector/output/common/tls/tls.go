@@ -24,12 +24,15 @@ type TLSConf struct {
 	Enabled            typehelpers.OptionalPair
 	NeedsEnabled       bool
 	InsecureSkipVerify bool
-	TlsMinVersion      string
-	CipherSuites       string
-	CAFilePath         string
-	CertPath           string
-	KeyPath            string
-	PassPhrase         string
+	// VerifyCertificate requires clients of a source to present a valid certificate
+	VerifyCertificate bool
+	ServerName        string
+	TlsMinVersion     string
+	CipherSuites      string
+	CAFilePath        string
+	CertPath          string
+	KeyPath           string
+	PassPhrase        string
 }
 
 func New(id string, spec *obs.OutputTLSSpec, secrets helpers.Secrets, op framework.Options, options ...framework.Option) framework.Element {
@@ -55,6 +58,7 @@ func New(id string, spec *obs.OutputTLSSpec, secrets helpers.Secrets, op framewo
 		conf.KeyPath = SecretPath(spec.Key)
 		conf.PassPhrase = secrets.AsString(spec.KeyPassphrase)
 		conf.InsecureSkipVerify = spec.InsecureSkipVerify
+		conf.ServerName = spec.ServerName
 	}
 	setTLSProfileFromOptions(&conf, op)
 	if conf.CipherSuites != "" || conf.TlsMinVersion != "" || spec != nil {
@@ -113,6 +117,12 @@ ciphersuites = "{{ .CipherSuites }}"
 verify_certificate = false
 verify_hostname = false
 {{- end }}
+{{- if .VerifyCertificate }}
+verify_certificate = true
+{{- end }}
+{{- if .ServerName }}
+server_name = "{{ .ServerName }}"
+{{- end }}
 {{- if and .KeyPath .CertPath }}
 key_file = {{ .KeyPath }}
 crt_file = {{ .CertPath }}
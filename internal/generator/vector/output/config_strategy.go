@@ -9,15 +9,32 @@ import (
 const (
 	minBufferSize   = 268435488
 	buffertTypeDisk = "disk"
+
+	// orderedConcurrency limits an output to a single in-flight request to preserve record ordering
+	orderedConcurrency = 1
+
+	// adaptiveConcurrency adapts the requests in flight to the response times and rate limiting of the output
+	adaptiveConcurrency = "adaptive"
 )
 
 func (o Output) VisitSink(s common.SinkConfig) {
 	if o.tuning.Compression != "" {
 		s.SetCompression(o.tuning.Compression)
 	}
+	if o.ordered {
+		if os, ok := s.(common.OrderedSinkConfig); ok {
+			os.PreserveOrdering()
+		}
+	}
 }
 
+// VisitAcknowledgements enables end-to-end acknowledgements for the atLeastOnce delivery mode. Sources checkpoint
+// the records they read only once the records are written to the disk buffer of the output, which is persisted
+// on the node, so a collector restarted on the node resumes delivery without losing or re-reading records
 func (o Output) VisitAcknowledgements(a common.Acknowledgments) common.Acknowledgments {
+	if o.tuning.Delivery == obs.DeliveryModeAtLeastOnce {
+		a.Enabled = true
+	}
 	return a
 }
 
@@ -39,13 +56,44 @@ func (o Output) VisitRequest(r common.Request) common.Request {
 		duration = *o.tuning.MaxRetryDuration * time.Second
 		r.RetryMaxDurationSec.Value = duration.Seconds()
 	}
+	if c := o.tuning.Concurrency; c != nil {
+		if c.Mode == obs.ConcurrencyModeFixed {
+			r.Concurrency.Value = c.Limit
+		} else {
+			r.Concurrency.Value = adaptiveConcurrency
+			if c.Limit > 0 {
+				r.MaxConcurrencyLimit.Value = c.Limit
+			}
+		}
+	}
+	if o.ordered {
+		r.Concurrency.Value = orderedConcurrency
+		r.MaxConcurrencyLimit.Value = nil
+	}
+	// the rate limit of the sink caps the replay of a backlog as well as the steady-state rate of requests
+	if o.tuning.Replay != nil && o.tuning.Replay.MaxRequestsPerSecond > 0 {
+		r.RateLimitDurationSecs.Value = 1
+		r.RateLimitNum.Value = o.tuning.Replay.MaxRequestsPerSecond
+	}
 
 	return r
 }
 
 // VisitBuffer modifies the buffer behavior depending upon the value
-// of the tuning.Delivery mode
+// of the tuning.Delivery mode, or the persistent queue of the aggregator
 func (o Output) VisitBuffer(b common.Buffer) common.Buffer {
+	if o.queueSize > 0 {
+		b.Type.Value = buffertTypeDisk
+		b.MaxSize.Value = o.queueSize
+		if o.queueSize < minBufferSize {
+			b.MaxSize.Value = minBufferSize
+		}
+		b.WhenFull.Value = common.BufferWhenFullBlock
+		if o.queueRetention == obs.QueueRetentionPolicyDropNewest {
+			b.WhenFull.Value = common.BufferWhenFullDropNewest
+		}
+		return b
+	}
 	switch o.tuning.Delivery {
 	case obs.DeliveryModeAtLeastOnce:
 		b.WhenFull.Value = common.BufferWhenFullBlock
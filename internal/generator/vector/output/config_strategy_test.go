@@ -183,6 +183,69 @@ retry_initial_backoff_secs = 25
 
 		})
 	})
+	Context("Ordering", func() {
+
+		It("should not set request.concurrency when ordering is not preserved", func() {
+			output := NewOutput(obs.OutputSpec{
+				Type:          obs.OutputTypeElasticsearch,
+				Elasticsearch: &obs.Elasticsearch{},
+			}, nil, nil)
+			Expect(``).To(EqualConfigFrom(common.NewRequest(ID, output)))
+		})
+
+		It("should limit request.concurrency to a single request when ordering is preserved", func() {
+			output := NewOutput(obs.OutputSpec{
+				Type:          obs.OutputTypeElasticsearch,
+				Elasticsearch: &obs.Elasticsearch{},
+			}, nil, nil)
+			output.PreserveOrdering()
+
+			Expect(`
+[sinks.id.request]
+concurrency = 1
+`).To(EqualConfigFrom(common.NewRequest(ID, output)))
+
+		})
+	})
+	Context("Concurrency", func() {
+
+		newOutput := func(concurrency *obs.ConcurrencySpec) *Output {
+			return NewOutput(obs.OutputSpec{
+				Type: obs.OutputTypeHTTP,
+				HTTP: &obs.HTTP{
+					Tuning: &obs.HTTPTuningSpec{
+						BaseOutputTuningSpec: obs.BaseOutputTuningSpec{
+							Concurrency: concurrency,
+						},
+					},
+				},
+			}, nil, nil)
+		}
+
+		It("should adapt request.concurrency up to the limit in the Adaptive mode", func() {
+			Expect(`
+[sinks.id.request]
+concurrency = "adaptive"
+adaptive_concurrency.max_concurrency_limit = 50
+`).To(EqualConfigFrom(common.NewRequest(ID, newOutput(&obs.ConcurrencySpec{Limit: 50}))))
+		})
+
+		It("should set request.concurrency to the limit in the Fixed mode", func() {
+			Expect(`
+[sinks.id.request]
+concurrency = 10
+`).To(EqualConfigFrom(common.NewRequest(ID, newOutput(&obs.ConcurrencySpec{Mode: obs.ConcurrencyModeFixed, Limit: 10}))))
+		})
+
+		It("should limit request.concurrency to a single request when ordering is preserved", func() {
+			output := newOutput(&obs.ConcurrencySpec{Limit: 50})
+			output.PreserveOrdering()
+			Expect(`
+[sinks.id.request]
+concurrency = 1
+`).To(EqualConfigFrom(common.NewRequest(ID, output)))
+		})
+	})
 	Context("MaxWrite", func() {
 
 		It("should rely upon the defaults and generate nothing when zero", func() {
@@ -228,8 +291,11 @@ max_bytes = 1024
 					},
 				},
 			}, nil, nil)
-			It("should do nothing to enable acknowledgments", func() {
-				Expect(``).To(EqualConfigFrom(common.NewAcknowledgments(ID, output)))
+			It("should enable acknowledgments", func() {
+				Expect(`
+[sinks.id.acknowledgements]
+enabled = true
+`).To(EqualConfigFrom(common.NewAcknowledgments(ID, output)))
 			})
 			It("should block when the buffer becomes full", func() {
 				Expect(`
@@ -262,6 +328,31 @@ max_size = 268435488
 				Expect(`
 [sinks.id.buffer]
 when_full = "drop_newest"
+`).To(EqualConfigFrom(common.NewBuffer(ID, output)))
+			})
+		})
+
+		Context("queued on the persistent volume of the aggregator", func() {
+
+			It("should queue to a disk buffer of the given size and block when full", func() {
+				output := NewOutput(obs.OutputSpec{Type: obs.OutputTypeElasticsearch, Elasticsearch: &obs.Elasticsearch{}}, nil, nil)
+				output.QueueTo(1073741824, obs.QueueRetentionPolicyBlock)
+				Expect(`
+[sinks.id.buffer]
+type = "disk"
+when_full = "block"
+max_size = 1073741824
+`).To(EqualConfigFrom(common.NewBuffer(ID, output)))
+			})
+
+			It("should drop_newest when full for the DropNewest retention policy", func() {
+				output := NewOutput(obs.OutputSpec{Type: obs.OutputTypeElasticsearch, Elasticsearch: &obs.Elasticsearch{}}, nil, nil)
+				output.QueueTo(1024, obs.QueueRetentionPolicyDropNewest)
+				Expect(`
+[sinks.id.buffer]
+type = "disk"
+when_full = "drop_newest"
+max_size = 268435488
 `).To(EqualConfigFrom(common.NewBuffer(ID, output)))
 			})
 		})
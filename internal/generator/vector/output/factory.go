@@ -1,6 +1,8 @@
 package output
 
 import (
+	"time"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
@@ -33,6 +35,16 @@ func New(o obs.OutputSpec, inputs []string, secrets map[string]*corev1.Secret, s
 		inputs = []string{throttleID}
 
 	}
+	if o.MaxRecordSize != nil && o.MaxRecordSize.Value() > 0 {
+		truncateID := helpers.MakeID(baseID, "truncate")
+		els = append(els, normalize.NewTruncate(truncateID, inputs, o.MaxRecordSize.Value())...)
+		inputs = []string{truncateID}
+	}
+	if replay := internalobs.NewTuning(o).Replay; replay != nil && replay.MaxAge != nil && *replay.MaxAge > 0 {
+		maxAgeID := helpers.MakeID(baseID, "max_age")
+		els = append(els, normalize.NewMaxAge(maxAgeID, inputs, *replay.MaxAge*time.Second)...)
+		inputs = []string{maxAgeID}
+	}
 
 	switch o.Type {
 	case obs.OutputTypeKafka:
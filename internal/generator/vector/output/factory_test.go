@@ -3,14 +3,17 @@ package output
 import (
 	"fmt"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 var _ = Describe("output/factory.go", func() {
@@ -62,5 +65,57 @@ var _ = Describe("output/factory.go", func() {
 			},
 			"factory_test_loki_with_throttle.toml",
 		),
+		Entry("should truncate messages larger than the max record size",
+			obs.OutputSpec{
+				Type: obs.OutputTypeHTTP,
+				Name: "http-receiver",
+				HTTP: &obs.HTTP{
+					URLSpec: obs.URLSpec{URL: "http://my-logstore.com"},
+				},
+				MaxRecordSize: utils.GetPtr(resource.MustParse("64Ki")),
+			},
+			nil,
+			"factory_test_http_with_max_record_size.toml",
+		),
 	)
+
+	It("should partition by container and limit in-flight requests for kafka when ordering is preserved", func() {
+		exp, err := tomlContent.ReadFile("factory_test_kafka_with_ordering.toml")
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", exp, err))
+		}
+		o := obs.OutputSpec{
+			Type: obs.OutputTypeKafka,
+			Name: "kafka-receiver",
+			Kafka: &obs.Kafka{
+				URL: "tcp://broker1-kafka.svc.messaging.cluster.local:9092/topic",
+			},
+		}
+		adapter := NewOutput(o, nil, framework.Options{})
+		adapter.PreserveOrdering()
+		Expect(string(exp)).To(EqualConfigFrom(New(o, []string{"application"}, nil, adapter, framework.Options{})))
+	})
+	It("should drop records older than the max age and limit the rate of requests when replay is bounded", func() {
+		exp, err := tomlContent.ReadFile("factory_test_http_with_replay.toml")
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", exp, err))
+		}
+		o := obs.OutputSpec{
+			Type: obs.OutputTypeHTTP,
+			Name: "http-receiver",
+			HTTP: &obs.HTTP{
+				URLSpec: obs.URLSpec{URL: "http://my-logstore.com"},
+				Tuning: &obs.HTTPTuningSpec{
+					BaseOutputTuningSpec: obs.BaseOutputTuningSpec{
+						Replay: &obs.ReplaySpec{
+							MaxRequestsPerSecond: 10,
+							MaxAge:               utils.GetPtr(time.Duration(3600)),
+						},
+					},
+				},
+			},
+		}
+		adapter := NewOutput(o, nil, framework.Options{})
+		Expect(string(exp)).To(EqualConfigFrom(New(o, []string{"application"}, nil, adapter, framework.Options{})))
+	})
 })
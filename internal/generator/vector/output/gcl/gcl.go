@@ -2,6 +2,7 @@ package gcl
 
 import (
 	"fmt"
+	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/tls"
@@ -37,6 +38,9 @@ type GoogleCloudLogging struct {
 	LogID       string
 	SeverityKey string
 
+	ResourceType   string
+	ResourceLabels []Element
+
 	CredentialsPath string
 	common.RootMixin
 }
@@ -56,8 +60,8 @@ log_id = "{{"{{"}} _internal.{{.LogID}} {{"}}"}}"
 severity_key = "{{.SeverityKey}}"
 
 [sinks.{{.ComponentID}}.resource]
-type = "k8s_node"
-node_name = "{{"{{hostname}}"}}"
+type = "{{.ResourceType}}"
+{{range .ResourceLabels}}{{kv .}}{{end -}}
 {{end}}`
 }
 
@@ -76,20 +80,36 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets helpers.Secrets,
 	}
 	componentID := vectorhelpers.MakeID(id, "log_id")
 	g := o.GoogleCloudLogging
+	els := []Element{
+		commontemplate.TemplateRemap(componentID, inputs, o.GoogleCloudLogging.LogID, componentID, "GoogleCloudLogging LogID"),
+	}
+	sinkInput := componentID
+	resourceType := obs.GoogleCloudLoggingResourceTypeNode
+	if g.Resource != nil && g.Resource.Type != "" {
+		resourceType = g.Resource.Type
+	}
+	resourceLabels := []Element{KV("node_name", `"{{hostname}}"`)}
+	if resourceType == obs.GoogleCloudLoggingResourceTypeContainer {
+		resourceID := vectorhelpers.MakeID(id, "resource")
+		els = append(els, ContainerResourceRemap(resourceID, componentID))
+		resourceLabels = ContainerResourceLabels(g, resourceID)
+		sinkInput = resourceID
+	}
 	gcl := &GoogleCloudLogging{
 		ComponentID:     id,
-		Inputs:          helpers.MakeInputs(componentID),
+		Inputs:          helpers.MakeInputs(sinkInput),
 		LogDestination:  LogDestination(g),
 		LogID:           componentID,
 		SeverityKey:     SeverityKey(g),
+		ResourceType:    string(resourceType),
+		ResourceLabels:  resourceLabels,
 		CredentialsPath: auth(g.Authentication, secrets),
 		RootMixin:       common.NewRootMixin(nil),
 	}
 	if strategy != nil {
 		strategy.VisitSink(gcl)
 	}
-	return []Element{
-		commontemplate.TemplateRemap(componentID, inputs, o.GoogleCloudLogging.LogID, componentID, "GoogleCloudLogging LogID"),
+	return append(els,
 		gcl,
 		common.NewEncoding(id, ""),
 		common.NewAcknowledgments(id, strategy),
@@ -97,7 +117,36 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets helpers.Secrets,
 		common.NewBuffer(id, strategy),
 		common.NewRequest(id, strategy),
 		tls.New(id, o.TLS, secrets, op),
+	)
+}
+
+// ContainerResourceRemap returns the transform which derives the labels of a k8s_container resource from the
+// Kubernetes metadata of the records.  Records without the metadata, e.g. node logs, are given empty labels
+func ContainerResourceRemap(id, input string) Element {
+	return Remap{
+		Desc:        "GoogleCloudLogging k8s_container resource labels",
+		ComponentID: id,
+		Inputs:      helpers.MakeInputs(input),
+		VRL: strings.TrimSpace(fmt.Sprintf(`
+._internal.%[1]s.cluster_name = to_string(.openshift.cluster_id) ?? ""
+._internal.%[1]s.namespace_name = to_string(.kubernetes.namespace_name) ?? ""
+._internal.%[1]s.pod_name = to_string(.kubernetes.pod_name) ?? ""
+._internal.%[1]s.container_name = to_string(.kubernetes.container_name) ?? ""
+`, id)),
+	}
+}
+
+// ContainerResourceLabels returns the labels of a k8s_container resource whose values are set by the given transform
+func ContainerResourceLabels(g *obs.GoogleCloudLogging, id string) []Element {
+	labels := []Element{}
+	if g.ID.Type == obs.GoogleCloudLoggingIDTypeProject {
+		labels = append(labels, KV("project_id", fmt.Sprintf("%q", g.ID.Value)))
+	}
+	labels = append(labels, KV("location", fmt.Sprintf("%q", g.Resource.Location)))
+	for _, name := range []string{"cluster_name", "namespace_name", "pod_name", "container_name"} {
+		labels = append(labels, KV(name, fmt.Sprintf(`"{{ _internal.%s.%s }}"`, id, name)))
 	}
+	return labels
 }
 
 func auth(spec *obs.GoogleCloudLoggingAuthentication, secrets helpers.Secrets) string {
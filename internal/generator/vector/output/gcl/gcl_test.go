@@ -82,5 +82,15 @@ var _ = Describe("Generate Vector config", func() {
 		Entry("with custom logId", func(spec *obs.OutputSpec) {
 			spec.GoogleCloudLogging.LogID = `my-id{.log_type||"none"}`
 		}, framework.NoOptions, "gcl_with_custom_logid.toml"),
+		Entry("with k8s_container resource", func(spec *obs.OutputSpec) {
+			spec.GoogleCloudLogging.ID = obs.GoogleCloudLoggingID{
+				Type:  obs.GoogleCloudLoggingIDTypeProject,
+				Value: "project-1",
+			}
+			spec.GoogleCloudLogging.Resource = &obs.GoogleCloudLoggingResource{
+				Type:     obs.GoogleCloudLoggingResourceTypeContainer,
+				Location: "us-central1",
+			}
+		}, framework.NoOptions, "gcl_with_container_resource.toml"),
 	)
 })
@@ -1,6 +1,9 @@
 package http
 
 import (
+	"fmt"
+	"strings"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	genhelper "github.com/openshift/cluster-logging-operator/internal/generator/helpers"
@@ -9,14 +12,23 @@ import (
 	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/auth"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/integrity"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/siem"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/tls"
 )
 
+// EnvelopeRecords is the placeholder of the records in the envelope of an http output
+const EnvelopeRecords = "{records}"
+
 type Http struct {
 	ComponentID string
 	Inputs      string
 	URI         string
 	Method      string
+
+	// PayloadPrefix and PayloadSuffix wrap the comma separated records of a request in an envelope
+	PayloadPrefix string
+	PayloadSuffix string
 	common.RootMixin
 }
 
@@ -31,6 +43,12 @@ type = "http"
 inputs = {{.Inputs}}
 uri = "{{.URI}}"
 method = "{{.Method}}"
+{{- if .PayloadPrefix}}
+payload_prefix = {{.PayloadPrefix}}
+payload_suffix = {{.PayloadSuffix}}
+framing.method = "character_delimited"
+framing.character_delimited.delimiter = ","
+{{- end}}
 {{.Compression}}
 {{end}}
 `
@@ -47,6 +65,23 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.Sec
 		}
 	}
 	var els []Element
+	codec := common.CodecJSON
+	if o.HTTP.Integrity != nil {
+		integrityID := vectorhelpers.MakeID(id, "integrity")
+		els = append(els, integrity.New(integrityID, inputs, o.HTTP.Integrity))
+		inputs = []string{integrityID}
+	}
+	if o.HTTP.Format != nil {
+		formatID := vectorhelpers.MakeID(id, "format")
+		els = append(els, Remap{
+			Desc:        "Encode records as " + string(o.HTTP.Format.Type) + " events",
+			ComponentID: formatID,
+			Inputs:      vectorhelpers.MakeInputs(inputs...),
+			VRL:         siem.VRL(o.HTTP.Format, ".message"),
+		})
+		inputs = []string{formatID}
+		codec = common.CodecText
+	}
 	sink := Output(id, o, inputs, secrets, op)
 	if strategy != nil {
 		strategy.VisitSink(sink)
@@ -56,7 +91,7 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.Sec
 		els,
 		[]Element{
 			sink,
-			common.NewEncoding(id, common.CodecJSON),
+			common.NewEncoding(id, codec),
 			common.NewAcknowledgments(id, strategy),
 			common.NewBatch(id, strategy),
 			common.NewBuffer(id, strategy),
@@ -68,13 +103,18 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.Sec
 }
 
 func Output(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.Secrets, op Options) *Http {
-	return &Http{
+	h := &Http{
 		ComponentID: id,
 		Inputs:      vectorhelpers.MakeInputs(inputs...),
 		URI:         o.HTTP.URL,
 		Method:      Method(o.HTTP),
 		RootMixin:   common.NewRootMixin(nil),
 	}
+	if prefix, suffix, found := strings.Cut(o.HTTP.Envelope, EnvelopeRecords); found {
+		h.PayloadPrefix = fmt.Sprintf("%q", prefix)
+		h.PayloadSuffix = fmt.Sprintf("%q", suffix)
+	}
+	return h
 }
 
 func Method(h *obs.HTTP) string {
@@ -122,6 +122,36 @@ var _ = Describe("Generate vector config", func() {
 					},
 				}
 			}, secrets, framework.NoOptions, "http_with_tls_using_configmaps.toml"),
+			Entry("with TLS server name", func(spec *obs.OutputSpec) {
+				spec.HTTP.Authentication = nil
+				spec.TLS = &obs.OutputTLSSpec{
+					ServerName: "logstore.example.com",
+				}
+			}, secrets, framework.NoOptions, "http_with_tls_server_name.toml"),
+			Entry("with LEEF formatted events", func(spec *obs.OutputSpec) {
+				spec.HTTP.Authentication = nil
+				spec.HTTP.Headers = nil
+				spec.HTTP.Format = &obs.SIEMFormat{
+					Type:     obs.SIEMFormatLEEF,
+					EventID:  `{.kubernetes.container_name||"none"}`,
+					Severity: "5",
+				}
+			}, secrets, framework.NoOptions, "http_with_leef_format.toml"),
+			Entry("with integrity signatures", func(spec *obs.OutputSpec) {
+				spec.HTTP.Authentication = nil
+				spec.HTTP.Headers = nil
+				spec.HTTP.Integrity = &obs.IntegritySpec{
+					Key: &obs.SecretReference{
+						Key:        "hmac-key",
+						SecretName: secretName,
+					},
+				}
+			}, secrets, framework.NoOptions, "http_with_integrity.toml"),
+			Entry("with an envelope", func(spec *obs.OutputSpec) {
+				spec.HTTP.Authentication = nil
+				spec.HTTP.Headers = nil
+				spec.HTTP.Envelope = `{"streams":[{records}]}`
+			}, secrets, framework.NoOptions, "http_with_envelope.toml"),
 		)
 	})
 
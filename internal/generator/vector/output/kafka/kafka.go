@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/integrity"
 	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/tls"
 
@@ -20,6 +21,9 @@ import (
 
 const (
 	defaultKafkaTopic = "topic"
+
+	// containerIDField is the record field used to partition records by container
+	containerIDField = "kubernetes.container_id"
 )
 
 type Kafka struct {
@@ -27,7 +31,10 @@ type Kafka struct {
 	Inputs           string
 	BootstrapServers string
 	Topic            string
+	KeyField         genhelper.OptionalPair
 	common.RootMixin
+
+	librdkafkaOptions map[string]string
 }
 
 func (k Kafka) Name() string {
@@ -42,6 +49,7 @@ inputs = {{.Inputs}}
 bootstrap_servers = {{.BootstrapServers}}
 topic = "{{"{{"}} _internal.{{.Topic}} {{"}}"}}"
 healthcheck.enabled = false
+{{.KeyField}}
 {{.Compression}}
 {{end}}
 `
@@ -51,6 +59,15 @@ func (k *Kafka) SetCompression(algo string) {
 	k.Compression.Value = algo
 }
 
+// PreserveOrdering partitions records by container and limits the producer to a single in-flight request
+// so records of a container are written to their partition in order
+func (k *Kafka) PreserveOrdering() {
+	if k.KeyField.Value == nil {
+		k.KeyField.Value = containerIDField
+	}
+	k.librdkafkaOptions[librdkafkaMaxInFlightRequests] = "1"
+}
+
 func New(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.Secrets, strategy common.ConfigStrategy, op Options) []Element {
 	if genhelper.IsDebugOutput(op) {
 		return []Element{
@@ -60,6 +77,25 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.Sec
 	componentID := vectorhelpers.MakeID(id, "topic")
 	brokers := Brokers(o)
 	sink := sink(id, o, []string{componentID}, componentID, op, brokers)
+	switch o.Kafka.TopicCreation {
+	case obs.KafkaTopicCreationAllow:
+		sink.librdkafkaOptions[librdkafkaAllowAutoCreateTopics] = "true"
+	case obs.KafkaTopicCreationDeny:
+		sink.librdkafkaOptions[librdkafkaAllowAutoCreateTopics] = "false"
+	}
+	integrityRemap := Element(Nil)
+	if o.Kafka.Integrity != nil {
+		integrityID := vectorhelpers.MakeID(id, "integrity")
+		integrityRemap = integrity.New(integrityID, inputs, o.Kafka.Integrity)
+		inputs = []string{integrityID}
+	}
+	keyRemap := Element(Nil)
+	if o.Kafka.PartitionKey != "" {
+		keyID := vectorhelpers.MakeID(id, "partition_key")
+		keyRemap = commontemplate.TemplateRemap(keyID, inputs, o.Kafka.PartitionKey, keyID, "Kafka Partition Key")
+		sink.KeyField.Value = "_internal." + keyID
+		inputs = []string{keyID}
+	}
 	if strategy != nil {
 		strategy.VisitSink(sink)
 	}
@@ -69,12 +105,12 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.Sec
 		o.TLS.InsecureSkipVerify = false
 		tlsConfig = []Element{tls.New(id, o.TLS, secrets, op, Option{Name: tls.IncludeEnabled, Value: ""})}
 		if skipVerify {
-			tlsConfig = append(tlsConfig, InsecureTLS{
-				ComponentID: id,
-			})
+			sink.librdkafkaOptions[librdkafkaSSLCertificateVerification] = "false"
 		}
 	}
 	elements := []Element{
+		integrityRemap,
+		keyRemap,
 		commontemplate.TemplateRemap(componentID, inputs, Topics(o), componentID, "Kafka Topic"),
 		sink,
 		common.NewEncoding(id, common.CodecJSON, func(e *common.Encoding) {
@@ -88,6 +124,7 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.Sec
 	elements = append(elements,
 		tlsConfig...,
 	)
+	elements = append(elements, NewLibrdkafkaOptions(id, sink.librdkafkaOptions))
 	return elements
 }
 
@@ -110,7 +147,10 @@ func sink(id string, o obs.OutputSpec, inputs []string, topic string, op Options
 		Inputs:           vectorhelpers.MakeInputs(inputs...),
 		Topic:            topic,
 		BootstrapServers: fmt.Sprintf("%q", brokers),
+		KeyField:         genhelper.NewOptionalPair("key_field", nil),
 		RootMixin:        common.NewRootMixin(nil),
+
+		librdkafkaOptions: map[string]string{},
 	}
 }
 
@@ -108,6 +108,22 @@ var _ = Describe("Generate vector config", func() {
 			spec.Kafka.URL = "tcp://broker1-kafka.svc.messaging.cluster.local:9092"
 			spec.Kafka.Topic = `foo-bar{.log_type||"none"}`
 		}),
+		Entry("with partition key template", "kafka_partition_key.toml", framework.NoOptions, nil, func(spec *obs.OutputSpec) {
+			spec.Kafka.PartitionKey = `{.kubernetes.namespace_name||"none"}-{.kubernetes.pod_uid||"none"}`
+		}),
+		Entry("with integrity signatures", "kafka_integrity.toml", framework.NoOptions, nil, func(spec *obs.OutputSpec) {
+			spec.Kafka.Integrity = &obs.IntegritySpec{
+				Algorithm: obs.IntegrityAlgorithmSHA512,
+				Key: &obs.SecretReference{
+					Key:        "hmac-key",
+					SecretName: secretName,
+				},
+			}
+		}),
+		Entry("with namespace topic template and topic creation denied", "kafka_topic_creation_deny.toml", framework.NoOptions, nil, func(spec *obs.OutputSpec) {
+			spec.Kafka.Topic = `logs.{.kubernetes.namespace_name||"unknown"}`
+			spec.Kafka.TopicCreation = obs.KafkaTopicCreationDeny
+		}),
 		Entry("with NOT tls brokers", "kafka_not_tls_brokers.toml", framework.NoOptions, nil, func(spec *obs.OutputSpec) {
 			spec.Kafka.URL = ""
 			spec.Kafka.Topic = ""
@@ -1,16 +1,41 @@
 package kafka
 
-type InsecureTLS struct {
+import (
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+)
+
+const (
+	librdkafkaSSLCertificateVerification = "enable.ssl.certificate.verification"
+	librdkafkaMaxInFlightRequests        = "max.in.flight.requests.per.connection"
+	librdkafkaAllowAutoCreateTopics      = "allow.auto.create.topics"
+)
+
+// LibrdkafkaOptions are client properties passed directly to librdkafka
+type LibrdkafkaOptions struct {
 	ComponentID string
+	Options     map[string]string
 }
 
-func (i InsecureTLS) Name() string {
-	return "kafkaInsecureTLSTemplate"
+func (l LibrdkafkaOptions) Name() string {
+	return "kafkaLibrdkafkaOptionsTemplate"
 }
 
-func (i InsecureTLS) Template() string {
-	return `{{define "` + i.Name() + `" -}}
+func (l LibrdkafkaOptions) Template() string {
+	return `{{define "` + l.Name() + `" -}}
 [sinks.{{.ComponentID}}.librdkafka_options]
-"enable.ssl.certificate.verification" = "false"
+{{- range $key, $value := .Options}}
+"{{$key}}" = "{{$value}}"
+{{- end}}
 {{- end}}`
 }
+
+// NewLibrdkafkaOptions returns the librdkafka_options section of a sink or Nil when there are no options
+func NewLibrdkafkaOptions(id string, options map[string]string) Element {
+	if len(options) == 0 {
+		return Nil
+	}
+	return LibrdkafkaOptions{
+		ComponentID: id,
+		Options:     options,
+	}
+}
@@ -0,0 +1,43 @@
+package output
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/url"
+)
+
+// MeshSecured returns the outputs with the secure URLs of the outputs sent over HTTP rewritten to plain URLs on the
+// same port, so the collector sends the logs over plain connections which are secured by the mesh.  The specs of the
+// outputs are copied rather than modified
+func MeshSecured(outputs []obs.OutputSpec) []obs.OutputSpec {
+	secured := make([]obs.OutputSpec, 0, len(outputs))
+	for _, o := range outputs {
+		switch {
+		case o.Type == obs.OutputTypeElasticsearch && o.Elasticsearch != nil:
+			es := *o.Elasticsearch
+			es.URL = url.PlainURL(es.URL)
+			o.Elasticsearch = &es
+		case o.Type == obs.OutputTypeHTTP && o.HTTP != nil:
+			http := *o.HTTP
+			http.URL = url.PlainURL(http.URL)
+			o.HTTP = &http
+		case o.Type == obs.OutputTypeLoki && o.Loki != nil:
+			loki := *o.Loki
+			loki.URL = url.PlainURL(loki.URL)
+			o.Loki = &loki
+		case o.Type == obs.OutputTypeSplunk && o.Splunk != nil:
+			splunk := *o.Splunk
+			splunk.URL = url.PlainURL(splunk.URL)
+			o.Splunk = &splunk
+		case o.Type == obs.OutputTypeOTLP && o.OTLP != nil:
+			otlp := *o.OTLP
+			otlp.URL = url.PlainURL(otlp.URL)
+			o.OTLP = &otlp
+		case o.Type == obs.OutputTypeCloudwatch && o.Cloudwatch != nil && o.Cloudwatch.URL != "":
+			cw := *o.Cloudwatch
+			cw.URL = url.PlainURL(cw.URL)
+			o.Cloudwatch = &cw
+		}
+		secured = append(secured, o)
+	}
+	return secured
+}
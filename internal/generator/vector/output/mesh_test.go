@@ -0,0 +1,25 @@
+package output
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("#MeshSecured", func() {
+
+	It("should rewrite the secure URLs of the outputs sent over HTTP to plain URLs on the same port", func() {
+		outputs := []obs.OutputSpec{
+			{Name: "my-http", Type: obs.OutputTypeHTTP, HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "https://my.example.com/logs"}}},
+			{Name: "my-es", Type: obs.OutputTypeElasticsearch, Elasticsearch: &obs.Elasticsearch{URLSpec: obs.URLSpec{URL: "https://es.example.com:9200"}}},
+			{Name: "my-loki", Type: obs.OutputTypeLoki, Loki: &obs.Loki{URLSpec: obs.URLSpec{URL: "http://loki.example.com:3100"}}},
+			{Name: "my-syslog", Type: obs.OutputTypeSyslog, Syslog: &obs.Syslog{URL: "tls://syslog.example.com:6514"}},
+		}
+		secured := MeshSecured(outputs)
+		Expect(secured[0].HTTP.URL).To(Equal("http://my.example.com:443/logs"))
+		Expect(secured[1].Elasticsearch.URL).To(Equal("http://es.example.com:9200"))
+		Expect(secured[2].Loki.URL).To(Equal("http://loki.example.com:3100"))
+		Expect(secured[3].Syslog.URL).To(Equal("tls://syslog.example.com:6514"), "Exp. outputs sent over TCP to be secured by the collector")
+		Expect(outputs[0].HTTP.URL).To(Equal("https://my.example.com/logs"), "Exp. the specs of the outputs to be unchanged")
+	})
+})
@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	WorkloadMetricsTransformName     = "workload_metrics"
+	workloadMetricsSizeTransformName = "workload_metrics_size"
+
+	workloadMetricsVRL = `
+. = {
+  "namespace_name": .kubernetes.namespace_name,
+  "pod_name": .kubernetes.pod_name,
+  "bytes": strlen(to_string(.message) ?? "")
+}
+`
+)
+
+// WorkloadLogToMetric counts the records and bytes collected from each pod
+type WorkloadLogToMetric struct {
+	ID     string
+	Inputs string
+}
+
+func (w WorkloadLogToMetric) Name() string {
+	return "workloadLogToMetricTemplate"
+}
+
+func (w WorkloadLogToMetric) Template() string {
+	return `{{define "` + w.Name() + `" -}}
+[transforms.{{.ID}}]
+type = "log_to_metric"
+inputs = {{.Inputs}}
+
+[[transforms.{{.ID}}.metrics]]
+type = "counter"
+field = "bytes"
+name = "workload_log_events_total"
+
+[transforms.{{.ID}}.metrics.tags]
+kubernetes_namespace_name = "{{"{{namespace_name}}"}}"
+kubernetes_pod_name = "{{"{{pod_name}}"}}"
+
+[[transforms.{{.ID}}.metrics]]
+type = "counter"
+field = "bytes"
+name = "workload_log_bytes_total"
+increment_by_value = true
+
+[transforms.{{.ID}}.metrics.tags]
+kubernetes_namespace_name = "{{"{{namespace_name}}"}}"
+kubernetes_pod_name = "{{"{{pod_name}}"}}"
+{{end}}`
+}
+
+// WorkloadMetrics returns the elements to generate metrics of the rate at which logs are collected from each pod
+// by the given container sources
+func WorkloadMetrics(inputs []string) []framework.Element {
+	return []framework.Element{
+		elements.Remap{
+			Desc:        "Reduce container logs to the namespace, pod and size of each record",
+			ComponentID: workloadMetricsSizeTransformName,
+			Inputs:      helpers.MakeInputs(inputs...),
+			VRL:         workloadMetricsVRL,
+		},
+		WorkloadLogToMetric{
+			ID:     WorkloadMetricsTransformName,
+			Inputs: helpers.MakeInputs(workloadMetricsSizeTransformName),
+		},
+	}
+}
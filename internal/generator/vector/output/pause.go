@@ -0,0 +1,177 @@
+package output
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	// spoolSize is the maximum size of the disk buffer in which the records of a paused output are spooled on
+	// the node.  The newest records are dropped once the spool is full
+	spoolSize = 1073741824
+
+	// spoolSocketDir is the directory of the sockets through which the spooled records are delivered once the
+	// output is resumed
+	spoolSocketDir = "/tmp"
+)
+
+// SpoolSink spools the records of a paused output in its disk buffer on the node.  Nothing listens on the socket
+// of the sink while the output is paused so the records stay in the buffer until the output is resumed
+type SpoolSink struct {
+	ComponentID string
+	Inputs      string
+	Path        string
+	MaxSize     int64
+}
+
+func (s SpoolSink) Name() string {
+	return "spoolSinkTemplate"
+}
+
+func (s SpoolSink) Template() string {
+	return `{{define "` + s.Name() + `" -}}
+# Spool records on the node while the output is paused
+[sinks.{{.ComponentID}}]
+type = "socket"
+inputs = {{.Inputs}}
+mode = "unix"
+path = "{{.Path}}"
+healthcheck.enabled = false
+
+[sinks.{{.ComponentID}}.encoding]
+codec = "json"
+
+[sinks.{{.ComponentID}}.framing]
+method = "newline_delimited"
+
+[sinks.{{.ComponentID}}.buffer]
+type = "disk"
+when_full = "drop_newest"
+max_size = {{.MaxSize}}
+{{end}}`
+}
+
+// SpoolSource receives the records spooled on the node while an output was paused
+type SpoolSource struct {
+	ComponentID string
+	Path        string
+}
+
+func (s SpoolSource) Name() string {
+	return "spoolSourceTemplate"
+}
+
+func (s SpoolSource) Template() string {
+	return `{{define "` + s.Name() + `" -}}
+# Receive records spooled on the node while the output was paused
+[sources.{{.ComponentID}}]
+type = "socket"
+mode = "unix"
+path = "{{.Path}}"
+
+[sources.{{.ComponentID}}.decoding]
+codec = "bytes"
+
+[sources.{{.ComponentID}}.framing]
+method = "newline_delimited"
+{{end}}`
+}
+
+// Blackhole discards the records of a paused output
+type Blackhole struct {
+	ComponentID string
+	Inputs      string
+}
+
+func (b Blackhole) Name() string {
+	return "blackholeTemplate"
+}
+
+func (b Blackhole) Template() string {
+	return `{{define "` + b.Name() + `" -}}
+# Drop records while the output is paused
+[sinks.{{.ComponentID}}]
+type = "blackhole"
+inputs = {{.Inputs}}
+print_interval_secs = 0
+{{end}}`
+}
+
+// spoolSink returns the sink which spools the records of an output with the given inputs.  The sink keeps its ID
+// when the output is resumed so the records in its buffer are delivered
+func spoolSink(o obs.OutputSpec, inputs []string) SpoolSink {
+	return SpoolSink{
+		ComponentID: helpers.MakeID(helpers.MakeOutputID(o.Name), "spool"),
+		Inputs:      helpers.MakeInputs(inputs...),
+		Path:        spoolSocket(o),
+		MaxSize:     spoolSize,
+	}
+}
+
+// spoolSocket returns the path of the socket through which the spooled records of an output are delivered
+func spoolSocket(o obs.OutputSpec) string {
+	return fmt.Sprintf("%s/%s.sock", spoolSocketDir, helpers.MakeOutputID(o.Name))
+}
+
+// NewPaused returns the sink of a paused output which either spools the records on the node or drops them according
+// to the pause policy of the output
+func NewPaused(o obs.OutputSpec, inputs []string) []Element {
+	if o.PausePolicy == obs.PausePolicyDrop {
+		return []Element{
+			Blackhole{
+				ComponentID: helpers.MakeOutputID(o.Name),
+				Inputs:      helpers.MakeInputs(inputs...),
+			},
+		}
+	}
+	return []Element{
+		spoolSink(o, inputs),
+	}
+}
+
+// NewSpoolReader returns the elements which deliver the records spooled for an output while it was paused.  The spool
+// no longer receives the records of the inputs but delivers those in its buffer through its socket. Spooled records
+// which can not be decoded are dropped.  The ID of the last element is returned to be added to the inputs of the output
+func NewSpoolReader(o obs.OutputSpec, inputs []string) ([]Element, string) {
+	outputID := helpers.MakeOutputID(o.Name)
+	gateID := helpers.MakeID(outputID, "spool", "gate")
+	sourceID := helpers.MakeID(outputID, "unspool")
+	decodeID := helpers.MakeID(sourceID, "decode")
+	return []Element{
+		elements.Filter{
+			ComponentID: gateID,
+			Desc:        "Stop spooling records once the output is resumed",
+			Inputs:      helpers.MakeInputs(inputs...),
+			Condition:   "false",
+		},
+		spoolSink(o, []string{gateID}),
+		SpoolSource{
+			ComponentID: sourceID,
+			Path:        spoolSocket(o),
+		},
+		elements.Remap{
+			ComponentID: decodeID,
+			Inputs:      helpers.MakeInputs(sourceID),
+			VRL: `record, err = parse_json(.message)
+if err != null {
+  log("Unable to decode spooled record: " + err, level: "error", rate_limit_secs: 60)
+  abort
+}
+if !is_object(record) {
+  log("Unable to decode spooled record: not an object", level: "error", rate_limit_secs: 60)
+  abort
+}
+. = object!(record)
+if is_string(.timestamp) {
+  .timestamp = parse_timestamp(.timestamp, "%+") ?? .timestamp
+}
+if is_string(."@timestamp") {
+  ."@timestamp" = parse_timestamp(."@timestamp", "%+") ?? ."@timestamp"
+}`,
+		},
+	}, decodeID
+}
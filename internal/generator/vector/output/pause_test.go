@@ -0,0 +1,146 @@
+package output
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("Paused outputs", func() {
+
+	var (
+		spec = obs.OutputSpec{
+			Type: obs.OutputTypeHTTP,
+			Name: "http-receiver",
+			HTTP: &obs.HTTP{
+				URLSpec: obs.URLSpec{URL: "http://my-logstore.com"},
+			},
+		}
+	)
+
+	newOutput := func(spec obs.OutputSpec, op framework.Options) *Output {
+		o := NewOutput(spec, nil, op)
+		o.inputIDs = []string{"application"}
+		return o
+	}
+
+	It("should spool records on the node while the output is paused", func() {
+		spec.Paused = true
+		Expect(`
+# Spool records on the node while the output is paused
+[sinks.output_http_receiver_spool]
+type = "socket"
+inputs = ["application"]
+mode = "unix"
+path = "/tmp/output_http_receiver.sock"
+healthcheck.enabled = false
+
+[sinks.output_http_receiver_spool.encoding]
+codec = "json"
+
+[sinks.output_http_receiver_spool.framing]
+method = "newline_delimited"
+
+[sinks.output_http_receiver_spool.buffer]
+type = "disk"
+when_full = "drop_newest"
+max_size = 1073741824
+`).To(EqualConfigFrom(newOutput(spec, framework.NoOptions).Elements()))
+	})
+
+	It("should drop records while the output is paused when the policy is Drop", func() {
+		spec.Paused = true
+		spec.PausePolicy = obs.PausePolicyDrop
+		Expect(`
+# Drop records while the output is paused
+[sinks.output_http_receiver]
+type = "blackhole"
+inputs = ["application"]
+print_interval_secs = 0
+`).To(EqualConfigFrom(newOutput(spec, framework.NoOptions).Elements()))
+	})
+
+	It("should deliver the spooled records when the output is resumed", func() {
+		spec.Paused = false
+		spec.PausePolicy = ""
+		op := framework.Options{framework.OptionResumedOutputs: []string{spec.Name}}
+		Expect(`
+# Stop spooling records once the output is resumed
+[transforms.output_http_receiver_spool_gate]
+type = "filter"
+inputs = ["application"]
+condition = '''
+false
+'''
+
+# Spool records on the node while the output is paused
+[sinks.output_http_receiver_spool]
+type = "socket"
+inputs = ["output_http_receiver_spool_gate"]
+mode = "unix"
+path = "/tmp/output_http_receiver.sock"
+healthcheck.enabled = false
+
+[sinks.output_http_receiver_spool.encoding]
+codec = "json"
+
+[sinks.output_http_receiver_spool.framing]
+method = "newline_delimited"
+
+[sinks.output_http_receiver_spool.buffer]
+type = "disk"
+when_full = "drop_newest"
+max_size = 1073741824
+
+# Receive records spooled on the node while the output was paused
+[sources.output_http_receiver_unspool]
+type = "socket"
+mode = "unix"
+path = "/tmp/output_http_receiver.sock"
+
+[sources.output_http_receiver_unspool.decoding]
+codec = "bytes"
+
+[sources.output_http_receiver_unspool.framing]
+method = "newline_delimited"
+
+[transforms.output_http_receiver_unspool_decode]
+type = "remap"
+inputs = ["output_http_receiver_unspool"]
+source = '''
+  record, err = parse_json(.message)
+  if err != null {
+    log("Unable to decode spooled record: " + err, level: "error", rate_limit_secs: 60)
+    abort
+  }
+  if !is_object(record) {
+    log("Unable to decode spooled record: not an object", level: "error", rate_limit_secs: 60)
+    abort
+  }
+  . = object!(record)
+  if is_string(.timestamp) {
+    .timestamp = parse_timestamp(.timestamp, "%+") ?? .timestamp
+  }
+  if is_string(."@timestamp") {
+    ."@timestamp" = parse_timestamp(."@timestamp", "%+") ?? ."@timestamp"
+  }
+'''
+
+[sinks.output_http_receiver]
+type = "http"
+inputs = ["application","output_http_receiver_unspool_decode"]
+uri = "http://my-logstore.com"
+method = "post"
+
+[sinks.output_http_receiver.encoding]
+codec = "json"
+except_fields = ["_internal"]
+
+[sinks.output_http_receiver.tls]
+min_tls_version = "VersionTLS12"
+ciphersuites = "TLS_AES_128_GCM_SHA256,TLS_AES_256_GCM_SHA384,TLS_CHACHA20_POLY1305_SHA256,ECDHE-ECDSA-AES128-GCM-SHA256,ECDHE-RSA-AES128-GCM-SHA256,ECDHE-ECDSA-AES256-GCM-SHA384,ECDHE-RSA-AES256-GCM-SHA384,ECDHE-ECDSA-CHACHA20-POLY1305,ECDHE-RSA-CHACHA20-POLY1305,DHE-RSA-AES128-GCM-SHA256,DHE-RSA-AES256-GCM-SHA384"
+`).To(EqualConfigFrom(newOutput(spec, op).Elements()))
+	})
+})
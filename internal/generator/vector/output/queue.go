@@ -0,0 +1,24 @@
+package output
+
+const (
+	// queueHeadroomPercent is the share of the persistent volume of the aggregator which is not allotted to the
+	// queues of the outputs.  The disk buffers exceed their max_size by the files being written and their ledgers
+	queueHeadroomPercent = 10
+)
+
+// QueueSize returns the size of the queue of each of the given number of sinks sharing a persistent volume of the
+// given size, after reserving the headroom of the volume
+func QueueSize(volumeSize int64, sinks int) int64 {
+	if sinks <= 0 {
+		return 0
+	}
+	return volumeSize * (100 - queueHeadroomPercent) / 100 / int64(sinks)
+}
+
+// MinQueueVolumeSize returns the minimum size, rounded up to a MiB, of a persistent volume shared by the queues of the
+// given number of sinks, since the queue of each sink can not be smaller than the minimum size of a disk buffer
+func MinQueueVolumeSize(sinks int) int64 {
+	const mib = 1 << 20
+	size := int64(sinks) * minBufferSize * 100 / (100 - queueHeadroomPercent)
+	return (size/mib + 1) * mib
+}
@@ -0,0 +1,23 @@
+package output
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("queues on the persistent volume of the aggregator", func() {
+
+	It("should split the volume between the sinks after reserving the headroom", func() {
+		Expect(QueueSize(10737418240, 4)).To(Equal(int64(2415919104)))
+		Expect(QueueSize(10737418240, 0)).To(BeZero())
+	})
+
+	It("should require a volume in which the queue of each sink is at least the minimum size of a disk buffer", func() {
+		for _, sinks := range []int{1, 2, 5} {
+			size := MinQueueVolumeSize(sinks)
+			Expect(size%(1<<20)).To(BeZero(), "Exp. the size to be rounded up to a MiB")
+			Expect(QueueSize(size, sinks)).To(BeNumerically(">=", minBufferSize))
+		}
+		Expect(MinQueueVolumeSize(1)).To(Equal(int64(285 << 20)))
+	})
+})
@@ -19,6 +19,8 @@ type Splunk struct {
 	Endpoint     string
 	DefaultToken string
 	Index        Element
+	Source       Element
+	SourceType   Element
 	common.RootMixin
 }
 
@@ -35,6 +37,8 @@ endpoint = "{{.Endpoint}}"
 {{.Compression}}
 default_token = "{{.DefaultToken}}"
 {{kv .Index -}}
+{{kv .Source -}}
+{{kv .SourceType -}}
 timestamp_key = "@timestamp"
 {{end}}`
 }
@@ -52,20 +56,31 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.Sec
 
 	timestampID := vectorhelpers.MakeID(id, "timestamp")
 
-	var indexTemplate Element
-	splunkSink := sink(id, o, []string{timestampID}, "", secrets, op)
-	if hasIndexKey(o.Splunk) {
-		splunkIndexID := vectorhelpers.MakeID(id, "splunk_index")
-		indexTemplate = commontemplate.TemplateRemap(splunkIndexID, []string{timestampID}, o.Splunk.Index, splunkIndexID, "Splunk Index")
-		splunkSink = sink(id, o, []string{splunkIndexID}, splunkIndexID, secrets, op)
+	// each templated field is evaluated by a remap which feeds the next one
+	inputID := timestampID
+	var templates []Element
+	template := func(field, userTemplate, description string) string {
+		if userTemplate == "" {
+			return ""
+		}
+		fieldID := vectorhelpers.MakeID(id, field)
+		templates = append(templates, commontemplate.TemplateRemap(fieldID, []string{inputID}, userTemplate, fieldID, description))
+		inputID = fieldID
+		return fieldID
 	}
+	index := template("splunk_index", o.Splunk.Index, "Splunk Index")
+	source := template("splunk_source", o.Splunk.Source, "Splunk Source")
+	sourceType := template("splunk_sourcetype", o.Splunk.SourceType, "Splunk Source Type")
+
+	splunkSink := sink(id, o, []string{inputID}, secrets)
+	splunkSink.Index = Tenant(o.Splunk, index)
+	splunkSink.Source = templatedKV("source", source)
+	splunkSink.SourceType = templatedKV("sourcetype", sourceType)
 
 	if strategy != nil {
 		strategy.VisitSink(splunkSink)
 	}
-	return []Element{
-		FixTimestampFormat(timestampID, inputs),
-		indexTemplate,
+	return append(append([]Element{FixTimestampFormat(timestampID, inputs)}, templates...),
 		splunkSink,
 		common.NewEncoding(id, common.CodecJSON),
 		common.NewAcknowledgments(id, strategy),
@@ -73,15 +88,17 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.Sec
 		common.NewBuffer(id, strategy),
 		common.NewRequest(id, strategy),
 		tls.New(id, o.TLS, secrets, op),
-	}
+	)
 }
 
-func sink(id string, o obs.OutputSpec, inputs []string, index string, secrets vectorhelpers.Secrets, op Options) *Splunk {
+func sink(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.Secrets) *Splunk {
 	s := &Splunk{
 		ComponentID: id,
 		Inputs:      vectorhelpers.MakeInputs(inputs...),
 		Endpoint:    o.Splunk.URL,
-		Index:       Tenant(o.Splunk, index),
+		Index:       Nil,
+		Source:      Nil,
+		SourceType:  Nil,
 		RootMixin:   common.NewRootMixin("none"),
 	}
 	authentication := o.Splunk.Authentication
@@ -116,5 +133,13 @@ func Tenant(s *obs.Splunk, index string) Element {
 	if !hasIndexKey(s) {
 		return Nil
 	}
-	return KV("index", fmt.Sprintf(`"{{ ._internal.%s }}"`, index))
+	return templatedKV("index", index)
+}
+
+// templatedKV returns the sink option whose value is the field set by the remap evaluating its template
+func templatedKV(key, fieldID string) Element {
+	if fieldID == "" {
+		return Nil
+	}
+	return KV(key, fmt.Sprintf(`"{{ ._internal.%s }}"`, fieldID))
 }
@@ -2,6 +2,8 @@ package splunk_test
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -13,6 +15,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/splunk"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
 )
 
 var _ = Describe("Generating vector config for Splunk output", func() {
@@ -90,8 +93,50 @@ var _ = Describe("Generating vector config for Splunk output", func() {
 		Entry("with custom static & dynamic index", "splunk_sink_with_custom_index.toml", framework.NoOptions, func(spec *obs.OutputSpec) {
 			spec.Splunk.Index = `foo-{.kubernetes.namespace_name||"missing"}`
 		}),
+		Entry("with custom index, source and source type", "splunk_sink_with_index_and_source.toml", framework.NoOptions, func(spec *obs.OutputSpec) {
+			spec.Splunk.Index = `foo-{.kubernetes.namespace_name||"missing"}`
+			spec.Splunk.Source = "openshift"
+			spec.Splunk.SourceType = `openshift:{.log_type||"unknown"}`
+		}),
 		Entry("with custom static & dynamic index", "splunk_sink_with_custom_index_dedot.toml", framework.NoOptions, func(spec *obs.OutputSpec) {
 			spec.Splunk.Index = `foo-{.kubernetes.namespace_labels."test/logging.io"||"missing"}`
 		}),
 	)
 })
+
+var _ = Describe("Splunk source and source type API validation", func() {
+
+	// splunkPattern returns the pattern with which the CRD validates a property of the splunk output
+	splunkPattern := func(property string) *regexp.Regexp {
+		content, err := os.ReadFile("../../../../../config/crd/bases/observability.openshift.io_clusterlogforwarders.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		crd := map[string]interface{}{}
+		Expect(yaml.Unmarshal(content, &crd)).To(Succeed())
+		schema := crd["spec"].(map[string]interface{})["versions"].([]interface{})[0].(map[string]interface{})["schema"]
+		node := schema.(map[string]interface{})["openAPIV3Schema"]
+		for _, key := range []string{"spec", "outputs", "splunk", property} {
+			node = node.(map[string]interface{})["properties"].(map[string]interface{})[key]
+			if items, found := node.(map[string]interface{})["items"]; found {
+				node = items
+			}
+		}
+		return regexp.MustCompile(node.(map[string]interface{})["pattern"].(string))
+	}
+
+	DescribeTable("should accept", func(property, value string) {
+		Expect(splunkPattern(property).MatchString(value)).To(BeTrue())
+	},
+		Entry("a static source", "source", "openshift"),
+		Entry("a static source with colons", "source", "kube:container:web"),
+		Entry("a templated source with colons", "source", `kube:{.kubernetes.container_name||"none"}`),
+		Entry("a static source type with colons", "sourceType", "kube:container"),
+		Entry("a templated source type with colons", "sourceType", `openshift:{.log_type||"unknown"}`),
+	)
+
+	DescribeTable("should reject", func(property, value string) {
+		Expect(splunkPattern(property).MatchString(value)).To(BeFalse())
+	},
+		Entry("a source with spaces", "source", "kube container"),
+		Entry("a source type with spaces", "sourceType", "kube container"),
+	)
+})
@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/siem"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/tls"
 
 	. "github.com/openshift/cluster-logging-operator/internal/generator/framework"
@@ -19,15 +21,19 @@ import (
 )
 
 const (
-	TCP = `tcp`
-	TLS = `tls`
+	TCP  = `tcp`
+	TLS  = `tls`
+	Unix = internalobs.SchemeUnix
 )
 
 type Syslog struct {
-	ComponentID string
-	Inputs      string
-	Address     string
-	Mode        string
+	ComponentID     string
+	Inputs          string
+	Address         string
+	Path            string
+	Mode            string
+	SendBufferBytes int64
+	KeepAliveSecs   int64
 	common.RootMixin
 }
 
@@ -40,8 +46,20 @@ func (s Syslog) Template() string {
 [sinks.{{.ComponentID}}]
 type = "socket"
 inputs = {{.Inputs}}
+{{- if .Path }}
+path = "{{.Path}}"
+{{- else }}
 address = "{{.Address}}"
+{{- end }}
 mode = "{{.Mode}}"
+{{- if .SendBufferBytes }}
+send_buffer_bytes = {{.SendBufferBytes}}
+{{- end }}
+{{- if .KeepAliveSecs }}
+
+[sinks.{{.ComponentID}}.keepalive]
+time_secs = {{.KeepAliveSecs}}
+{{- end }}
 {{end}}`
 }
 
@@ -59,6 +77,8 @@ type SyslogEncodingRemap struct {
 	Inputs         string
 	EncodingFields EncodingTemplateField
 	PayloadKey     string
+	// Format is the VRL which encodes the payload as an event of a SIEM format
+	Format string
 }
 
 func (ser SyslogEncodingRemap) Name() string {
@@ -79,6 +99,9 @@ source = '''
 {{end -}}
 {{end}}
 
+{{if .Format -}}
+{{.Format}}
+{{end}}
 {{if .PayloadKey -}}
 if is_null({{.PayloadKey}}) {
 	.payload_key = .
@@ -142,11 +165,14 @@ func New(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.Sec
 
 	syslogElements = append(syslogElements, Encoding(id, o, templateFieldPairs.FieldVRLList)...)
 
-	return append(syslogElements,
+	syslogElements = append(syslogElements,
 		common.NewAcknowledgments(id, strategy),
 		common.NewBuffer(id, strategy),
-		tls.New(id, o.TLS, secrets, op, tls.IncludeEnabledOption),
 	)
+	if sink.Mode == Unix {
+		return syslogElements
+	}
+	return append(syslogElements, tls.New(id, o.TLS, secrets, op, tls.IncludeEnabledOption))
 }
 
 func Output(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.Secrets, op Options, urlScheme string, host string) *Syslog {
@@ -154,13 +180,25 @@ func Output(id string, o obs.OutputSpec, inputs []string, secrets vectorhelpers.
 	if urlScheme == TLS {
 		mode = TCP
 	}
-	return &Syslog{
+	sink := &Syslog{
 		ComponentID: id,
 		Inputs:      vectorhelpers.MakeInputs(inputs...),
 		Address:     host,
 		Mode:        mode,
 		RootMixin:   common.NewRootMixin(nil),
 	}
+	if mode == Unix {
+		sink.Path = internalobs.SocketPath(o)
+	}
+	if tuning := o.Syslog.Tuning; tuning != nil {
+		if tuning.SendBufferSize != nil {
+			sink.SendBufferBytes = tuning.SendBufferSize.Value()
+		}
+		if tuning.KeepAlive != nil && mode == TCP {
+			sink.KeepAliveSecs = int64(*tuning.KeepAlive)
+		}
+	}
+	return sink
 }
 
 // getEncodingTemplatesAndFields determines which encoding fields are templated
@@ -201,7 +239,7 @@ func Encoding(id string, o obs.OutputSpec, templatePairs []FieldVRLStringPair) [
 		AddLogSource: genhelper.NewOptionalPair("add_log_source", o.Syslog.Enrichment == obs.EnrichmentTypeKubernetesMinimal),
 		PayloadKey:   genhelper.NewOptionalPair("payload_key", nil),
 	}
-	if o.Syslog.PayloadKey != "" {
+	if o.Syslog.PayloadKey != "" || o.Syslog.Format != nil {
 		sysLEncode.PayloadKey.Value = "payload_key"
 	}
 
@@ -217,12 +255,16 @@ func Encoding(id string, o obs.OutputSpec, templatePairs []FieldVRLStringPair) [
 }
 
 func parseEncoding(id string, inputs []string, templatePairs EncodingTemplateField, o *obs.Syslog) Element {
-	return SyslogEncodingRemap{
+	remap := SyslogEncodingRemap{
 		ComponentID:    id,
 		Inputs:         vectorhelpers.MakeInputs(inputs...),
 		EncodingFields: templatePairs,
 		PayloadKey:     PayloadKey(o.PayloadKey),
 	}
+	if o.Format != nil {
+		remap.Format = siem.VRL(o.Format, ".payload_key")
+	}
+	return remap
 }
 
 func Facility(s *obs.Syslog) string {
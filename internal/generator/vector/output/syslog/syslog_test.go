@@ -2,6 +2,7 @@ package syslog_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -10,8 +11,10 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/syslog"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 var _ = Describe("vector syslog clf output", func() {
@@ -78,6 +81,27 @@ var _ = Describe("vector syslog clf output", func() {
 		Entry("should configure TCP with defaults", "tcp_with_defaults.toml", func(spec *obs.OutputSpec) {
 			spec.Syslog.URL = "tcp://logserver:514"
 		}),
+		Entry("should configure TCP with socket tuning", "tcp_with_tuning.toml", func(spec *obs.OutputSpec) {
+			spec.Syslog.URL = "tcp://logserver:514"
+			spec.Syslog.Tuning = &obs.SyslogTuningSpec{
+				KeepAlive:      utils.GetPtr(time.Duration(120)),
+				SendBufferSize: utils.GetPtr(resource.MustParse("1Mi")),
+			}
+		}),
+		Entry("should configure a unix domain socket without TLS", "unix_with_defaults.toml", func(spec *obs.OutputSpec) {
+			spec.Syslog.URL = "unix:///var/run/siem/syslog.sock"
+		}),
+		Entry("should configure TCP with CEF formatted messages", "tcp_with_cef_format.toml", func(spec *obs.OutputSpec) {
+			spec.Syslog.URL = "tcp://logserver:514"
+			spec.Syslog.Format = &obs.SIEMFormat{
+				Type:    obs.SIEMFormatCEF,
+				Product: "ACME|Logging",
+				Fields: map[string]string{
+					"msg": `{.message||""}`,
+					"cs1": `{.kubernetes.namespace_name||"none"}`,
+				},
+			}
+		}),
 		Entry("should configure UDP with every setting", "udp_with_every_setting.toml", func(spec *obs.OutputSpec) {
 			spec.Syslog = &obs.Syslog{
 				URL:        "udp://logserver:514",
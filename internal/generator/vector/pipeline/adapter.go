@@ -10,6 +10,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/minlevel"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output"
 	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
@@ -32,6 +33,17 @@ func (o *Pipeline) Elements() []framework.Element {
 	return elements
 }
 
+// MetricsIDs returns the IDs of the components generating metrics from the records of the pipeline
+func (o *Pipeline) MetricsIDs() []string {
+	ids := []string{}
+	for _, pf := range o.Filters {
+		if pf.metricsID != "" {
+			ids = append(ids, pf.metricsID)
+		}
+	}
+	return ids
+}
+
 func NewPipeline(index int, p obs.PipelineSpec, inputs map[string]helpers.InputComponent, outputs map[string]*output.Output, filters map[string]*filter.InternalFilterSpec, inputSpecs []obs.InputSpec) *Pipeline {
 	pipeline := &Pipeline{
 		PipelineSpec: p,
@@ -46,8 +58,15 @@ func NewPipeline(index int, p obs.PipelineSpec, inputs map[string]helpers.InputC
 			}
 		}
 	}
-	for name, f := range filters {
-		pipeline.filterMap[name] = *f
+	for _, name := range p.FilterRefs {
+		if f, found := filters[name]; found {
+			pipeline.filterMap[name] = *f
+		}
+	}
+	if p.Ordering == obs.OrderingModeStrict {
+		for _, name := range p.OutputRefs {
+			outputs[name].PreserveOrdering()
+		}
 	}
 	addPrefilters(pipeline)
 	addPostfilters(pipeline)
@@ -111,6 +130,16 @@ func addPrefilters(p *Pipeline) {
 
 func addPostfilters(p *Pipeline) {
 	postfilters := []string{}
+	if p.MinLevel != "" {
+		postfilters = append(postfilters, minlevel.MinLevel)
+		p.filterMap[minlevel.MinLevel] = filter.InternalFilterSpec{
+			FilterSpec:        &obs.FilterSpec{Type: minlevel.MinLevel},
+			SuppliesTransform: true,
+			TranformFactory: func(id string, inputs ...string) framework.Element {
+				return minlevel.New(id, p.MinLevel, inputs...)
+			},
+		}
+	}
 	postfilters = append(postfilters, viaq.ViaqDedot)
 	p.filterMap[viaq.ViaqDedot] = filter.InternalFilterSpec{
 		FilterSpec:        &obs.FilterSpec{Type: viaq.ViaqDedot},
@@ -155,6 +184,9 @@ type PipelineFilter struct {
 
 	//transformFactory is a function that takes input IDs and returns a transform
 	transformFactory func(...string) framework.Element
+
+	// metricsID is the ID of the component generating metrics from the records of the filter, if any
+	metricsID string
 }
 
 func (pf *PipelineFilter) ID() string {
@@ -171,12 +203,16 @@ func (pf *PipelineFilter) AddInputFrom(n helpers.InputComponent) {
 func NewPipelineFilter(pipelineName, filterRef string, spec filter.InternalFilterSpec, pipeline obs.PipelineSpec) *PipelineFilter {
 	ids := []string{helpers.MakePipelineID(pipelineName, filterRef)}
 	if spec.SuppliesTransform {
-		return &PipelineFilter{
+		pf := &PipelineFilter{
 			ids: ids,
 			transformFactory: func(inputs ...string) framework.Element {
 				return spec.TranformFactory(ids[0], inputs...)
 			},
 		}
+		if spec.MetricsID != nil {
+			pf.metricsID = spec.MetricsID(ids[0])
+		}
+		return pf
 	}
 
 	if vrl, err := spec.RemapFilter.VRL(); err != nil {
@@ -188,7 +224,7 @@ func NewPipelineFilter(pipelineName, filterRef string, spec filter.InternalFilte
 			ids:      ids,
 			vrl:      vrl,
 			isFilterElement: func() bool {
-				return spec.Type == obs.FilterTypeDrop
+				return spec.Type == obs.FilterTypeDrop || spec.Type == obs.FilterTypeSchedule || spec.Type == obs.FilterTypeCEL
 			}(),
 		}
 	}
@@ -7,6 +7,7 @@ import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/input"
@@ -68,7 +69,7 @@ var _ = Describe("pipeline/adapter.go", func() {
 									`.foo.labels."test.dot-with/slashes888"`},
 							},
 						},
-					}),
+					}, framework.NoOptions),
 					inputSpecs,
 				)
 				Expect(adapter.Filters).To(HaveLen(3), "expected a viaq, prune and dedot filter to be added to the pipeline")
@@ -90,7 +91,7 @@ var _ = Describe("pipeline/adapter.go", func() {
 								NotIn: []obs.FieldPath{".kubernetes.labels", ".message", ".foo"},
 							},
 						},
-					}),
+					}, framework.NoOptions),
 					inputSpecs,
 				)
 				Expect(adapter.Filters).To(HaveLen(3), "expected viaq, prune and dedot filters to be added to the pipeline")
@@ -113,7 +114,7 @@ var _ = Describe("pipeline/adapter.go", func() {
 								NotIn: []obs.FieldPath{".kubernetes.container_name", `.foo.bar."baz/bar"`, `.foo`},
 							},
 						},
-					}),
+					}, framework.NoOptions),
 					inputSpecs,
 				)
 				Expect(adapter.Filters).To(HaveLen(3), "expected a viaq, prune and dedot filter to be added to the pipeline")
@@ -146,7 +147,7 @@ var _ = Describe("pipeline/adapter.go", func() {
 							},
 						},
 					},
-				}),
+				}, framework.NoOptions),
 				inputSpecs,
 			)
 			Expect(adapter.Filters).To(HaveLen(3), "expected viaq, kubeapi and dedot filters to be added to the pipeline")
@@ -199,11 +200,59 @@ var _ = Describe("pipeline/adapter.go", func() {
 							},
 						},
 					},
-				}),
+				}, framework.NoOptions),
 				inputSpecs,
 			)
 			Expect(adapter.Filters).To(HaveLen(4), "expected journal, viaq, drop and dedot filters to be added to the pipeline")
 			Expect(mustLoad("adapter_test_drop_filter.toml")).To(EqualConfigFrom(adapter.Elements()))
 		})
+
+		It("should expose the metrics of a clock skew filter spec'd for the pipeline", func() {
+			inputSpecs := []obs.InputSpec{
+				{Name: "app-in", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+			}
+			adapter := NewPipeline(0, obs.PipelineSpec{
+				Name:       "mypipeline",
+				InputRefs:  []string{inputSpecs[0].Name},
+				FilterRefs: []string{"my-skew"},
+			}, map[string]helpers.InputComponent{
+				inputSpecs[0].Name: input.NewInput(inputSpecs[0], secrets, "", factory.ForwarderResourceNames{CommonName: constants.CollectorName}, nil),
+			}, map[string]*output.Output{},
+				filter.NewInternalFilterMap(map[string]*obs.FilterSpec{
+					"my-skew": {
+						Name: "my-skew",
+						Type: obs.FilterTypeClockSkew,
+					},
+				}, framework.NoOptions),
+				inputSpecs,
+			)
+			Expect(adapter.Filters).To(HaveLen(3), "expected viaq, clock skew and dedot filters to be added to the pipeline")
+			Expect(adapter.MetricsIDs()).To(Equal([]string{"pipeline_mypipeline_my_skew_1_metrics"}))
+		})
+
+		It("should drop records below the minimum level after user filters when spec'd for the pipeline", func() {
+			inputSpecs := []obs.InputSpec{
+				{Name: "app-in", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+			}
+			adapter := NewPipeline(0, obs.PipelineSpec{
+				Name:       "mypipeline",
+				InputRefs:  []string{inputSpecs[0].Name},
+				FilterRefs: []string{"my-prune"},
+				MinLevel:   obs.LogLevelWarn,
+			}, map[string]helpers.InputComponent{
+				inputSpecs[0].Name: input.NewInput(inputSpecs[0], secrets, "", factory.ForwarderResourceNames{CommonName: constants.CollectorName}, nil),
+			}, map[string]*output.Output{},
+				filter.NewInternalFilterMap(map[string]*obs.FilterSpec{
+					"my-prune": {
+						Name:            "my-prune",
+						Type:            obs.FilterTypePrune,
+						PruneFilterSpec: &obs.PruneFilterSpec{In: []obs.FieldPath{".foo"}},
+					},
+				}, framework.NoOptions),
+				inputSpecs,
+			)
+			Expect(adapter.Filters).To(HaveLen(4), "expected viaq, prune, minLevel and dedot filters to be added to the pipeline")
+			Expect(adapter.Filters[2].ID()).To(Equal("pipeline_mypipeline_minlevel_2"))
+		})
 	})
 })
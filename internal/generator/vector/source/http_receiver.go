@@ -1,12 +1,17 @@
 package source
 
 import (
+	"fmt"
+	"strings"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 )
 
+const peerAddressKey = "_peer_address"
+
 func NewHttpSource(id, inputName string, input obs.InputSpec) (framework.Element, string) {
 	return HttpReceiver{
 		ID:            id,
@@ -14,6 +19,8 @@ func NewHttpSource(id, inputName string, input obs.InputSpec) (framework.Element
 		ListenAddress: helpers.ListenOnAllLocalInterfacesAddress(),
 		ListenPort:    input.Receiver.Port,
 		Format:        string(input.Receiver.HTTP.Format),
+		Delimited:     input.Receiver.HTTP.Format == obs.HTTPReceiverFormatCEF || input.Receiver.HTTP.Format == obs.HTTPReceiverFormatLEEF,
+		PeerKey:       peerKey(input.Receiver),
 	}, id
 }
 
@@ -23,6 +30,10 @@ type HttpReceiver struct {
 	ListenAddress string
 	ListenPort    int32
 	Format        string
+	// Delimited records are newline delimited text instead of JSON
+	Delimited bool
+	// PeerKey is the field to which the address of the client is added
+	PeerKey string
 }
 
 func (HttpReceiver) Name() string {
@@ -35,11 +46,54 @@ func (i HttpReceiver) Template() string {
 [sources.{{.ID}}]
 type = "http_server"
 address = "{{.ListenAddress}}:{{.ListenPort}}"
+{{- if .Delimited }}
+framing.method = "newline_delimited"
+decoding.codec = "bytes"
+{{- else }}
 decoding.codec = "json"
+{{- end }}
+{{- if .PeerKey }}
+host_key = "{{.PeerKey}}"
+{{- end }}
 {{end}}
 `
 }
 
+// NewAllowedCIDRsTransform drops records from clients whose address is not in one of the allowed CIDRs of the receiver
+func NewAllowedCIDRsTransform(id, inputs string, receiver *obs.ReceiverSpec) (framework.Element, string) {
+	if peerKey(receiver) == "" {
+		return framework.Nil, inputs
+	}
+	allowedID := helpers.MakeID(id, "allowed")
+	conditions := make([]string, len(receiver.ClientAuth.AllowedCIDRs))
+	for i, cidr := range receiver.ClientAuth.AllowedCIDRs {
+		conditions[i] = fmt.Sprintf("(ip_cidr_contains(%q, ip) ?? false)", cidr)
+	}
+	return elements.Remap{
+		ComponentID: allowedID,
+		Inputs:      helpers.MakeInputs(inputs),
+		VRL: strings.TrimSpace(fmt.Sprintf(`
+address = to_string(del(.%s)) ?? ""
+ip = address
+if starts_with(address, "[") {
+  ip = replace(address, r'^\[(.*)\]:\d+$', "$$1")
+} else if match(address, r'^[0-9.]+:\d+$') {
+  ip = replace(address, r':\d+$', "")
+}
+if !(%s) {
+  abort
+}
+`, peerAddressKey, strings.Join(conditions, " || "))),
+	}, allowedID
+}
+
+func peerKey(receiver *obs.ReceiverSpec) string {
+	if receiver.ClientAuth == nil || len(receiver.ClientAuth.AllowedCIDRs) == 0 {
+		return ""
+	}
+	return peerAddressKey
+}
+
 func NewSplitTransform(id, inputs string) (framework.Element, string) {
 	splitID := helpers.MakeID(id, "split")
 	return elements.Remap{
@@ -16,6 +16,22 @@ type KubernetesLogs struct {
 	IncludePaths       string
 	ExcludePaths       string
 	ExtraLabelSelector string
+
+	// DisablePartialMerge forwards the partial lines written by the container runtime as separate records
+	DisablePartialMerge bool
+
+	// MaxMergedLineBytes is the maximum size of a merged line, if any
+	MaxMergedLineBytes int64
+
+	// CapturePreviousLogs discovers new log files more frequently and keeps reading rotated files longer so the
+	// final lines of terminated containers are collected
+	CapturePreviousLogs bool
+
+	// MaxReadBytes is the maximum number of bytes read from a file before moving to the next, if any
+	MaxReadBytes int64
+
+	// RotateWaitSecs is the time rotated files continue to be read, if any
+	RotateWaitSecs int64
 }
 
 func (kl KubernetesLogs) Name() string {
@@ -27,9 +43,12 @@ func (kl KubernetesLogs) Template() string {
 # {{.Desc}}
 [sources.{{.ComponentID}}]
 type = "kubernetes_logs"
-max_read_bytes = 3145728
-glob_minimum_cooldown_ms = 15000
-auto_partial_merge = true
+max_read_bytes = {{if gt .MaxReadBytes 0}}{{.MaxReadBytes}}{{else}}3145728{{end}}
+glob_minimum_cooldown_ms = {{if .CapturePreviousLogs}}1000{{else}}15000{{end}}
+auto_partial_merge = {{not .DisablePartialMerge}}
+{{- if gt .MaxMergedLineBytes 0}}
+max_merged_line_bytes = {{.MaxMergedLineBytes}}
+{{- end}}
 {{- if gt (len .IncludePaths) 0}}
 include_paths_glob_patterns = {{.IncludePaths}}
 {{- end}}
@@ -45,7 +64,7 @@ pod_annotation_fields.pod_annotations = "kubernetes.annotations"
 pod_annotation_fields.pod_uid = "kubernetes.pod_id"
 pod_annotation_fields.pod_node_name = "hostname"
 namespace_annotation_fields.namespace_uid = "kubernetes.namespace_id"
-rotate_wait_secs = 5
+rotate_wait_secs = {{if gt .RotateWaitSecs 0}}{{.RotateWaitSecs}}{{else if .CapturePreviousLogs}}60{{else}}5{{end}}
 {{end}}`
 }
 
@@ -0,0 +1,110 @@
+package source
+
+import (
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/elements"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	// syslogLevel maps the severity of a syslog record to the level of the data model
+	syslogLevel = `if exists(.severity) { .level = .severity }`
+
+	// httpJSONStructured moves a JSON record received by the HTTP receiver into the structured field
+	httpJSONStructured = `
+ts = del(.timestamp)
+del(.path)
+del(.source_type)
+. = {"structured": ., "timestamp": ts}
+`
+	parseJSONMessage = `
+parsed, err = parse_json(.message)
+if err == null && is_object(parsed) {
+  .structured = parsed
+  del(.message)
+}
+`
+	parseCEFMessage = `
+parsed, err = parse_cef(.message)
+if err == null {
+  .structured = parsed
+  del(.message)
+}
+`
+	// parseLEEFMessage parses the header and attributes of a LEEF record.  Attributes are tab delimited unless
+	// a LEEF 2.0 header defines a delimiter as a character or its hex representation (e.g. x5E)
+	parseLEEFMessage = `
+parsed, err = parse_regex(.message, r'LEEF:(?P<leefVersion>[^|]*)\|(?P<vendor>[^|]*)\|(?P<product>[^|]*)\|(?P<productVersion>[^|]*)\|(?P<eventId>[^|]*)\|(?P<attributes>.*)')
+if err == null {
+  attributes = to_string(del(parsed.attributes))
+  delimiter = "\t"
+  if starts_with(to_string(parsed.leefVersion), "2") {
+    header, err = parse_regex(attributes, r'^(?P<delimiter>[^|=]{1,4})\|(?P<attributes>.*)')
+    if err == null {
+      attributes = to_string(header.attributes)
+      delimiter = to_string(header.delimiter)
+      if match(delimiter, r'^0?[xX][0-9A-Fa-f]{2}$') {
+        delimiter = decode_base16(slice!(delimiter, -2)) ?? "\t"
+      }
+    }
+  }
+  .structured = merge(parsed, parse_key_value(attributes, key_value_delimiter: "=", field_delimiter: delimiter) ?? {})
+  del(.message)
+}
+`
+)
+
+// NewReceiverFormatTransform parses the records of a receiver according to their format and maps the parsed fields
+// into the data model.  The inputs are returned when the receiver does not define a format which requires parsing
+func NewReceiverFormatTransform(id, inputs string, receiver *obs.ReceiverSpec) (framework.Element, string) {
+	vrl := receiverFormatVRL(receiver)
+	if vrl == "" {
+		return framework.Nil, inputs
+	}
+	formatID := helpers.MakeID(id, "format")
+	return elements.Remap{
+		ComponentID: formatID,
+		Inputs:      helpers.MakeInputs(inputs),
+		VRL:         vrl,
+	}, formatID
+}
+
+func receiverFormatVRL(receiver *obs.ReceiverSpec) string {
+	switch receiver.Type {
+	case obs.ReceiverTypeSyslog:
+		if receiver.Syslog == nil || (receiver.Syslog.RFC == "" && receiver.Syslog.MessageFormat == "") {
+			return ""
+		}
+		vrls := []string{}
+		// Only records in RFC5424 format have a version
+		switch receiver.Syslog.RFC {
+		case obs.SyslogRFC3164:
+			vrls = append(vrls, `if exists(.version) { abort }`)
+		case obs.SyslogRFC5424:
+			vrls = append(vrls, `if !exists(.version) { abort }`)
+		}
+		vrls = append(vrls, syslogLevel)
+		switch receiver.Syslog.MessageFormat {
+		case obs.SyslogMessageFormatJSON:
+			vrls = append(vrls, parseJSONMessage)
+		case obs.SyslogMessageFormatCEF:
+			vrls = append(vrls, parseCEFMessage)
+		case obs.SyslogMessageFormatLEEF:
+			vrls = append(vrls, parseLEEFMessage)
+		}
+		return strings.Join(helpers.TrimSpaces(vrls), "\n")
+	case obs.ReceiverTypeHTTP:
+		switch receiver.HTTP.Format {
+		case obs.HTTPReceiverFormatJSON:
+			return strings.TrimSpace(httpJSONStructured)
+		case obs.HTTPReceiverFormatCEF:
+			return strings.TrimSpace(parseCEFMessage)
+		case obs.HTTPReceiverFormatLEEF:
+			return strings.TrimSpace(parseLEEFMessage)
+		}
+	}
+	return ""
+}
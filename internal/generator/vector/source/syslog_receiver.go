@@ -12,6 +12,7 @@ func NewSyslogSource(id, inputName string, input obs.InputSpec) framework.Elemen
 		InputName:     inputName,
 		ListenAddress: helpers.ListenOnAllLocalInterfacesAddress(),
 		ListenPort:    input.Receiver.Port,
+		PermitOrigin:  allowedCIDRs(input.Receiver),
 	}
 }
 
@@ -20,6 +21,8 @@ type SyslogReceiver struct {
 	InputName     string
 	ListenAddress string
 	ListenPort    int32
+	// PermitOrigin is the list of CIDRs from which connections are accepted
+	PermitOrigin string
 }
 
 func (SyslogReceiver) Name() string {
@@ -33,6 +36,16 @@ func (i SyslogReceiver) Template() string {
 type = "syslog"
 address = "{{.ListenAddress}}:{{.ListenPort}}"
 mode = "tcp"
+{{- if .PermitOrigin }}
+permit_origin = {{.PermitOrigin}}
+{{- end }}
 {{end}}
 `
 }
+
+func allowedCIDRs(receiver *obs.ReceiverSpec) string {
+	if receiver.ClientAuth == nil || len(receiver.ClientAuth.AllowedCIDRs) == 0 {
+		return ""
+	}
+	return helpers.MakeInputs(receiver.ClientAuth.AllowedCIDRs...)
+}
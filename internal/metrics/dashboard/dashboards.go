@@ -75,7 +75,7 @@ func ReconcileForDashboards(k8sClient client.Client, reader client.Reader) error
 		return fmt.Errorf("failed to get %v configmap: %v", key, err)
 	}
 
-	if err = reconcile.Configmap(k8sClient, reader, cm, comparators.CompareLabels); err != nil {
+	if err = reconcile.Configmap(k8sClient, reader, cm, nil, comparators.CompareLabels); err != nil {
 		return err
 	}
 
@@ -20,5 +20,5 @@ func ReconcileDaemonset(exporter loggingv1alpha1.LogFileMetricExporter,
 	tlsProfile, _ := tls.FetchAPIServerTlsProfile(k8sClient)
 	desired := NewDaemonSet(exporter, namespace, name, tls.GetClusterTLSProfileSpec(tlsProfile), visitors...)
 	utils.AddOwnerRefToObject(desired, owner)
-	return reconcile.DaemonSet(k8sClient, desired)
+	return reconcile.DaemonSet(k8sClient, desired, nil)
 }
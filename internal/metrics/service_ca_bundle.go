@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReconcileServiceCABundle reconciles the configmap into which the service CA bundle is injected for the user workload
+// Prometheus to verify the metrics endpoints.  The data of the configmap is owned by the service CA operator
+func ReconcileServiceCABundle(k8sClient client.Client, namespace, name string, owner metav1.OwnerReference) error {
+	desired := runtime.NewConfigMap(namespace, name, nil)
+	desired.Annotations = map[string]string{constants.AnnotationInjectCABundle: "true"}
+	utils.AddOwnerRefToObject(desired, owner)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &corev1.ConfigMap{}
+		key := client.ObjectKeyFromObject(desired)
+		if err := k8sClient.Get(context.TODO(), key, current); err != nil {
+			if errors.IsNotFound(err) {
+				return k8sClient.Create(context.TODO(), desired)
+			}
+			return fmt.Errorf("failed to get %v ConfigMap: %w", key, err)
+		}
+		if current.Annotations[constants.AnnotationInjectCABundle] == "true" && utils.HasSameOwner(current.OwnerReferences, desired.OwnerReferences) {
+			return nil
+		}
+		if current.Annotations == nil {
+			current.Annotations = map[string]string{}
+		}
+		current.Annotations[constants.AnnotationInjectCABundle] = "true"
+		current.OwnerReferences = desired.OwnerReferences
+		return k8sClient.Update(context.TODO(), current)
+	})
+}
+
+// RemoveServiceCABundle removes the configmap of the service CA bundle
+func RemoveServiceCABundle(k8sClient client.Client, namespace, name string) error {
+	if err := k8sClient.Delete(context.TODO(), runtime.NewConfigMap(namespace, name, nil)); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failure deleting configmap %s/%s: %v", namespace, name, err)
+	}
+	return nil
+}
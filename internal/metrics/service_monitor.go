@@ -8,6 +8,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -75,3 +76,35 @@ func ReconcileServiceMonitor(k8sClient client.Client, namespace, name, component
 	desired := NewServiceMonitor(namespace, name, component, portName, owner)
 	return reconcile.ServiceMonitor(k8sClient, desired)
 }
+
+// ReconcileServiceMonitorWithCA reconciles the ServiceMonitor of a metrics endpoint which is verified with the given CA
+// instead of the CA file of the platform Prometheus, which is not accessible to the user workload Prometheus
+func ReconcileServiceMonitorWithCA(k8sClient client.Client, namespace, name, component, portName string, ca monitoringv1.SecretOrConfigMap, owner metav1.OwnerReference) error {
+	desired := NewServiceMonitor(namespace, name, component, portName, owner)
+	for i := range desired.Spec.Endpoints {
+		tlsConfig := desired.Spec.Endpoints[i].TLSConfig
+		tlsConfig.CAFile = ""
+		tlsConfig.CA = ca
+	}
+	return reconcile.ServiceMonitor(k8sClient, desired)
+}
+
+// SecretCA returns the CA (ca.crt) of the given secret
+func SecretCA(secretName string) monitoringv1.SecretOrConfigMap {
+	return monitoringv1.SecretOrConfigMap{
+		Secret: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+			Key:                  constants.CACertKey,
+		},
+	}
+}
+
+// ServiceCABundleCA returns the service CA injected into the given configmap
+func ServiceCABundleCA(configMapName string) monitoringv1.SecretOrConfigMap {
+	return monitoringv1.SecretOrConfigMap{
+		ConfigMap: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+			Key:                  constants.ServiceCABundleKey,
+		},
+	}
+}
@@ -67,4 +67,14 @@ var _ = Describe("Reconcile ServiceMonitor", func() {
 		Expect(smInstance.Spec.Endpoints[0].TLSConfig.SafeTLSConfig.ServerName).
 			To(Equal(svcURL))
 	})
+
+	It("should verify the metrics endpoint with the CA of a provided serving certificate", func() {
+		Expect(ReconcileServiceMonitorWithCA(reqClient, constants.OpenshiftNS, serviceName, component, portName, SecretCA("my-cert"), owner)).To(Succeed())
+
+		Expect(reqClient.Get(context.TODO(), serviceMonitorKey, smInstance)).Should(Succeed())
+		tlsConfig := smInstance.Spec.Endpoints[0].TLSConfig
+		Expect(tlsConfig.CAFile).To(BeEmpty())
+		Expect(tlsConfig.CA.Secret.Name).To(Equal("my-cert"))
+		Expect(tlsConfig.CA.Secret.Key).To(Equal(constants.CACertKey))
+	})
 })
@@ -6,6 +6,7 @@ import (
 	log "github.com/ViaQ/logerr/v2/log/static"
 	loggingv1alpha1 "github.com/openshift/cluster-logging-operator/api/logging/v1alpha1"
 	observabilityv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/prometheus/client_golang/prometheus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,6 +16,9 @@ import (
 const (
 	boolYes = "1"
 	boolNo  = "0"
+
+	workloadDaemonSet  = "daemonset"
+	workloadDeployment = "deployment"
 )
 
 type telemetryCollector struct {
@@ -39,6 +43,8 @@ func (t *telemetryCollector) Describe(descs chan<- *prometheus.Desc) {
 	descs <- forwarderPipelinesDesc
 	descs <- forwarderInputTypeDesc
 	descs <- forwarderOutputTypeDesc
+	descs <- forwarderFilterTypeDesc
+	descs <- forwarderCollectorInfoDesc
 }
 
 func (t *telemetryCollector) Collect(m chan<- prometheus.Metric) {
@@ -96,6 +102,24 @@ func (t *telemetryCollector) collectForwarder(m chan<- prometheus.Metric) error
 			m <- prometheus.MustNewConstMetric(forwarderOutputTypeDesc, prometheus.GaugeValue, float64(c),
 				t.version, clf.Namespace, clf.Name, string(output))
 		}
+
+		filterTypes := map[observabilityv1.FilterType]int{}
+		for _, f := range clf.Spec.Filters {
+			count := filterTypes[f.Type]
+			filterTypes[f.Type] = count + 1
+		}
+
+		for filter, c := range filterTypes {
+			m <- prometheus.MustNewConstMetric(forwarderFilterTypeDesc, prometheus.GaugeValue, float64(c),
+				t.version, clf.Namespace, clf.Name, string(filter))
+		}
+
+		workload := workloadDaemonSet
+		if internalobs.DeployAsDeployment(clf) {
+			workload = workloadDeployment
+		}
+		m <- prometheus.MustNewConstMetric(forwarderCollectorInfoDesc, prometheus.GaugeValue, 1.0,
+			t.version, clf.Namespace, clf.Name, constants.VectorName, workload)
 	}
 
 	return nil
@@ -72,6 +72,16 @@ log_file_metric_exporter_info{deployed="0",healthStatus="0",version="test-versio
 								Type: observabilityv1.OutputTypeLokiStack,
 							},
 						},
+						Filters: []observabilityv1.FilterSpec{
+							{
+								Name: "drop",
+								Type: observabilityv1.FilterTypeDrop,
+							},
+							{
+								Name: "prune",
+								Type: observabilityv1.FilterTypePrune,
+							},
+						},
 						Pipelines: []observabilityv1.PipelineSpec{
 							{
 								Name: "pipeline",
@@ -97,6 +107,13 @@ log_file_metric_exporter_info{deployed="0",healthStatus="0",version="test-versio
 				wantMetrics := `# HELP log_file_metric_exporter_info Info metric containing information about usage the file metric exporter. Value is always 1.
 # TYPE log_file_metric_exporter_info gauge
 log_file_metric_exporter_info{deployed="0",healthStatus="0",version="test-version"} 1
+# HELP log_forwarder_collector_info Info metric containing information about the collector deployed for a forwarder. Value is always 1.
+# TYPE log_forwarder_collector_info gauge
+log_forwarder_collector_info{collector="vector",resource_name="test-name",resource_namespace="test-namespace",version="test-version",workload="daemonset"} 1
+# HELP log_forwarder_filter_type Shows which filter types a forwarder uses.
+# TYPE log_forwarder_filter_type gauge
+log_forwarder_filter_type{filter="drop",resource_name="test-name",resource_namespace="test-namespace",version="test-version"} 1
+log_forwarder_filter_type{filter="prune",resource_name="test-name",resource_namespace="test-namespace",version="test-version"} 1
 # HELP log_forwarder_input_type Shows which input types a forwarder uses.
 # TYPE log_forwarder_input_type gauge
 log_forwarder_input_type{input="application",resource_name="test-name",resource_namespace="test-namespace",version="test-version"} 1
@@ -18,8 +18,11 @@ const (
 	labelHealthStatus = "healthStatus"
 	labelDeployed     = "deployed"
 
-	labelInput  = "input"
-	labelOutput = "output"
+	labelInput     = "input"
+	labelOutput    = "output"
+	labelFilter    = "filter"
+	labelCollector = "collector"
+	labelWorkload  = "workload"
 )
 
 var (
@@ -44,6 +47,16 @@ var (
 		"Shows which output types a forwarder uses.",
 		[]string{labelVersion, labelResourceNamespace, labelResourceName, labelOutput}, nil,
 	)
+	forwarderFilterTypeDesc = prometheus.NewDesc(
+		metricsPrefix+"forwarder_filter_type",
+		"Shows which filter types a forwarder uses.",
+		[]string{labelVersion, labelResourceNamespace, labelResourceName, labelFilter}, nil,
+	)
+	forwarderCollectorInfoDesc = prometheus.NewDesc(
+		metricsPrefix+"forwarder_collector_info",
+		"Info metric containing information about the collector deployed for a forwarder. Value is always 1.",
+		[]string{labelVersion, labelResourceNamespace, labelResourceName, labelCollector, labelWorkload}, nil,
+	)
 )
 
 // Setup initializes the telemetry collector and registers it with the given Prometheus registry.
@@ -0,0 +1,231 @@
+package network
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/reconcile"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// lokiStackGatewayPort is the port of the gateway service of a LokiStack
+	lokiStackGatewayPort = 8080
+)
+
+var (
+	// defaultSchemePorts are the ports of outputs whose URL does not specify one
+	defaultSchemePorts = map[string]int32{
+		"http":  80,
+		"https": 443,
+		"tcp":   9092,
+		"tls":   6514,
+		"udp":   514,
+		"udps":  6514,
+	}
+
+	// clusterEgressPorts are the ports of cluster services used by every collector:  DNS and the API server
+	clusterEgressPorts = []networkingv1.NetworkPolicyPort{
+		policyPort(corev1.ProtocolUDP, 53),
+		policyPort(corev1.ProtocolTCP, 53),
+		policyPort(corev1.ProtocolUDP, 5353),
+		policyPort(corev1.ProtocolTCP, 5353),
+		policyPort(corev1.ProtocolTCP, 443),
+		policyPort(corev1.ProtocolTCP, 6443),
+	}
+)
+
+// ReconcileNetworkPolicy reconciles the NetworkPolicy of the collector pods of a forwarder.  The policy is removed
+// when the forwarder does not define one
+func ReconcileNetworkPolicy(k8sClient client.Client, namespace, name, instance string, spec obs.ClusterLogForwarderSpec, metricsPort int32, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+	if spec.NetworkPolicy == nil {
+		policy := &networkingv1.NetworkPolicy{}
+		runtime.Initialize(policy, namespace, name)
+		if err := k8sClient.Delete(context.TODO(), policy); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+	desired := NewNetworkPolicy(namespace, name, instance, spec, metricsPort, visitors)
+	utils.AddOwnerRefToObject(desired, owner)
+	return reconcile.NetworkPolicy(k8sClient, desired)
+}
+
+// NewNetworkPolicy generates the NetworkPolicy of the collector pods of a forwarder for the rule set of the forwarder
+func NewNetworkPolicy(namespace, name, instance string, spec obs.ClusterLogForwarderSpec, metricsPort int32, visitors func(o runtime.Object)) *networkingv1.NetworkPolicy {
+	policy := &networkingv1.NetworkPolicy{}
+	runtime.Initialize(policy, namespace, name, visitors)
+	policy.Spec = networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{
+			MatchLabels: runtime.Selectors(instance, constants.CollectorName, constants.VectorName),
+		},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+		Ingress:     []networkingv1.NetworkPolicyIngressRule{{}},
+		Egress:      egressRules(spec),
+	}
+	if spec.NetworkPolicy.RuleSet != obs.NetworkPolicyRuleSetTypeRestrictIngressEgress {
+		return policy
+	}
+	ingress := []networkingv1.NetworkPolicyPort{policyPort(corev1.ProtocolTCP, metricsPort)}
+	for _, input := range spec.Inputs {
+		if input.Type == obs.InputTypeReceiver && input.Receiver != nil {
+			ingress = append(ingress, policyPort(corev1.ProtocolTCP, input.Receiver.Port))
+		}
+	}
+	policy.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{Ports: uniquePorts(ingress)}}
+	return policy
+}
+
+// ReconcileAggregatorNetworkPolicy reconciles the NetworkPolicy of the aggregator pods of a forwarder.  The policy is
+// reconciled whether or not the forwarder defines one because the aggregator does not authenticate the collectors
+// which forward the logs
+func ReconcileAggregatorNetworkPolicy(k8sClient client.Client, namespace, name, instance, collectorInstance string, spec obs.ClusterLogForwarderSpec, metricsPort int32, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+	desired := NewAggregatorNetworkPolicy(namespace, name, instance, collectorInstance, spec, metricsPort, visitors)
+	utils.AddOwnerRefToObject(desired, owner)
+	return reconcile.NetworkPolicy(k8sClient, desired)
+}
+
+// NewAggregatorNetworkPolicy generates the NetworkPolicy of the aggregator pods of a forwarder which only admits the
+// collector pods of the forwarder to the aggregator port.  Egress follows the rule set of the forwarder
+func NewAggregatorNetworkPolicy(namespace, name, instance, collectorInstance string, spec obs.ClusterLogForwarderSpec, metricsPort int32, visitors func(o runtime.Object)) *networkingv1.NetworkPolicy {
+	policy := &networkingv1.NetworkPolicy{}
+	runtime.Initialize(policy, namespace, name, visitors)
+	policy.Spec = networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{
+			MatchLabels: runtime.Selectors(instance, constants.CollectorName, constants.VectorName),
+		},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+		Ingress: []networkingv1.NetworkPolicyIngressRule{
+			{
+				Ports: []networkingv1.NetworkPolicyPort{policyPort(corev1.ProtocolTCP, metricsPort)},
+			},
+			{
+				Ports: []networkingv1.NetworkPolicyPort{policyPort(corev1.ProtocolTCP, constants.AggregatorPort)},
+				From: []networkingv1.NetworkPolicyPeer{
+					{
+						PodSelector: &metav1.LabelSelector{
+							MatchLabels: runtime.Selectors(collectorInstance, constants.CollectorName, constants.VectorName),
+						},
+					},
+				},
+			},
+		},
+		Egress: egressRules(spec),
+	}
+	return policy
+}
+
+// egressRules are the egress rules of the pods of a forwarder for its rule set
+func egressRules(spec obs.ClusterLogForwarderSpec) []networkingv1.NetworkPolicyEgressRule {
+	if spec.NetworkPolicy == nil || spec.NetworkPolicy.RuleSet != obs.NetworkPolicyRuleSetTypeRestrictIngressEgress {
+		return []networkingv1.NetworkPolicyEgressRule{{}}
+	}
+	egress := append([]networkingv1.NetworkPolicyPort{}, clusterEgressPorts...)
+	if spec.Collector != nil && spec.Collector.Aggregator != nil {
+		// the collectors forward the logs to the aggregator
+		egress = append(egress, policyPort(corev1.ProtocolTCP, constants.AggregatorPort))
+	}
+	for _, o := range spec.Outputs {
+		egress = append(egress, outputPorts(o)...)
+	}
+	return []networkingv1.NetworkPolicyEgressRule{{Ports: uniquePorts(egress)}}
+}
+
+// outputPorts are the ports the collector connects to for an output
+func outputPorts(o obs.OutputSpec) (ports []networkingv1.NetworkPolicyPort) {
+	urls := []string{}
+	switch o.Type {
+	case obs.OutputTypeCloudwatch:
+		urls = append(urls, o.Cloudwatch.URL)
+	case obs.OutputTypeElasticsearch:
+		urls = append(urls, o.Elasticsearch.URL)
+	case obs.OutputTypeHTTP:
+		urls = append(urls, o.HTTP.URL)
+	case obs.OutputTypeKafka:
+		urls = append(urls, o.Kafka.URL)
+		for _, broker := range o.Kafka.Brokers {
+			urls = append(urls, string(broker))
+		}
+	case obs.OutputTypeLoki:
+		urls = append(urls, o.Loki.URL)
+	case obs.OutputTypeLokiStack:
+		return []networkingv1.NetworkPolicyPort{policyPort(corev1.ProtocolTCP, lokiStackGatewayPort)}
+	case obs.OutputTypeOTLP:
+		urls = append(urls, o.OTLP.URL)
+	case obs.OutputTypeSplunk:
+		urls = append(urls, o.Splunk.URL)
+	case obs.OutputTypeSyslog:
+		urls = append(urls, o.Syslog.URL)
+	}
+	for _, u := range urls {
+		if port, found := urlPort(u); found {
+			ports = append(ports, port)
+		}
+	}
+	if len(ports) == 0 {
+		// Outputs of cloud services without a URL, or with a URL without a port, are reached over HTTPS
+		ports = append(ports, policyPort(corev1.ProtocolTCP, 443))
+	}
+	return ports
+}
+
+func urlPort(rawURL string) (networkingv1.NetworkPolicyPort, bool) {
+	if rawURL == "" {
+		return networkingv1.NetworkPolicyPort{}, false
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return networkingv1.NetworkPolicyPort{}, false
+	}
+	scheme := strings.ToLower(u.Scheme)
+	protocol := corev1.ProtocolTCP
+	if scheme == "udp" || scheme == "udps" {
+		protocol = corev1.ProtocolUDP
+	}
+	if port, err := strconv.ParseInt(u.Port(), 10, 32); err == nil {
+		return policyPort(protocol, int32(port)), true
+	}
+	if port, found := defaultSchemePorts[scheme]; found {
+		return policyPort(protocol, port), true
+	}
+	return networkingv1.NetworkPolicyPort{}, false
+}
+
+func policyPort(protocol corev1.Protocol, port int32) networkingv1.NetworkPolicyPort {
+	return networkingv1.NetworkPolicyPort{
+		Protocol: utils.GetPtr(protocol),
+		Port:     utils.GetPtr(intstr.FromInt32(port)),
+	}
+}
+
+// uniquePorts removes duplicate ports and sorts them so the policy is stable
+func uniquePorts(ports []networkingv1.NetworkPolicyPort) []networkingv1.NetworkPolicyPort {
+	seen := map[string]bool{}
+	unique := []networkingv1.NetworkPolicyPort{}
+	for _, port := range ports {
+		key := string(*port.Protocol) + "/" + port.Port.String()
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, port)
+		}
+	}
+	sort.Slice(unique, func(i, j int) bool {
+		if unique[i].Port.IntVal != unique[j].Port.IntVal {
+			return unique[i].Port.IntVal < unique[j].Port.IntVal
+		}
+		return *unique[i].Protocol < *unique[j].Protocol
+	})
+	return unique
+}
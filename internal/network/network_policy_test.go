@@ -0,0 +1,155 @@
+package network
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("#NewNetworkPolicy", func() {
+
+	const (
+		name        = "my-forwarder"
+		metricsPort = int32(24231)
+	)
+
+	var (
+		spec obs.ClusterLogForwarderSpec
+
+		commonLabels = func(o runtime.Object) {
+			runtime.SetCommonLabels(o, constants.VectorName, name, constants.CollectorName)
+		}
+
+		ports = func(rules ...string) []networkingv1.NetworkPolicyPort {
+			result := []networkingv1.NetworkPolicyPort{}
+			for i := 0; i < len(rules); i += 2 {
+				port, _ := urlPort(rules[i+1] + "://host:" + rules[i])
+				result = append(result, port)
+			}
+			return result
+		}
+	)
+
+	BeforeEach(func() {
+		spec = obs.ClusterLogForwarderSpec{
+			NetworkPolicy: &obs.NetworkPolicy{RuleSet: obs.NetworkPolicyRuleSetTypeRestrictIngressEgress},
+			Inputs: []obs.InputSpec{
+				{Name: "http", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeHTTP, Port: 8443}},
+			},
+			Outputs: []obs.OutputSpec{
+				{Name: "es", Type: obs.OutputTypeElasticsearch, Elasticsearch: &obs.Elasticsearch{URLSpec: obs.URLSpec{URL: "https://es.example.com:9200"}}},
+				{Name: "http", Type: obs.OutputTypeHTTP, HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "https://logs.example.com"}}},
+				{Name: "syslog", Type: obs.OutputTypeSyslog, Syslog: &obs.Syslog{URL: "udp://syslog.example.com:1514"}},
+				{Name: "gcl", Type: obs.OutputTypeGoogleCloudLogging, GoogleCloudLogging: &obs.GoogleCloudLogging{}},
+			},
+		}
+	})
+
+	It("should select the collector pods of the forwarder", func() {
+		policy := NewNetworkPolicy(constants.OpenshiftNS, name, name, spec, metricsPort, commonLabels)
+		Expect(policy.Spec.PodSelector.MatchLabels).To(HaveKeyWithValue(constants.LabelK8sInstance, name))
+		Expect(policy.Spec.PodSelector.MatchLabels).To(HaveKeyWithValue(constants.LabelK8sComponent, constants.CollectorName))
+		Expect(policy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress))
+	})
+
+	It("should allow all traffic for the AllowAllIngressEgress rule set", func() {
+		spec.NetworkPolicy.RuleSet = obs.NetworkPolicyRuleSetTypeAllowAllIngressEgress
+		policy := NewNetworkPolicy(constants.OpenshiftNS, name, name, spec, metricsPort, commonLabels)
+		Expect(policy.Spec.Ingress).To(Equal([]networkingv1.NetworkPolicyIngressRule{{}}))
+		Expect(policy.Spec.Egress).To(Equal([]networkingv1.NetworkPolicyEgressRule{{}}))
+	})
+
+	It("should only allow ingress to the metrics and receiver ports for the RestrictIngressEgress rule set", func() {
+		policy := NewNetworkPolicy(constants.OpenshiftNS, name, name, spec, metricsPort, commonLabels)
+		Expect(policy.Spec.Ingress).To(Equal([]networkingv1.NetworkPolicyIngressRule{{Ports: ports("8443", "tcp", "24231", "tcp")}}))
+	})
+
+	It("should only allow egress to the output ports, DNS and the API server for the RestrictIngressEgress rule set", func() {
+		policy := NewNetworkPolicy(constants.OpenshiftNS, name, name, spec, metricsPort, commonLabels)
+		Expect(policy.Spec.Egress).To(Equal([]networkingv1.NetworkPolicyEgressRule{{Ports: ports(
+			"53", "tcp", "53", "udp",
+			"443", "tcp",
+			"1514", "udp",
+			"5353", "tcp", "5353", "udp",
+			"6443", "tcp",
+			"9200", "tcp",
+		)}}))
+	})
+
+	It("should allow egress to the aggregator port when the forwarder deploys an aggregator", func() {
+		spec.Collector = &obs.CollectorSpec{Aggregator: &obs.AggregatorSpec{}}
+		policy := NewNetworkPolicy(constants.OpenshiftNS, name, name, spec, metricsPort, commonLabels)
+		Expect(policy.Spec.Ingress[0].Ports).ToNot(ContainElement(ports("24240", "tcp")[0]))
+		Expect(policy.Spec.Egress[0].Ports).To(ContainElement(ports("24240", "tcp")[0]))
+	})
+
+	Context("#NewAggregatorNetworkPolicy", func() {
+
+		const aggregatorName = name + "-aggregator"
+
+		BeforeEach(func() {
+			spec.Collector = &obs.CollectorSpec{Aggregator: &obs.AggregatorSpec{}}
+		})
+
+		It("should only admit the collector pods of the forwarder to the aggregator port", func() {
+			policy := NewAggregatorNetworkPolicy(constants.OpenshiftNS, aggregatorName, aggregatorName, name, spec, metricsPort, commonLabels)
+			Expect(policy.Spec.PodSelector.MatchLabels).To(HaveKeyWithValue(constants.LabelK8sInstance, aggregatorName))
+			Expect(policy.Spec.Ingress).To(Equal([]networkingv1.NetworkPolicyIngressRule{
+				{Ports: ports("24231", "tcp")},
+				{
+					Ports: ports("24240", "tcp"),
+					From: []networkingv1.NetworkPolicyPeer{
+						{PodSelector: &metav1.LabelSelector{MatchLabels: runtime.Selectors(name, constants.CollectorName, constants.VectorName)}},
+					},
+				},
+			}))
+		})
+
+		It("should restrict ingress when the forwarder does not define a policy", func() {
+			spec.NetworkPolicy = nil
+			policy := NewAggregatorNetworkPolicy(constants.OpenshiftNS, aggregatorName, aggregatorName, name, spec, metricsPort, commonLabels)
+			Expect(policy.Spec.Ingress).To(HaveLen(2))
+			Expect(policy.Spec.Ingress[1].From).ToNot(BeEmpty())
+			Expect(policy.Spec.Egress).To(Equal([]networkingv1.NetworkPolicyEgressRule{{}}))
+		})
+
+		It("should restrict egress for the RestrictIngressEgress rule set", func() {
+			policy := NewAggregatorNetworkPolicy(constants.OpenshiftNS, aggregatorName, aggregatorName, name, spec, metricsPort, commonLabels)
+			Expect(policy.Spec.Egress).To(Equal(NewNetworkPolicy(constants.OpenshiftNS, name, name, spec, metricsPort, commonLabels).Spec.Egress))
+		})
+	})
+
+	Context("#ReconcileNetworkPolicy", func() {
+		It("should remove the policy when the forwarder does not define one", func() {
+			owner := metav1.OwnerReference{Name: name}
+			k8sClient := fake.NewFakeClient()
+			Expect(ReconcileNetworkPolicy(k8sClient, constants.OpenshiftNS, name, name, spec, metricsPort, owner, commonLabels)).To(Succeed())
+			key := types.NamespacedName{Namespace: constants.OpenshiftNS, Name: name}
+			Expect(k8sClient.Get(context.TODO(), key, &networkingv1.NetworkPolicy{})).To(Succeed())
+
+			spec.NetworkPolicy = nil
+			Expect(ReconcileNetworkPolicy(k8sClient, constants.OpenshiftNS, name, name, spec, metricsPort, owner, commonLabels)).To(Succeed())
+			err := k8sClient.Get(context.TODO(), key, &networkingv1.NetworkPolicy{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("#urlPort", func() {
+	It("should default the port by scheme", func() {
+		port, found := urlPort("https://logs.example.com")
+		Expect(found).To(BeTrue())
+		Expect(port.Port.IntVal).To(BeEquivalentTo(443))
+		Expect(*port.Protocol).To(Equal(corev1.ProtocolTCP))
+	})
+})
@@ -0,0 +1,69 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reconcileInputRoute reconciles a route to the service of a receiver input.  The route re-encrypts traffic to
+// the serving certificate of the service issued by the cluster's cert signing service, which is trusted by the router,
+// or passes it through when the receiver uses its own certificate
+func reconcileInputRoute(k8sClient client.Client, service *v1.Service, host string, passthrough bool, owner metav1.OwnerReference) error {
+	desired := runtime.NewRoute(service.Namespace, service.Name, service.Name, strconv.Itoa(int(service.Spec.Ports[0].Port)))
+	desired.Labels = service.Labels
+	desired.Spec.Host = host
+	desired.Spec.TLS = &routev1.TLSConfig{
+		Termination:                   routev1.TLSTerminationReencrypt,
+		InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyNone,
+	}
+	if passthrough {
+		desired.Spec.TLS.Termination = routev1.TLSTerminationPassthrough
+	}
+	utils.AddOwnerRefToObject(desired, owner)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &routev1.Route{}
+		if err := k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(desired), current); err != nil {
+			if errors.IsNotFound(err) {
+				return k8sClient.Create(context.TODO(), desired)
+			}
+			return fmt.Errorf("failed to get %v Route: %w", client.ObjectKeyFromObject(desired), err)
+		}
+		if desired.Spec.Host == "" {
+			// Keep the host generated by the cluster ingress
+			desired.Spec.Host = current.Spec.Host
+		}
+		desired.Spec.WildcardPolicy = current.Spec.WildcardPolicy
+		desired.Spec.To.Weight = current.Spec.To.Weight
+		if reflect.DeepEqual(current.Spec, desired.Spec) && reflect.DeepEqual(current.Labels, desired.Labels) &&
+			reflect.DeepEqual(current.OwnerReferences, desired.OwnerReferences) {
+			log.V(3).Info("Route is the same skipping update")
+			return nil
+		}
+		current.Labels = desired.Labels
+		current.Spec = desired.Spec
+		current.OwnerReferences = desired.OwnerReferences
+		return k8sClient.Update(context.TODO(), current)
+	})
+}
+
+// RemoveInputRoute removes the route to the service of a receiver input
+func RemoveInputRoute(k8sClient client.Client, namespace, name string) error {
+	route := runtime.NewRoute(namespace, name, name, "")
+	if err := k8sClient.Delete(context.TODO(), route); err != nil && !errors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		return err
+	}
+	return nil
+}
@@ -47,7 +47,41 @@ func ReconcileService(k8sClient client.Client, namespace, name, instanceName, co
 	return reconcile.Service(k8sClient, desired)
 }
 
-func ReconcileInputService(k8sClient client.Client, namespace, name, instance, certSecretName string, port, targetPort int32, receiverType obs.ReceiverType, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+// ReconcileAggregatorService reconciles the service that exposes the metrics of the aggregator and the port on which
+// it receives the logs forwarded by the collectors
+func ReconcileAggregatorService(k8sClient client.Client, namespace, name, instanceName, metricsPortName, certSecretName string, metricsPort int32, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+	desired := factory.NewService(
+		name,
+		namespace,
+		constants.CollectorName,
+		instanceName,
+		[]v1.ServicePort{
+			{
+				Port:       metricsPort,
+				TargetPort: intstr.FromString(metricsPortName),
+				Name:       metricsPortName,
+			},
+			{
+				Port:       constants.AggregatorPort,
+				TargetPort: intstr.FromInt32(constants.AggregatorPort),
+				Name:       "aggregator",
+				Protocol:   v1.ProtocolTCP,
+			},
+		},
+		withServiceTypeLabel(constants.ServiceTypeMetrics),
+		visitors,
+	)
+
+	desired.Annotations = map[string]string{
+		constants.AnnotationServingCertSecretName: certSecretName,
+	}
+	utils.AddOwnerRefToObject(desired, owner)
+	return reconcile.Service(k8sClient, desired)
+}
+
+// ReconcileInputService reconciles the service, and the route when requested, that exposes a receiver input
+func ReconcileInputService(k8sClient client.Client, namespace, name, instance, certSecretName string, port, targetPort int32, receiver obs.ReceiverSpec, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+	receiverType, expose := receiver.Type, receiver.Expose
 	desired := factory.NewService(
 		name,
 		namespace,
@@ -73,7 +107,34 @@ func ReconcileInputService(k8sClient client.Client, namespace, name, instance, c
 	desired.Annotations = map[string]string{
 		constants.AnnotationServingCertSecretName: certSecretName,
 	}
+	desired.Spec.Type = v1.ServiceTypeClusterIP
+	if expose != nil {
+		switch expose.Type {
+		case obs.ReceiverExposeTypeNodePort:
+			desired.Spec.Type = v1.ServiceTypeNodePort
+		case obs.ReceiverExposeTypeLoadBalancer:
+			desired.Spec.Type = v1.ServiceTypeLoadBalancer
+		}
+		if desired.Spec.Type != v1.ServiceTypeClusterIP {
+			desired.Spec.Ports[0].NodePort = expose.NodePort
+			desired.Spec.ExternalTrafficPolicy = v1.ServiceExternalTrafficPolicyCluster
+			// Preserve the address of clients so the collector is able to verify it is allowed
+			if receiver.ClientAuth != nil && len(receiver.ClientAuth.AllowedCIDRs) > 0 {
+				desired.Spec.ExternalTrafficPolicy = v1.ServiceExternalTrafficPolicyLocal
+				if desired.Spec.Type == v1.ServiceTypeLoadBalancer {
+					desired.Spec.LoadBalancerSourceRanges = receiver.ClientAuth.AllowedCIDRs
+				}
+			}
+		}
+	}
 
 	utils.AddOwnerRefToObject(desired, owner)
-	return reconcile.Service(k8sClient, desired)
+	if err := reconcile.Service(k8sClient, desired); err != nil {
+		return err
+	}
+	if expose != nil && expose.Type == obs.ReceiverExposeTypeRoute {
+		passthrough := receiver.TLS != nil && receiver.TLS.Certificate != nil
+		return reconcileInputRoute(k8sClient, desired, expose.Host, passthrough, owner)
+	}
+	return RemoveInputRoute(k8sClient, namespace, name)
 }
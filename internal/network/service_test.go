@@ -6,11 +6,15 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	routev1 "github.com/openshift/api/route/v1"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -74,3 +78,95 @@ var _ = Describe("Reconcile Service", func() {
 	})
 
 })
+
+var _ = Describe("Reconcile Input Service", func() {
+
+	const (
+		serviceName = "my-forwarder-http"
+		port        = int32(8443)
+	)
+
+	var (
+		k8sClient client.Client
+		receiver  obs.ReceiverSpec
+		owner     = metav1.OwnerReference{Name: "my-forwarder"}
+		key       = types.NamespacedName{Name: serviceName, Namespace: constants.OpenshiftNS}
+
+		commonLabels = func(o runtime.Object) {
+			runtime.SetCommonLabels(o, "vector", "my-forwarder", constants.CollectorName)
+		}
+		reconcileService = func() *corev1.Service {
+			Expect(ReconcileInputService(k8sClient, constants.OpenshiftNS, serviceName, "my-forwarder", serviceName, port, port, receiver, owner, commonLabels)).To(Succeed())
+			service := &corev1.Service{}
+			Expect(k8sClient.Get(context.TODO(), key, service)).To(Succeed())
+			return service
+		}
+	)
+
+	BeforeEach(func() {
+		k8sClient = fake.NewFakeClient()
+		receiver = obs.ReceiverSpec{Type: obs.ReceiverTypeHTTP, Port: port, HTTP: &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatKubeAPIAudit}}
+	})
+
+	It("should expose the receiver by a ClusterIP service by default", func() {
+		Expect(reconcileService().Spec.Type).To(Equal(corev1.ServiceTypeClusterIP))
+	})
+
+	It("should expose the receiver by a NodePort service with the defined node port", func() {
+		receiver.Expose = &obs.ReceiverExposeSpec{Type: obs.ReceiverExposeTypeNodePort, NodePort: 30443}
+		service := reconcileService()
+		Expect(service.Spec.Type).To(Equal(corev1.ServiceTypeNodePort))
+		Expect(service.Spec.Ports[0].NodePort).To(BeEquivalentTo(30443))
+	})
+
+	It("should keep the allocated node port of a LoadBalancer service", func() {
+		receiver.Expose = &obs.ReceiverExposeSpec{Type: obs.ReceiverExposeTypeLoadBalancer}
+		service := reconcileService()
+		Expect(service.Spec.Type).To(Equal(corev1.ServiceTypeLoadBalancer))
+		service.Spec.Ports[0].NodePort = 31000
+		Expect(k8sClient.Update(context.TODO(), service)).To(Succeed())
+
+		Expect(reconcileService().Spec.Ports[0].NodePort).To(BeEquivalentTo(31000))
+	})
+
+	It("should restrict a LoadBalancer service to the allowed CIDRs and preserve the address of clients", func() {
+		receiver.Expose = &obs.ReceiverExposeSpec{Type: obs.ReceiverExposeTypeLoadBalancer}
+		receiver.ClientAuth = &obs.ReceiverClientAuthSpec{AllowedCIDRs: []string{"10.0.0.0/16"}}
+		service := reconcileService()
+		Expect(service.Spec.LoadBalancerSourceRanges).To(Equal([]string{"10.0.0.0/16"}))
+		Expect(service.Spec.ExternalTrafficPolicy).To(Equal(corev1.ServiceExternalTrafficPolicyLocal))
+
+		receiver.ClientAuth = nil
+		service = reconcileService()
+		Expect(service.Spec.LoadBalancerSourceRanges).To(BeEmpty())
+		Expect(service.Spec.ExternalTrafficPolicy).To(Equal(corev1.ServiceExternalTrafficPolicyCluster))
+	})
+
+	It("should expose the receiver by a re-encrypt route", func() {
+		receiver.Expose = &obs.ReceiverExposeSpec{Type: obs.ReceiverExposeTypeRoute, Host: "audit.apps.example.com"}
+		reconcileService()
+		route := &routev1.Route{}
+		Expect(k8sClient.Get(context.TODO(), key, route)).To(Succeed())
+		Expect(route.Spec.Host).To(Equal("audit.apps.example.com"))
+		Expect(route.Spec.To.Name).To(Equal(serviceName))
+		Expect(route.Spec.TLS.Termination).To(Equal(routev1.TLSTerminationReencrypt))
+	})
+
+	It("should pass traffic through the route when the receiver uses its own certificate", func() {
+		receiver.Expose = &obs.ReceiverExposeSpec{Type: obs.ReceiverExposeTypeRoute}
+		receiver.TLS = &obs.InputTLSSpec{Certificate: &obs.ValueReference{Key: "tls.crt", SecretName: "mycert"}}
+		reconcileService()
+		route := &routev1.Route{}
+		Expect(k8sClient.Get(context.TODO(), key, route)).To(Succeed())
+		Expect(route.Spec.TLS.Termination).To(Equal(routev1.TLSTerminationPassthrough))
+	})
+
+	It("should remove the route when the receiver is no longer exposed by one", func() {
+		receiver.Expose = &obs.ReceiverExposeSpec{Type: obs.ReceiverExposeTypeRoute}
+		reconcileService()
+		receiver.Expose = nil
+		reconcileService()
+		err := k8sClient.Get(context.TODO(), key, &routev1.Route{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,183 @@
+package forwarder
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	"github.com/openshift/cluster-logging-operator/internal/controller/observability"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	validations "github.com/openshift/cluster-logging-operator/internal/validations/observability"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// GeneratePath is the path at which the generator service renders the collector config of a forwarder
+	GeneratePath = "/v1/generate"
+
+	// maxRequestSize is the maximum size of the forwarder posted to the generator service
+	maxRequestSize = 4 << 20
+)
+
+// NewHandler returns the handler of the generator service which renders the collector config of the ClusterLogForwarder
+// posted as YAML or JSON.  The forwarder is posted by itself or as a List together with the Secrets and ConfigMaps it
+// references.  The config is rendered by the same initialization, validation and generator the operator runs in-process
+// so a management plane can render the configs of its managed clusters centrally.  Requests must present the token as
+// a bearer token because the posted secrets are rendered into the config
+func NewHandler(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(GeneratePath, authenticate(token, http.HandlerFunc(generate)))
+	return mux
+}
+
+// authenticate rejects the requests which do not present the token as their bearer token
+func authenticate(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bearer, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" || !found || subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "the request must present a valid bearer token", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func generate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "the forwarder must be posted", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if len(body) == 0 {
+		http.Error(w, "the request does not contain a forwarder", http.StatusBadRequest)
+		return
+	}
+	forwarder, objects, err := decodeRequest(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	conf, err := GenerateForwarder(forwarder, objects...)
+	if err != nil {
+		log.V(3).Error(err, "Unable to generate log configuration")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/toml")
+	if _, err = io.WriteString(w, conf); err != nil {
+		log.V(3).Error(err, "Unable to write the generated configuration")
+	}
+}
+
+// decodeRequest decodes the forwarder of a request, and the secrets and configmaps of a request posted as a List
+func decodeRequest(body []byte) (forwarder *obs.ClusterLogForwarder, objects []client.Object, err error) {
+	typeMeta := metav1.TypeMeta{}
+	if err = yaml.Unmarshal(body, &typeMeta); err != nil {
+		return nil, nil, fmt.Errorf("unable to decode the request: %v", err)
+	}
+	if typeMeta.Kind != "List" {
+		forwarder = &obs.ClusterLogForwarder{}
+		if err = yaml.Unmarshal(body, forwarder); err != nil {
+			return nil, nil, fmt.Errorf("unable to decode the forwarder: %v", err)
+		}
+		return forwarder, nil, nil
+	}
+	list := &corev1.List{}
+	if err = yaml.Unmarshal(body, list); err != nil {
+		return nil, nil, fmt.Errorf("unable to decode the list: %v", err)
+	}
+	for i, item := range list.Items {
+		if err = yaml.Unmarshal(item.Raw, &typeMeta); err != nil {
+			return nil, nil, fmt.Errorf("unable to decode items[%d]: %v", i, err)
+		}
+		var object client.Object
+		switch typeMeta.Kind {
+		case "ClusterLogForwarder":
+			if forwarder != nil {
+				return nil, nil, fmt.Errorf("items[%d] is a second forwarder", i)
+			}
+			forwarder = &obs.ClusterLogForwarder{}
+			object = forwarder
+		case "Secret":
+			object = &corev1.Secret{}
+		case "ConfigMap":
+			object = &corev1.ConfigMap{}
+		default:
+			return nil, nil, fmt.Errorf("items[%d] is a %q, only a forwarder, secrets and configmaps are supported", i, typeMeta.Kind)
+		}
+		if err = yaml.Unmarshal(item.Raw, object); err != nil {
+			return nil, nil, fmt.Errorf("unable to decode items[%d]: %v", i, err)
+		}
+		if object != forwarder {
+			objects = append(objects, object)
+		}
+	}
+	if forwarder == nil {
+		return nil, nil, fmt.Errorf("the list does not contain a forwarder")
+	}
+	return forwarder, objects, nil
+}
+
+// GenerateForwarder renders the config of the primary collector of a forwarder with the generator options of the
+// operator.  The forwarder is initialized and validated against the given secrets and configmaps instead of those of a
+// cluster.  The permissions of the serviceAccount are not validated because they only exist on the cluster to which the
+// forwarder is deployed
+func GenerateForwarder(forwarder *obs.ClusterLogForwarder, objects ...client.Object) (string, error) {
+	for _, object := range objects {
+		if object.GetNamespace() == "" {
+			object.SetNamespace(forwarder.Namespace)
+		}
+	}
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return "", err
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	reconciler := &observability.ClusterLogForwarderReconciler{
+		ForwarderContext: internalcontext.ForwarderContext{
+			Client:    k8sClient,
+			Reader:    k8sClient,
+			Forwarder: forwarder,
+		},
+	}
+	if err := reconciler.Initialize(); err != nil {
+		return "", fmt.Errorf("unable to initialize the forwarder: %v", err)
+	}
+	validations.ValidateClusterLogForwarderSpec(reconciler.ForwarderContext)
+	if failures := validationFailures(reconciler.Forwarder.Status); len(failures) > 0 {
+		return "", fmt.Errorf("the forwarder is not valid: %s", strings.Join(failures, ", "))
+	}
+
+	context := reconciler.ForwarderContext
+	resourceNames := factory.ResourceNames(*context.Forwarder)
+	return observability.GeneratePrimaryConfig(context, *resourceNames, observability.GeneratorOptions(context, resourceNames))
+}
+
+// validationFailures returns the messages of the failed validations of a forwarder
+func validationFailures(status obs.ClusterLogForwarderStatus) (failures []string) {
+	for _, conditions := range [][]metav1.Condition{status.Conditions, status.Inputs, status.Outputs, status.Filters, status.Pipelines} {
+		for _, condition := range conditions {
+			if condition.Status == obs.ConditionFalse {
+				failures = append(failures, fmt.Sprintf("%s: %s", condition.Type, condition.Message))
+			}
+		}
+	}
+	return failures
+}
@@ -0,0 +1,183 @@
+package forwarder
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Generator service", func() {
+
+	const (
+		token = "my-token"
+
+		forwarder = `
+apiVersion: observability.openshift.io/v1
+kind: ClusterLogForwarder
+metadata:
+  name: my-forwarder
+  namespace: openshift-logging
+spec:
+  serviceAccount:
+    name: my-collector
+  outputs:
+  - name: my-http
+    type: http
+    http:
+      url: http://http-receiver.openshift-logging.svc:8090
+  pipelines:
+  - name: my-pipeline
+    inputRefs:
+    - application
+    outputRefs:
+    - my-http
+`
+
+		forwarderWithSecrets = `
+apiVersion: v1
+kind: List
+items:
+- apiVersion: observability.openshift.io/v1
+  kind: ClusterLogForwarder
+  metadata:
+    name: my-forwarder
+    namespace: openshift-logging
+  spec:
+    serviceAccount:
+      name: my-collector
+    outputs:
+    - name: my-http
+      type: http
+      http:
+        url: https://http-receiver.openshift-logging.svc:8090
+        authentication:
+          username:
+            secretName: my-secret
+            key: username
+          password:
+            secretName: my-secret
+            key: password
+    pipelines:
+    - name: my-pipeline
+      inputRefs:
+      - application
+      outputRefs:
+      - my-http
+- apiVersion: v1
+  kind: Secret
+  metadata:
+    name: my-secret
+  data:
+    username: bXktdXNlcg==
+    password: bXktcGFzc3dvcmQ=
+`
+	)
+
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		server = httptest.NewServer(NewHandler(token))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	request := func(method, bearer, body string) (*http.Response, string) {
+		req, err := http.NewRequest(method, server.URL+GeneratePath, strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Content-Type", "application/yaml")
+		if bearer != "" {
+			req.Header.Set("Authorization", "Bearer "+bearer)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		content, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return resp, string(content)
+	}
+
+	post := func(body string) (*http.Response, string) {
+		return request(http.MethodPost, token, body)
+	}
+
+	It("should render the collector config of the posted forwarder", func() {
+		resp, conf := post(forwarder)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get("Content-Type")).To(Equal("application/toml"))
+		clf, objects, err := decodeRequest([]byte(forwarder))
+		Expect(err).ToNot(HaveOccurred())
+		expConf, err := GenerateForwarder(clf, objects...)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conf).To(Equal(expConf))
+		Expect(conf).To(ContainSubstring("[sinks.output_my_http]"))
+	})
+
+	It("should render the collector config the operator deploys for a forwarder with an aggregator", func() {
+		resp, conf := post(strings.Replace(forwarder, "  serviceAccount:\n", "  collector:\n    aggregator: {}\n  serviceAccount:\n", 1))
+		Expect(resp.StatusCode).To(Equal(http.StatusOK), conf)
+		Expect(conf).To(ContainSubstring("my-forwarder-aggregator.openshift-logging.svc"))
+		Expect(conf).ToNot(ContainSubstring("[sinks.output_my_http]"))
+	})
+
+	It("should render the collector config of a forwarder posted with its secrets", func() {
+		resp, conf := post(forwarderWithSecrets)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK), conf)
+		Expect(conf).To(ContainSubstring(`[sinks.output_my_http.auth]`))
+		Expect(conf).To(ContainSubstring(`SECRET[kubernetes_secret.my-secret/username]`))
+	})
+
+	It("should reject a forwarder whose referenced secrets are not posted", func() {
+		resp, message := post(strings.Split(forwarderWithSecrets, "- apiVersion: v1\n  kind: Secret")[0])
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		Expect(message).To(ContainSubstring("the forwarder is not valid"))
+		Expect(message).To(ContainSubstring("my-secret"))
+	})
+
+	It("should reject a forwarder which fails the validations of the operator", func() {
+		resp, message := post(strings.Replace(forwarder, "    - my-http\n", "    - my-missing-output\n", 1))
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		Expect(message).To(ContainSubstring("my-missing-output"))
+	})
+
+	It("should reject a list of unsupported objects", func() {
+		resp, message := post(strings.Replace(forwarderWithSecrets, "kind: Secret", "kind: Pod", 1))
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		Expect(message).To(ContainSubstring(`"Pod"`))
+	})
+
+	It("should reject a request without a forwarder", func() {
+		resp, _ := post("")
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should reject a forwarder that can not be unmarshalled", func() {
+		resp, _ := post("spec: [")
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should only accept posted forwarders", func() {
+		resp, _ := request(http.MethodGet, token, "")
+		Expect(resp.StatusCode).To(Equal(http.StatusMethodNotAllowed))
+		Expect(resp.Header.Get("Allow")).To(Equal(http.MethodPost))
+	})
+
+	It("should reject requests without the bearer token", func() {
+		resp, _ := request(http.MethodPost, "", forwarder)
+		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+		resp, _ = request(http.MethodPost, "not-my-token", forwarder)
+		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("should reject every request when no token is configured", func() {
+		server.Close()
+		server = httptest.NewServer(NewHandler(""))
+		resp, _ := request(http.MethodPost, "", forwarder)
+		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+})
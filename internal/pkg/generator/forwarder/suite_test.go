@@ -0,0 +1,13 @@
+package forwarder
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestForwarder(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Forwarder Generator Service")
+}
@@ -3,6 +3,8 @@ package reconcile
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	"github.com/openshift/cluster-logging-operator/internal/utils/comparators"
@@ -10,10 +12,16 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/set"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func Configmap(k8Client client.Client, reader client.Reader, configMap *corev1.ConfigMap, opts ...comparators.ComparisonOption) error {
+// Configmap reconciles a ConfigMap to the desired state returning an error if there is an issue creating or
+// updating to the desired state.  Modifications made outside of the operator are recorded by the drift detector
+func Configmap(k8Client client.Client, reader client.Reader, configMap *corev1.ConfigMap, drift *DriftDetector, opts ...comparators.ComparisonOption) error {
+	if err := drift.setDesiredHash(configMap, []interface{}{configMap.Data, configMap.Labels, configMap.Annotations, configMap.OwnerReferences}); err != nil {
+		return err
+	}
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		current := &corev1.ConfigMap{}
 		key := client.ObjectKeyFromObject(configMap)
@@ -23,7 +31,8 @@ func Configmap(k8Client client.Client, reader client.Reader, configMap *corev1.C
 			}
 			return fmt.Errorf("Failed to get %v configmap: %v", key, err)
 		}
-		if configmaps.AreSame(current, configMap, opts...) && utils.HasSameOwner(current.OwnerReferences, configMap.OwnerReferences) {
+		same := configmaps.AreSame(current, configMap, opts...) && utils.HasSameOwner(current.OwnerReferences, configMap.OwnerReferences)
+		if !drift.shouldUpdate("ConfigMap", current, configMap, same, configMapDiff(current, configMap)) {
 			return nil
 		} else {
 			current.Data = configMap.Data
@@ -34,3 +43,24 @@ func Configmap(k8Client client.Client, reader client.Reader, configMap *corev1.C
 		return k8Client.Update(context.TODO(), current)
 	})
 }
+
+// configMapDiff summarizes the keys and metadata that differ between the current and desired configmap
+func configMapDiff(current, desired *corev1.ConfigMap) string {
+	fields := []string{}
+	keys := set.KeySet(current.Data).Union(set.KeySet(desired.Data))
+	for _, key := range keys.SortedList() {
+		if current.Data[key] != desired.Data[key] {
+			fields = append(fields, "data."+key)
+		}
+	}
+	if !reflect.DeepEqual(current.Labels, desired.Labels) {
+		fields = append(fields, "labels")
+	}
+	if !reflect.DeepEqual(current.Annotations, desired.Annotations) {
+		fields = append(fields, "annotations")
+	}
+	if !utils.HasSameOwner(current.OwnerReferences, desired.OwnerReferences) {
+		fields = append(fields, "ownerReferences")
+	}
+	return strings.Join(fields, ",")
+}
@@ -13,8 +13,12 @@ import (
 )
 
 // DaemonSet reconciles a DaemonSet to the desired spec returning an error
-// if there is an issue creating or updating to the desired state
-func DaemonSet(k8Client client.Client, desired *apps.DaemonSet) error {
+// if there is an issue creating or updating to the desired state.  Modifications made outside of
+// the operator are recorded by the drift detector
+func DaemonSet(k8Client client.Client, desired *apps.DaemonSet, drift *DriftDetector) error {
+	if err := drift.setDesiredHash(desired, []interface{}{desired.Labels, desired.Spec, desired.OwnerReferences}); err != nil {
+		return err
+	}
 	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		current := &apps.DaemonSet{}
 		key := client.ObjectKeyFromObject(desired)
@@ -24,12 +28,12 @@ func DaemonSet(k8Client client.Client, desired *apps.DaemonSet) error {
 			}
 			return fmt.Errorf("failed to get %v DaemonSet: %w", key, err)
 		}
-		same := false
-
-		if same, _ = daemonsets.AreSame(current, desired); same {
+		same, fields := daemonsets.AreSame(current, desired)
+		if !drift.shouldUpdate("DaemonSet", current, desired, same, fields) {
 			log.V(3).Info("DaemonSets are the same skipping update", "daemonsetName", current.Name)
 			return nil
 		}
+		setAppliedHash(current, desired)
 		current.Labels = desired.Labels
 		current.Spec = desired.Spec
 		current.OwnerReferences = desired.OwnerReferences
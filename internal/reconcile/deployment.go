@@ -13,8 +13,12 @@ import (
 )
 
 // Deployment reconciles a Deployment to the desired spec returning an error
-// if there is an issue creating or updating to the desired state
-func Deployment(k8Client client.Client, desired *apps.Deployment) error {
+// if there is an issue creating or updating to the desired state.  Modifications made outside of
+// the operator are recorded by the drift detector
+func Deployment(k8Client client.Client, desired *apps.Deployment, drift *DriftDetector) error {
+	if err := drift.setDesiredHash(desired, []interface{}{desired.Labels, desired.Spec, desired.OwnerReferences}); err != nil {
+		return err
+	}
 	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		current := &apps.Deployment{}
 		key := client.ObjectKeyFromObject(desired)
@@ -24,12 +28,12 @@ func Deployment(k8Client client.Client, desired *apps.Deployment) error {
 			}
 			return fmt.Errorf("failed to get %v Deployment: %w", key, err)
 		}
-		same := false
-
-		if same, _ = deployments.AreSame(current, desired); same {
+		same, fields := deployments.AreSame(current, desired)
+		if !drift.shouldUpdate("Deployment", current, desired, same, fields) {
 			log.V(3).Info("Deployments are the same skipping update", "deploymentName", current.Name)
 			return nil
 		}
+		setAppliedHash(current, desired)
 		current.Labels = desired.Labels
 		current.Spec = desired.Spec
 		current.OwnerReferences = desired.OwnerReferences
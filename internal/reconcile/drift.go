@@ -0,0 +1,106 @@
+package reconcile
+
+import (
+	"encoding/json"
+	"fmt"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationDesiredHash is the hash of the desired state of a managed resource that was last applied by the operator
+const AnnotationDesiredHash = "observability.openshift.io/desired-hash"
+
+// DriftPolicy determines how modifications of a managed resource made outside of the operator are handled
+type DriftPolicy string
+
+const (
+	// DriftPolicyRevert reverts the modifications to the desired state
+	DriftPolicyRevert DriftPolicy = "Revert"
+
+	// DriftPolicyReport reports the modifications and leaves the resource unchanged
+	DriftPolicyReport DriftPolicy = "Report"
+)
+
+// Drift is a modification of a managed resource made outside of the operator
+type Drift struct {
+	Kind string
+	Name string
+	// Fields summarizes the modified fields
+	Fields string
+}
+
+func (d Drift) String() string {
+	return fmt.Sprintf("%s %s modified %s", d.Kind, d.Name, d.Fields)
+}
+
+// DriftDetector records modifications of managed resources made outside of the operator.  A resource has drifted
+// when it differs from its desired state even though the desired state has not changed since it was last applied.
+// A nil DriftDetector does not detect drift
+type DriftDetector struct {
+	Policy DriftPolicy
+	Drifts []Drift
+}
+
+// setDesiredHash annotates the desired resource with the hash of its desired state
+func (d *DriftDetector) setDesiredHash(desired metav1.Object, state interface{}) error {
+	if d == nil {
+		return nil
+	}
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	hash, err := utils.CalculateMD5Hash(string(raw))
+	if err != nil {
+		return err
+	}
+	annotations := desired.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[AnnotationDesiredHash] = hash
+	desired.SetAnnotations(annotations)
+	return nil
+}
+
+// shouldUpdate evaluates if the current resource must be updated to the desired state.  A resource that is the same
+// as the desired state is only updated to record the hash of the desired state.  A resource that has drifted is not
+// updated when the policy is to report drift
+func (d *DriftDetector) shouldUpdate(kind string, current, desired metav1.Object, same bool, fields string) bool {
+	if d == nil {
+		return !same
+	}
+	hash := desired.GetAnnotations()[AnnotationDesiredHash]
+	applied := current.GetAnnotations()[AnnotationDesiredHash]
+	if same || applied != hash {
+		return applied != hash
+	}
+	d.record(Drift{Kind: kind, Name: current.GetName(), Fields: fields})
+	return d.Policy != DriftPolicyReport
+}
+
+func (d *DriftDetector) record(drift Drift) {
+	for _, recorded := range d.Drifts {
+		if recorded.Kind == drift.Kind && recorded.Name == drift.Name {
+			return
+		}
+	}
+	log.V(1).Info("Detected modification of a managed resource", "kind", drift.Kind, "name", drift.Name, "fields", drift.Fields, "policy", d.Policy)
+	d.Drifts = append(d.Drifts, drift)
+}
+
+// setAppliedHash records the hash of the desired state on the current resource
+func setAppliedHash(current, desired metav1.Object) {
+	hash, found := desired.GetAnnotations()[AnnotationDesiredHash]
+	if !found {
+		return
+	}
+	annotations := current.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[AnnotationDesiredHash] = hash
+	current.SetAnnotations(annotations)
+}
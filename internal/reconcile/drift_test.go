@@ -0,0 +1,75 @@
+package reconcile_test
+
+import (
+	"context"
+
+	"github.com/openshift/cluster-logging-operator/internal/reconcile"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	core "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("reconciling with drift detection", func() {
+
+	var (
+		k8sClient client.Client
+		desired   *core.ConfigMap
+	)
+
+	newDesired := func(value string) *core.ConfigMap {
+		return runtime.NewConfigMap("test-namespace", "collector-config", map[string]string{"vector.toml": value})
+	}
+
+	current := func() *core.ConfigMap {
+		cm := &core.ConfigMap{}
+		Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(desired), cm)).To(Succeed())
+		return cm
+	}
+
+	modify := func() {
+		cm := current()
+		cm.Data["vector.toml"] = "modified"
+		Expect(k8sClient.Update(context.TODO(), cm)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		k8sClient = fake.NewClientBuilder().Build()
+		desired = newDesired("desired")
+		Expect(reconcile.Configmap(k8sClient, k8sClient, desired, &reconcile.DriftDetector{})).To(Succeed())
+		Expect(current().Annotations).To(HaveKey(reconcile.AnnotationDesiredHash))
+	})
+
+	It("should revert and record modifications made outside of the operator", func() {
+		modify()
+		drift := &reconcile.DriftDetector{Policy: reconcile.DriftPolicyRevert}
+		Expect(reconcile.Configmap(k8sClient, k8sClient, newDesired("desired"), drift)).To(Succeed())
+		Expect(drift.Drifts).To(Equal([]reconcile.Drift{{Kind: "ConfigMap", Name: "collector-config", Fields: "data.vector.toml"}}))
+		Expect(current().Data["vector.toml"]).To(Equal("desired"))
+	})
+
+	It("should only record modifications when the policy is to report them", func() {
+		modify()
+		drift := &reconcile.DriftDetector{Policy: reconcile.DriftPolicyReport}
+		Expect(reconcile.Configmap(k8sClient, k8sClient, newDesired("desired"), drift)).To(Succeed())
+		Expect(drift.Drifts).To(HaveLen(1))
+		Expect(current().Data["vector.toml"]).To(Equal("modified"))
+	})
+
+	It("should update without recording drift when the desired state changes", func() {
+		modify()
+		drift := &reconcile.DriftDetector{Policy: reconcile.DriftPolicyReport}
+		Expect(reconcile.Configmap(k8sClient, k8sClient, newDesired("changed"), drift)).To(Succeed())
+		Expect(drift.Drifts).To(BeEmpty())
+		Expect(current().Data["vector.toml"]).To(Equal("changed"))
+	})
+
+	It("should not record drift when the resource is unmodified", func() {
+		drift := &reconcile.DriftDetector{Policy: reconcile.DriftPolicyReport}
+		Expect(reconcile.Configmap(k8sClient, k8sClient, newDesired("desired"), drift)).To(Succeed())
+		Expect(drift.Drifts).To(BeEmpty())
+	})
+})
@@ -0,0 +1,38 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NetworkPolicy reconciles a NetworkPolicy to the desired spec returning an error
+// if there is an issue creating or updating to the desired state
+func NetworkPolicy(k8Client client.Client, desired *networkingv1.NetworkPolicy) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &networkingv1.NetworkPolicy{}
+		key := client.ObjectKeyFromObject(desired)
+		if err := k8Client.Get(context.TODO(), key, current); err != nil {
+			if errors.IsNotFound(err) {
+				return k8Client.Create(context.TODO(), desired)
+			}
+			return fmt.Errorf("failed to get %v NetworkPolicy: %w", key, err)
+		}
+		if equality.Semantic.DeepEqual(current.Spec, desired.Spec) && reflect.DeepEqual(current.Labels, desired.Labels) &&
+			reflect.DeepEqual(current.OwnerReferences, desired.OwnerReferences) {
+			log.V(3).Info("NetworkPolicy is the same skipping update")
+			return nil
+		}
+		current.Labels = desired.Labels
+		current.Spec = desired.Spec
+		current.OwnerReferences = desired.OwnerReferences
+		return k8Client.Update(context.TODO(), current)
+	})
+}
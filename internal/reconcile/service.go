@@ -24,6 +24,14 @@ func Service(k8Client client.Client, desired *corev1.Service) error {
 			}
 			return fmt.Errorf("failed to get %v Service: %w", key, err)
 		}
+		// Keep ports allocated by the cluster which are not explicitly defined
+		if desired.Spec.Type != "" && desired.Spec.Type == current.Spec.Type {
+			for i := range desired.Spec.Ports {
+				if i < len(current.Spec.Ports) && desired.Spec.Ports[i].NodePort == 0 {
+					desired.Spec.Ports[i].NodePort = current.Spec.Ports[i].NodePort
+				}
+			}
+		}
 		same := false
 
 		if same, _ = services.AreSame(current, desired); same {
@@ -34,6 +42,13 @@ func Service(k8Client client.Client, desired *corev1.Service) error {
 		//Explicitly copying because services are immutable
 		current.Labels = desired.Labels
 		current.Spec.Selector = desired.Spec.Selector
+		if desired.Spec.Type != "" {
+			current.Spec.Type = desired.Spec.Type
+		}
+		if desired.Spec.ExternalTrafficPolicy != "" {
+			current.Spec.ExternalTrafficPolicy = desired.Spec.ExternalTrafficPolicy
+		}
+		current.Spec.LoadBalancerSourceRanges = desired.Spec.LoadBalancerSourceRanges
 		current.Spec.Ports = desired.Spec.Ports
 		current.OwnerReferences = desired.OwnerReferences
 		return k8Client.Update(context.TODO(), current)
@@ -0,0 +1,45 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	"github.com/openshift/cluster-logging-operator/internal/utils/comparators/statefulsets"
+	apps "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StatefulSet reconciles a StatefulSet to the desired spec returning an error if there is an issue creating or
+// updating to the desired state.  The volume claim templates of an existing StatefulSet are retained because they
+// can not be modified
+func StatefulSet(k8Client client.Client, desired *apps.StatefulSet, drift *DriftDetector) error {
+	if err := drift.setDesiredHash(desired, []interface{}{desired.Labels, desired.Spec, desired.OwnerReferences}); err != nil {
+		return err
+	}
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &apps.StatefulSet{}
+		key := client.ObjectKeyFromObject(desired)
+		if err := k8Client.Get(context.TODO(), key, current); err != nil {
+			if errors.IsNotFound(err) {
+				return k8Client.Create(context.TODO(), desired)
+			}
+			return fmt.Errorf("failed to get %v StatefulSet: %w", key, err)
+		}
+		same, fields := statefulsets.AreSame(current, desired)
+		if !drift.shouldUpdate("StatefulSet", current, desired, same, fields) {
+			log.V(3).Info("StatefulSets are the same skipping update", "statefulSetName", current.Name)
+			return nil
+		}
+		setAppliedHash(current, desired)
+		claims := current.Spec.VolumeClaimTemplates
+		current.Labels = desired.Labels
+		current.Spec = desired.Spec
+		current.Spec.VolumeClaimTemplates = claims
+		current.OwnerReferences = desired.OwnerReferences
+		return k8Client.Update(context.TODO(), current)
+	})
+	return retryErr
+}
@@ -81,3 +81,10 @@ func NewDeployment(namespace, name string, visitors ...func(o runtime.Object)) *
 	Initialize(dpl, namespace, name, visitors...)
 	return dpl
 }
+
+// NewStatefulSet returns a statefulset
+func NewStatefulSet(namespace, name string, visitors ...func(o runtime.Object)) *appsv1.StatefulSet {
+	sts := &appsv1.StatefulSet{}
+	Initialize(sts, namespace, name, visitors...)
+	return sts
+}
@@ -17,6 +17,12 @@ func AreSame(current *apps.Deployment, desired *apps.Deployment) (bool, string)
 		return false, resource
 	}
 
+	// Check replicas
+	if !reflect.DeepEqual(current.Spec.Replicas, desired.Spec.Replicas) {
+		log.V(3).Info("Deployment replicas change", "name", current.Name)
+		return false, "replicas"
+	}
+
 	// Check labels
 	if !reflect.DeepEqual(current.Labels, desired.Labels) {
 		log.V(3).Info("Deployment labels change", "name", current.Name)
@@ -60,6 +60,16 @@ var _ = Describe("deployments#AreSame", func() {
 		})
 	})
 
+	Context("when evaluating replicas", func() {
+
+		It("should recognize the replicas are different", func() {
+			replicas := int32(3)
+			desired.Spec.Replicas = &replicas
+			ok, _ := deployments.AreSame(current, desired)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
 	Context("when evaluating labels", func() {
 
 		It("should recognize the labels are different", func() {
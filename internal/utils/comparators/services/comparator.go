@@ -3,6 +3,7 @@ package services
 import (
 	"fmt"
 	"reflect"
+	"slices"
 
 	log "github.com/ViaQ/logerr/v2/log/static"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
@@ -21,6 +22,18 @@ func AreSame(current *v1.Service, desired *v1.Service) (bool, string) {
 		log.V(3).Info("Service Selector change", "current name", current.Name)
 		return false, "spec.selector"
 	}
+	if serviceType(current) != serviceType(desired) {
+		log.V(3).Info("Service type change", "current name", current.Name)
+		return false, "spec.type"
+	}
+	if desired.Spec.ExternalTrafficPolicy != "" && current.Spec.ExternalTrafficPolicy != desired.Spec.ExternalTrafficPolicy {
+		log.V(3).Info("Service external traffic policy change", "current name", current.Name)
+		return false, "spec.externalTrafficPolicy"
+	}
+	if !slices.Equal(current.Spec.LoadBalancerSourceRanges, desired.Spec.LoadBalancerSourceRanges) {
+		log.V(3).Info("Service load balancer source ranges change", "current name", current.Name)
+		return false, "spec.loadBalancerSourceRanges"
+	}
 	if len(current.Spec.Ports) != len(desired.Spec.Ports) {
 		return false, "spec.ports"
 	}
@@ -39,3 +52,11 @@ func AreSame(current *v1.Service, desired *v1.Service) (bool, string) {
 
 	return true, ""
 }
+
+// serviceType is the type of the service where an undefined type is the default ClusterIP type
+func serviceType(service *v1.Service) v1.ServiceType {
+	if service.Spec.Type == "" {
+		return v1.ServiceTypeClusterIP
+	}
+	return service.Spec.Type
+}
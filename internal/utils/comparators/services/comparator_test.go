@@ -53,6 +53,19 @@ var _ = Describe("services#AreSame", func() {
 			Expect(ok).To(BeFalse())
 		})
 	})
+	Context("when evaluating the type", func() {
+		It("should recognize an undefined type as ClusterIP", func() {
+			current.Spec.Type = v1.ServiceTypeClusterIP
+			ok, _ := services.AreSame(current, desired)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should recognize they are different", func() {
+			desired.Spec.Type = v1.ServiceTypeLoadBalancer
+			ok, _ := services.AreSame(current, desired)
+			Expect(ok).To(BeFalse())
+		})
+	})
 	Context("when evaluating ServicePorts", func() {
 		It("should recognize they are the same", func() {
 			ok, _ := services.AreSame(current, desired)
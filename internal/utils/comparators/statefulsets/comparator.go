@@ -0,0 +1,40 @@
+package statefulsets
+
+import (
+	"reflect"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	"github.com/openshift/cluster-logging-operator/internal/utils/comparators/pod"
+	apps "k8s.io/api/apps/v1"
+)
+
+// AreSame compares statefulsets for equality and return true equal otherwise false.  The volume claim templates
+// are not compared because they can not be modified
+func AreSame(current *apps.StatefulSet, desired *apps.StatefulSet) (bool, string) {
+
+	// Check pod specs
+	if same, resource := pod.AreSame(&current.Spec.Template.Spec, &desired.Spec.Template.Spec, current.Name); !same {
+		log.V(3).Info("StatefulSet pod spec change", "name", current.Name)
+		return false, resource
+	}
+
+	// Check replicas
+	if !reflect.DeepEqual(current.Spec.Replicas, desired.Spec.Replicas) {
+		log.V(3).Info("StatefulSet replicas change", "name", current.Name)
+		return false, "replicas"
+	}
+
+	// Check labels
+	if !reflect.DeepEqual(current.Labels, desired.Labels) {
+		log.V(3).Info("StatefulSet labels change", "name", current.Name)
+		return false, "labels"
+	}
+
+	// Check ownership
+	if !reflect.DeepEqual(current.GetOwnerReferences(), desired.GetOwnerReferences()) {
+		log.V(3).Info("StatefulSet ownership change", "name", current.Name)
+		return false, "ownerReference"
+	}
+
+	return true, ""
+}
@@ -0,0 +1,66 @@
+package statefulsets_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/cluster-logging-operator/internal/utils/comparators/statefulsets"
+)
+
+var _ = Describe("statefulsets#AreSame", func() {
+
+	var (
+		current, desired *apps.StatefulSet
+	)
+
+	BeforeEach(func() {
+		current = &apps.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					"foo": "bar",
+				},
+			},
+			Spec: apps.StatefulSetSpec{
+				Template: v1.PodTemplateSpec{
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{
+							{},
+						},
+					},
+				},
+			},
+		}
+		desired = current.DeepCopy()
+	})
+
+	It("should recognize the specs are same", func() {
+		ok, _ := statefulsets.AreSame(current, desired)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should recognize the pod specs are different", func() {
+		desired.Spec.Template.Spec.Containers = append(desired.Spec.Template.Spec.Containers, v1.Container{})
+		ok, _ := statefulsets.AreSame(current, desired)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should recognize the replicas are different", func() {
+		replicas := int32(3)
+		desired.Spec.Replicas = &replicas
+		ok, field := statefulsets.AreSame(current, desired)
+		Expect(ok).To(BeFalse())
+		Expect(field).To(Equal("replicas"))
+	})
+
+	It("should ignore the volume claim templates", func() {
+		desired.Spec.VolumeClaimTemplates = []v1.PersistentVolumeClaim{
+			{Spec: v1.PersistentVolumeClaimSpec{Resources: v1.VolumeResourceRequirements{Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")}}}},
+		}
+		ok, _ := statefulsets.AreSame(current, desired)
+		Expect(ok).To(BeTrue())
+	})
+})
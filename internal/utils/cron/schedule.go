@@ -0,0 +1,145 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSearch bounds the search for the next time of a schedule which can never occur (e.g. 30 February)
+const maxSearch = 5 * 366 * 24 * time.Hour
+
+// Schedule is a standard five field cron expression: minute, hour, day of month, month and day of week
+type Schedule struct {
+	minute, hour, dom, month, dow bits
+	// anyDay is true when either the day of month or the day of week is unrestricted, in which case both
+	// must match.  Otherwise, either may match
+	anyDay bool
+}
+
+type bits uint64
+
+func (b bits) has(i int) bool {
+	return b&(1<<uint(i)) != 0
+}
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var (
+	minuteField = field{"minute", 0, 59}
+	hourField   = field{"hour", 0, 23}
+	domField    = field{"day of month", 1, 31}
+	monthField  = field{"month", 1, 12}
+	dowField    = field{"day of week", 0, 7}
+)
+
+// Parse parses a five field cron expression.  Each field is a comma separated list of values, ranges (e.g. 1-5),
+// or '*' optionally followed by a step (e.g. */15).  Day of week 0 and 7 are both Sunday
+func Parse(expr string) (Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("expected 5 fields in cron expression %q, found %d", expr, len(fields))
+	}
+	s := Schedule{}
+	var err error
+	if s.minute, err = minuteField.parse(fields[0]); err != nil {
+		return Schedule{}, err
+	}
+	if s.hour, err = hourField.parse(fields[1]); err != nil {
+		return Schedule{}, err
+	}
+	if s.dom, err = domField.parse(fields[2]); err != nil {
+		return Schedule{}, err
+	}
+	if s.month, err = monthField.parse(fields[3]); err != nil {
+		return Schedule{}, err
+	}
+	if s.dow, err = dowField.parse(fields[4]); err != nil {
+		return Schedule{}, err
+	}
+	if s.dow.has(7) {
+		s.dow |= 1
+	}
+	s.anyDay = strings.HasPrefix(fields[2], "*") || strings.HasPrefix(fields[4], "*")
+	return s, nil
+}
+
+func (f field) parse(expr string) (bits, error) {
+	var result bits
+	for _, part := range strings.Split(expr, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepExpr); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q for %s", stepExpr, f.name)
+			}
+		}
+		low, high := f.min, f.max
+		if rangeExpr != "*" {
+			lowExpr, highExpr, isRange := strings.Cut(rangeExpr, "-")
+			var err error
+			if low, err = f.value(lowExpr); err != nil {
+				return 0, err
+			}
+			high = low
+			if isRange {
+				if high, err = f.value(highExpr); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				high = f.max
+			}
+			if low > high {
+				return 0, fmt.Errorf("invalid range %q for %s", rangeExpr, f.name)
+			}
+		}
+		for i := low; i <= high; i += step {
+			result |= 1 << uint(i)
+		}
+	}
+	return result, nil
+}
+
+func (f field) value(expr string) (int, error) {
+	i, err := strconv.Atoi(expr)
+	if err != nil || i < f.min || i > f.max {
+		return 0, fmt.Errorf("invalid value %q for %s, must be between %d and %d", expr, f.name, f.min, f.max)
+	}
+	return i, nil
+}
+
+// Next returns the first time after t matched by the schedule, or the zero time if there is none
+func (s Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxSearch)
+	for t.Before(limit) {
+		switch {
+		case !s.month.has(int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case !s.hour.has(t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case !s.minute.has(t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s Schedule) matchesDay(t time.Time) bool {
+	dom := s.dom.has(t.Day())
+	dow := s.dow.has(int(t.Weekday()))
+	if s.anyDay {
+		return dom && dow
+	}
+	return dom || dow
+}
@@ -0,0 +1,46 @@
+package cron_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/cluster-logging-operator/internal/utils/cron"
+)
+
+var _ = Describe("cron#Schedule", func() {
+
+	// Monday
+	from := time.Date(2024, 1, 1, 10, 30, 15, 0, time.UTC)
+
+	DescribeTable("#Next", func(expr string, exp time.Time) {
+		schedule, err := cron.Parse(expr)
+		Expect(err).To(BeNil())
+		Expect(schedule.Next(from)).To(Equal(exp))
+	},
+		Entry("every minute", "* * * * *", time.Date(2024, 1, 1, 10, 31, 0, 0, time.UTC)),
+		Entry("every 15 minutes", "*/15 * * * *", time.Date(2024, 1, 1, 10, 45, 0, 0, time.UTC)),
+		Entry("daily", "0 2 * * *", time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC)),
+		Entry("weekly on Sunday as 0", "0 2 * * 0", time.Date(2024, 1, 7, 2, 0, 0, 0, time.UTC)),
+		Entry("weekly on Sunday as 7", "0 2 * * 7", time.Date(2024, 1, 7, 2, 0, 0, 0, time.UTC)),
+		Entry("weekdays", "0 9-17/4 * * 1-5", time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC)),
+		Entry("lists", "5,50 10 * * *", time.Date(2024, 1, 1, 10, 50, 0, 0, time.UTC)),
+		Entry("monthly", "0 0 1 * *", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("day of month or day of week", "0 0 15 * 3", time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)),
+		Entry("leap day", "0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("never", "0 0 30 2 *", time.Time{}),
+	)
+
+	DescribeTable("#Parse should fail", func(expr string) {
+		_, err := cron.Parse(expr)
+		Expect(err).ToNot(BeNil())
+	},
+		Entry("with too few fields", "0 2 * *"),
+		Entry("with a value out of range", "60 * * * *"),
+		Entry("with an inverted range", "0 5-1 * * *"),
+		Entry("with an invalid step", "*/0 * * * *"),
+		Entry("with a name", "0 0 * * MON"),
+	)
+})
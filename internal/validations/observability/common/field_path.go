@@ -0,0 +1,30 @@
+package common
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FieldPath returns the JSONPath-style reference to an entry of a list in the forwarder spec (e.g. spec.outputs[2])
+func FieldPath(list string, index int) string {
+	return fmt.Sprintf("spec.%s[%d]", list, index)
+}
+
+// PrefixMessages prefixes each validation message with the field path of the spec entry it describes
+// so large specs can be debugged from the status alone
+func PrefixMessages(path string, messages []string) []string {
+	results := make([]string, 0, len(messages))
+	for _, m := range messages {
+		results = append(results, fmt.Sprintf("%s: %s", path, m))
+	}
+	return results
+}
+
+// WithFieldPath prefixes the message of a failed condition with the field path of the spec entry it describes
+func WithFieldPath(path string, condition metav1.Condition) metav1.Condition {
+	if condition.Status == metav1.ConditionFalse {
+		condition.Message = fmt.Sprintf("%s: %s", path, condition.Message)
+	}
+	return condition
+}
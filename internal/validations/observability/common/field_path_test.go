@@ -0,0 +1,28 @@
+package common
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("field paths", func() {
+
+	It("should reference an entry of a list in the spec", func() {
+		Expect(FieldPath("outputs", 2)).To(Equal("spec.outputs[2]"))
+	})
+
+	It("should prefix each message with the field path", func() {
+		Expect(PrefixMessages("spec.outputs[2]", []string{"http.url is invalid", "tls.key is missing"})).To(Equal([]string{
+			"spec.outputs[2]: http.url is invalid",
+			"spec.outputs[2]: tls.key is missing",
+		}))
+	})
+
+	It("should only prefix the message of a failed condition", func() {
+		failed := metav1.Condition{Status: metav1.ConditionFalse, Message: "globs must match"}
+		valid := metav1.Condition{Status: metav1.ConditionTrue, Message: `input "foo" is valid`}
+		Expect(WithFieldPath("spec.inputs[0]", failed).Message).To(Equal("spec.inputs[0]: globs must match"))
+		Expect(WithFieldPath("spec.inputs[0]", valid).Message).To(Equal(`input "foo" is valid`))
+	})
+})
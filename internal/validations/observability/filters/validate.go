@@ -1,13 +1,100 @@
 package filters
 
 import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/grok"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
+// encryptKeySize is the size in bytes of the symmetric key supported by the encrypt filter algorithms
+const encryptKeySize = 32
+
 func Validate(context internalcontext.ForwarderContext) {
-	filterMap := internalobs.FilterMap(context.Forwarder.Spec)
-	for _, filter := range filterMap {
-		internalobs.SetCondition(&context.Forwarder.Status.Filters, ValidateFilter(*filter))
+	for i, filter := range context.Forwarder.Spec.Filters {
+		condition := ValidateFilter(filter)
+		if condition.Status == metav1.ConditionTrue {
+			var messages []string
+			switch filter.Type {
+			case obs.FilterTypeEncrypt:
+				messages = validateEncryptKey(filter, context.Secrets)
+			case obs.FilterTypeNamespaceParsers:
+				messages = validateNamespaceParsers(filter, context.ConfigMaps)
+			}
+			if len(messages) > 0 {
+				condition.Status = metav1.ConditionFalse
+				condition.Reason = obs.ReasonValidationFailure
+				condition.Message = fmt.Sprintf("%s: %v", filter.Name, strings.Join(messages, ","))
+			}
+		}
+		internalobs.SetCondition(&context.Forwarder.Status.Filters, common.WithFieldPath(common.FieldPath("filters", i), condition))
+	}
+}
+
+// validateEncryptKey verifies the secret key of an encrypt filter exists and decodes to a key of the supported size
+func validateEncryptKey(filter obs.FilterSpec, secrets map[string]*corev1.Secret) []string {
+	ref := filter.Encrypt.Key
+	messages := common.ValidateValueReference([]*obs.ValueReference{{Key: ref.Key, SecretName: ref.SecretName}}, secrets, nil)
+	if len(messages) > 0 {
+		return messages
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(secrets[ref.SecretName].Data[ref.Key])))
+	if err != nil || len(key) != encryptKeySize {
+		messages = append(messages, fmt.Sprintf("secret[%s.%s] must be a base64 encoded %d byte key", ref.SecretName, ref.Key, encryptKeySize))
+	}
+	return messages
+}
+
+// validateNamespaceParsers verifies the configmap of a namespaceParsers filter exists and registers valid parsers
+func validateNamespaceParsers(filter obs.FilterSpec, configMaps map[string]*corev1.ConfigMap) (messages []string) {
+	configMap, found := configMaps[filter.NamespaceParsers.ConfigMapName]
+	if !found {
+		return []string{fmt.Sprintf("configmap[%s] not found", filter.NamespaceParsers.ConfigMapName)}
+	}
+	parsers, err := internalobs.NamespaceParsers(configMap)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	for _, p := range parsers {
+		switch p.Type {
+		case internalobs.NamespaceParserTypeRegex:
+			if _, err := regexp.Compile(p.Pattern); err != nil || strings.Contains(p.Pattern, "'") {
+				messages = append(messages, fmt.Sprintf("parser %q must have a valid regular expression without single quotes", p.Name))
+			}
+		case internalobs.NamespaceParserTypeGrok:
+			messages = append(messages, validateNamespaceGrokPattern(p)...)
+		case internalobs.NamespaceParserTypeJSONPointer:
+			if !strings.HasPrefix(p.Pattern, "/") {
+				messages = append(messages, fmt.Sprintf("parser %q must have a JSON pointer starting with a '/'", p.Name))
+			}
+		}
+		if errs := validation.IsDNS1123Label(p.Namespace); len(errs) > 0 {
+			messages = append(messages, fmt.Sprintf("parser %q must have a valid namespace: %s", p.Name, strings.Join(errs, ",")))
+		}
+	}
+	return messages
+}
+
+// validateNamespaceGrokPattern verifies the references of the grok pattern of a parser.  The pattern is parsed without
+// the custom patterns and the library of the grok filter, so only the patterns built into the collector can be referenced
+func validateNamespaceGrokPattern(p internalobs.NamespaceParser) (messages []string) {
+	references := grok.References(p.Pattern)
+	if strings.Count(p.Pattern, "%{") != len(references) {
+		messages = append(messages, fmt.Sprintf("parser %q must have a grok pattern with valid references, e.g. %%{WORD:method}", p.Name))
+	}
+	for _, name := range references {
+		if grokCustomNameRegex.MatchString(name) {
+			messages = append(messages, fmt.Sprintf("parser %q must only reference built-in grok patterns: pattern %q is not defined", p.Name, name))
+		}
 	}
+	return messages
 }
@@ -4,16 +4,25 @@ import (
 	"fmt"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/cel"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/grok"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/rollup"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/vrl"
+	"golang.org/x/text/encoding/htmlindex"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/set"
 	"regexp"
 	"strings"
+	"time"
 )
 
 var (
 	// Matches dot delimited paths with alphanumeric & `_`. Any other characters added in a segment will require quotes.
 	// Matches `.kubernetes.namespace_name` & `kubernetes."test-label/with slashes"` & `."@timestamp"`
 	pathExpRegex = regexp.MustCompile(`^(\.[a-zA-Z0-9_]+|\."[^"]+")(\.[a-zA-Z0-9_]+|\."[^"]+")*$`)
+
+	// Matches the names of the custom patterns of grok filters
+	grokCustomNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
 )
 
 func ValidateFilter(spec obs.FilterSpec) (condition metav1.Condition) {
@@ -24,6 +33,32 @@ func ValidateFilter(spec obs.FilterSpec) (condition metav1.Condition) {
 		results = append(results, validateDropFilter(spec)...)
 	case obs.FilterTypePrune:
 		results = append(results, validatePruneFilter(spec)...)
+	case obs.FilterTypeSchedule:
+		results = append(results, validateScheduleFilter(spec)...)
+	case obs.FilterTypeEncrypt:
+		results = append(results, validateEncryptFilter(spec)...)
+	case obs.FilterTypeSanitize:
+		results = append(results, validateSanitizeFilter(spec)...)
+	case obs.FilterTypeRedact:
+		results = append(results, validateRedactFilter(spec)...)
+	case obs.FilterTypeTimestamp:
+		results = append(results, validateTimestampFilter(spec)...)
+	case obs.FilterTypeClockSkew:
+		results = append(results, validateClockSkewFilter(spec)...)
+	case obs.FilterTypeLogMetrics:
+		results = append(results, validateLogMetricsFilter(spec)...)
+	case obs.FilterTypeGrok:
+		results = append(results, validateGrokFilter(spec)...)
+	case obs.FilterTypeCEL:
+		results = append(results, validateCELFilter(spec)...)
+	case obs.FilterTypeVRL:
+		results = append(results, validateVRLFilter(spec)...)
+	case obs.FilterTypeRollup:
+		results = append(results, validateRollupFilter(spec)...)
+	case obs.FilterTypeNamespaceParsers:
+		if spec.NamespaceParsers == nil || spec.NamespaceParsers.ConfigMapName == "" {
+			results = append(results, fmt.Sprintf("%s namespace parsers filter must reference a configmap", spec.Name))
+		}
 	}
 	condition = internalobs.NewConditionFromPrefix(obs.ConditionTypeValidFilterPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("filter %q is valid", spec.Name))
 	if len(results) > 0 {
@@ -41,7 +76,6 @@ func validateDropFilter(filterSpec obs.FilterSpec) (results []string) {
 		results = append(results, fmt.Sprintf("%q drop filter must have at least one test spec'd", filterSpec.Name))
 	}
 
-	var err error
 	// Validate each test
 	for i, dropTest := range filterSpec.DropTestsSpec {
 		testErrors := []string{}
@@ -55,10 +89,11 @@ func validateDropFilter(filterSpec obs.FilterSpec) (results []string) {
 				testErrors = append(testErrors, "only one of matches or notMatches can be defined at once")
 			}
 			// Validate provided regex
+			var err error
 			if testCondition.Matches != "" {
 				_, err = regexp.Compile(testCondition.Matches)
 			} else if testCondition.NotMatches != "" {
-				_, err = regexp.Compile(testCondition.Matches)
+				_, err = regexp.Compile(testCondition.NotMatches)
 			}
 			if err != nil {
 				testErrors = append(testErrors, "matches/notMatches must be a valid regular expression.")
@@ -108,6 +143,313 @@ func validatePruneFilter(filterSpec obs.FilterSpec) (results []string) {
 	return results
 }
 
+// validateScheduleFilter validates the time zone and windows of a schedule filter
+func validateScheduleFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.Schedule == nil || len(filterSpec.Schedule.Windows) == 0 {
+		results = append(results, fmt.Sprintf("%s schedule filter must have at least one window", filterSpec.Name))
+		return results
+	}
+	errList := []string{}
+	if tz := filterSpec.Schedule.TimeZone; tz != "" {
+		if _, err := time.LoadLocation(tz); err != nil {
+			errList = append(errList, fmt.Sprintf("%q is not a valid time zone", tz))
+		}
+	}
+	for i, window := range filterSpec.Schedule.Windows {
+		if window.Start == window.End {
+			errList = append(errList, fmt.Sprintf("window[%d] must start and end at different times", i))
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateEncryptFilter validates the fields of an encrypt filter can be encrypted without breaking the routing of records
+func validateEncryptFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.Encrypt == nil || len(filterSpec.Encrypt.Fields) == 0 {
+		results = append(results, fmt.Sprintf("%s encrypt filter must have at least one field", filterSpec.Name))
+		return results
+	}
+	errList := []string{}
+	routingFields := set.New[obs.FieldPath](".log_type", ".log_source")
+	for _, fieldPath := range filterSpec.Encrypt.Fields {
+		if err := validateFieldPath(fieldPath); err != "" {
+			errList = append(errList, err)
+		} else if routingFields.Has(fieldPath) {
+			errList = append(errList, fmt.Sprintf("%q is required to route records and can not be encrypted", fieldPath))
+		}
+	}
+	if filterSpec.Encrypt.Key == nil {
+		errList = append(errList, "key must be defined")
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateSanitizeFilter validates the fields and charset of a sanitize filter
+func validateSanitizeFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.Sanitize == nil {
+		return results
+	}
+	errList := []string{}
+	for _, fieldPath := range filterSpec.Sanitize.Fields {
+		if err := validateFieldPath(fieldPath); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	if charset := filterSpec.Sanitize.Charset; charset != "" {
+		if _, err := htmlindex.Get(charset); err != nil {
+			errList = append(errList, fmt.Sprintf("%q is not a supported charset", charset))
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateTimestampFilter validates the field, pattern, formats and time zones of a timestamp filter
+func validateTimestampFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.Timestamp == nil || len(filterSpec.Timestamp.Formats) == 0 {
+		results = append(results, fmt.Sprintf("%s timestamp filter must have at least one format", filterSpec.Name))
+		return results
+	}
+	spec := filterSpec.Timestamp
+	errList := []string{}
+	if spec.Field != "" {
+		if err := validateFieldPath(spec.Field); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	if spec.Pattern != "" {
+		if exp, err := regexp.Compile(spec.Pattern); err != nil || strings.Contains(spec.Pattern, "'") {
+			errList = append(errList, fmt.Sprintf("pattern %q must be a valid regular expression without single quotes", spec.Pattern))
+		} else if exp.SubexpIndex("timestamp") < 0 {
+			errList = append(errList, fmt.Sprintf("pattern %q must have a capture group named timestamp", spec.Pattern))
+		}
+	}
+	timeZones := []string{spec.TimeZone}
+	for _, ns := range spec.NamespaceTimeZones {
+		timeZones = append(timeZones, ns.TimeZone)
+	}
+	for _, tz := range timeZones {
+		if tz == "" {
+			continue
+		}
+		if _, err := time.LoadLocation(tz); err != nil {
+			errList = append(errList, fmt.Sprintf("%q is not a valid time zone", tz))
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateClockSkewFilter validates the bounds of a clock skew filter
+func validateClockSkewFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.ClockSkew == nil {
+		return results
+	}
+	errList := []string{}
+	if maxPast := filterSpec.ClockSkew.MaxPast; maxPast != nil && *maxPast < 1 {
+		errList = append(errList, "maxPast must be at least one second")
+	}
+	if maxFuture := filterSpec.ClockSkew.MaxFuture; maxFuture != nil && *maxFuture < 1 {
+		errList = append(errList, "maxFuture must be at least one second")
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateLogMetricsFilter validates the names, fields and patterns of the metrics of a log metrics filter
+func validateLogMetricsFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.LogMetrics == nil || len(filterSpec.LogMetrics.Metrics) == 0 {
+		results = append(results, fmt.Sprintf("%s log metrics filter must have at least one metric", filterSpec.Name))
+		return results
+	}
+	errList := []string{}
+	names := set.New[string]()
+	for _, m := range filterSpec.LogMetrics.Metrics {
+		if names.Has(m.Name) {
+			errList = append(errList, fmt.Sprintf("metric %q is defined more than once", m.Name))
+		}
+		names.Insert(m.Name)
+		if m.Field != "" {
+			if err := validateFieldPath(m.Field); err != "" {
+				errList = append(errList, err)
+			}
+		}
+		if _, err := regexp.Compile(m.Pattern); err != nil || strings.Contains(m.Pattern, "'") {
+			errList = append(errList, fmt.Sprintf("pattern %q of metric %q must be a valid regular expression without single quotes", m.Pattern, m.Name))
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateGrokFilter validates the field, the custom patterns and the references of the patterns of a grok filter
+func validateGrokFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.Grok == nil || len(filterSpec.Grok.Patterns) == 0 {
+		results = append(results, fmt.Sprintf("%s grok filter must have at least one pattern", filterSpec.Name))
+		return results
+	}
+	spec := filterSpec.Grok
+	errList := []string{}
+	if spec.Field != "" {
+		if err := validateFieldPath(spec.Field); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	for name := range spec.CustomPatterns {
+		if !grokCustomNameRegex.MatchString(name) {
+			errList = append(errList, fmt.Sprintf("custom pattern name %q must be lower case alphanumeric characters or '_'", name))
+		} else if _, found := grok.Library[name]; found {
+			errList = append(errList, fmt.Sprintf("custom pattern %q must not replace the pattern of the library", name))
+		}
+	}
+	patterns := append([]string{}, spec.Patterns...)
+	for _, name := range set.KeySet(spec.CustomPatterns).SortedList() {
+		patterns = append(patterns, spec.CustomPatterns[name])
+	}
+	unknown := set.New[string]()
+	for _, pattern := range patterns {
+		for _, name := range grok.References(pattern) {
+			_, isCustom := spec.CustomPatterns[name]
+			_, inLibrary := grok.Library[name]
+			if grokCustomNameRegex.MatchString(name) && !isCustom && !inLibrary && !unknown.Has(name) {
+				unknown.Insert(name)
+				errList = append(errList, fmt.Sprintf("pattern %q is not defined", name))
+			}
+		}
+	}
+	if cycle := grokCustomPatternCycle(spec.CustomPatterns); cycle != "" {
+		errList = append(errList, fmt.Sprintf("custom pattern %q must not reference itself", cycle))
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// grokCustomPatternCycle returns the name of a custom pattern which references itself, directly or indirectly
+func grokCustomPatternCycle(custom map[string]string) string {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		switch state[name] {
+		case visiting:
+			return true
+		case visited:
+			return false
+		}
+		state[name] = visiting
+		for _, ref := range grok.References(custom[name]) {
+			if _, isCustom := custom[ref]; isCustom && visit(ref) {
+				return true
+			}
+		}
+		state[name] = visited
+		return false
+	}
+	for _, name := range set.KeySet(custom).SortedList() {
+		if visit(name) {
+			return name
+		}
+	}
+	return ""
+}
+
+// validateCELFilter validates the expression of a cel filter compiles to a collector condition
+func validateCELFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.CEL == nil || filterSpec.CEL.Expression == "" {
+		return append(results, fmt.Sprintf("%s cel filter must have an expression", filterSpec.Name))
+	}
+	if _, err := cel.Compile(filterSpec.CEL.Expression); err != nil {
+		results = append(results, fmt.Sprintf("%s: invalid expression: %v", filterSpec.Name, err))
+	}
+	return results
+}
+
+// validateVRLFilter validates the source of a vrl filter is free of syntax errors
+func validateVRLFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.VRL == nil {
+		return append(results, fmt.Sprintf("%s vrl filter must have a source", filterSpec.Name))
+	}
+	if err := vrl.Validate(filterSpec.VRL.Source); err != nil {
+		results = append(results, fmt.Sprintf("%s: invalid source: %v", filterSpec.Name, err))
+	}
+	return results
+}
+
+// validateRollupFilter validates the window, the fields and the names of the sums of a rollup filter
+func validateRollupFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.Rollup == nil {
+		return append(results, fmt.Sprintf("%s rollup filter must have a spec", filterSpec.Name))
+	}
+	spec := filterSpec.Rollup
+	errList := []string{}
+	if spec.Window != nil && *spec.Window < 1 {
+		errList = append(errList, fmt.Sprintf("window %d must be at least 1 second", *spec.Window))
+	}
+	for _, f := range spec.GroupBy {
+		if err := validateFieldPath(f); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	names := set.New(rollup.CountField, "@timestamp", "log_type", "log_source", "hostname", "openshift")
+	for _, f := range spec.GroupBy {
+		names.Insert(strings.Trim(strings.SplitN(strings.TrimPrefix(string(f), "."), ".", 2)[0], `"`))
+	}
+	for _, sum := range spec.Sums {
+		if names.Has(sum.Name) {
+			errList = append(errList, fmt.Sprintf("sum %q must not be named after another field of the summary records", sum.Name))
+		}
+		names.Insert(sum.Name)
+		if err := validateFieldPath(sum.Field); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
+// validateRedactFilter validates the fields and the patterns of a redact filter
+func validateRedactFilter(filterSpec obs.FilterSpec) (results []string) {
+	if filterSpec.Redact == nil || len(filterSpec.Redact.Patterns) == 0 {
+		return append(results, fmt.Sprintf("%s redact filter must have at least one pattern", filterSpec.Name))
+	}
+	errList := []string{}
+	for _, fieldPath := range filterSpec.Redact.Fields {
+		if err := validateFieldPath(fieldPath); err != "" {
+			errList = append(errList, err)
+		}
+	}
+	for _, pattern := range filterSpec.Redact.Patterns {
+		if _, err := regexp.Compile(pattern); err != nil || strings.Contains(pattern, "'") {
+			errList = append(errList, fmt.Sprintf("pattern %q must be a valid regular expression without single quotes", pattern))
+		}
+	}
+	if len(errList) != 0 {
+		results = append(results, fmt.Sprintf("%s: %v", filterSpec.Name, errList))
+	}
+	return results
+}
+
 // validateFieldPath validates a field path for correctness
 func validateFieldPath(fieldPath obs.FieldPath) string {
 	path := string(fieldPath)
@@ -5,13 +5,24 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+	"time"
 )
 
 var _ = Describe("[internal][validations] ClusterLogForwarder: Filters", func() {
 	const (
 		myDrop             = "dropFilter"
 		myPrune            = "pruneFilter"
+		mySchedule         = "scheduleFilter"
+		myEncrypt          = "encryptFilter"
+		mySanitize         = "sanitizeFilter"
+		myRedact           = "redactFilter"
+		myTimestamp        = "timestampFilter"
+		myClockSkew        = "clockSkewFilter"
+		myLogMetrics       = "logMetricsFilter"
+		myGrok             = "grokFilter"
 		expConditionTypeRE = obs.ConditionTypeValidFilterPrefix + "-.*"
 	)
 
@@ -96,6 +107,19 @@ var _ = Describe("[internal][validations] ClusterLogForwarder: Filters", func()
 				},
 				"[matches/notMatches must be a valid regular expression.]",
 			),
+			Entry("should fail validation if notMatches contains an invalid regular expression",
+				[]obs.DropTest{
+					{
+						DropConditions: []obs.DropCondition{
+							{
+								Field:      ".level",
+								NotMatches: "(warn|error",
+							},
+						},
+					},
+				},
+				"[matches/notMatches must be a valid regular expression.]",
+			),
 		)
 
 		DescribeTable("valid drop filter spec", func(dropTests []obs.DropTest) {
@@ -347,4 +371,324 @@ var _ = Describe("[internal][validations] ClusterLogForwarder: Filters", func()
 		})
 
 	})
+	Context("#validateScheduleFilter", func() {
+		DescribeTable("invalid schedule filter spec", func(schedule *obs.ScheduleFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:     mySchedule,
+				Type:     obs.FilterTypeSchedule,
+				Schedule: schedule,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("without windows", &obs.ScheduleFilterSpec{}, "schedule filter must have at least one window"),
+			Entry("with an unknown time zone", &obs.ScheduleFilterSpec{
+				TimeZone: "Mars/Olympus_Mons",
+				Windows:  []obs.TimeWindow{{Start: "09:00", End: "17:00"}},
+			}, ".*is not a valid time zone.*"),
+			Entry("with an empty window", &obs.ScheduleFilterSpec{
+				Windows: []obs.TimeWindow{{Start: "09:00", End: "09:00"}},
+			}, ".*must start and end at different times.*"),
+		)
+
+		It("should pass validation for windows spanning midnight", func() {
+			spec := obs.FilterSpec{
+				Name: mySchedule,
+				Type: obs.FilterTypeSchedule,
+				Schedule: &obs.ScheduleFilterSpec{
+					TimeZone: "UTC",
+					Windows:  []obs.TimeWindow{{Start: "22:00", End: "06:00"}},
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, ".*is valid"))
+		})
+	})
+	Context("#validateEncryptFilter", func() {
+		key := &obs.SecretReference{SecretName: "field-key", Key: "key"}
+		DescribeTable("invalid encrypt filter spec", func(encrypt *obs.EncryptFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:    myEncrypt,
+				Type:    obs.FilterTypeEncrypt,
+				Encrypt: encrypt,
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+		},
+			Entry("without fields", &obs.EncryptFilterSpec{Key: key}, "encrypt filter must have at least one field"),
+			Entry("with an invalid field path", &obs.EncryptFilterSpec{Fields: []obs.FieldPath{"message"}, Key: key}, ".*must start with a '.'.*"),
+			Entry("with a routing field", &obs.EncryptFilterSpec{Fields: []obs.FieldPath{".message", ".log_type"}, Key: key}, `.*".log_type" is required to route records.*`),
+		)
+
+		DescribeTable("key of the encrypt filter", func(data map[string][]byte, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:    myEncrypt,
+				Type:    obs.FilterTypeEncrypt,
+				Encrypt: &obs.EncryptFilterSpec{Fields: []obs.FieldPath{".message"}, Key: key},
+			}
+			secrets := map[string]*corev1.Secret{
+				"field-key": {Data: data},
+			}
+			if errMsg == "" {
+				Expect(validateEncryptKey(spec, secrets)).To(BeEmpty())
+			} else {
+				Expect(validateEncryptKey(spec, secrets)).To(ContainElement(MatchRegexp(errMsg)))
+			}
+		},
+			Entry("with a 32 byte key", map[string][]byte{"key": []byte("MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")}, ""),
+			Entry("with a missing key", map[string][]byte{}, `secret\[field-key.key\] not found`),
+			Entry("with a key which is not base64 encoded", map[string][]byte{"key": []byte("not a key")}, "must be a base64 encoded 32 byte key"),
+			Entry("with a key of the wrong size", map[string][]byte{"key": []byte("MDEyMzQ1Njc4OWFiY2RlZg==")}, "must be a base64 encoded 32 byte key"),
+		)
+	})
+	Context("#validateSanitizeFilter", func() {
+		DescribeTable("sanitize filter spec", func(sanitize *obs.SanitizeFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:     mySanitize,
+				Type:     obs.FilterTypeSanitize,
+				Sanitize: sanitize,
+			}
+			if errMsg == "" {
+				Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, ".*is valid"))
+			} else {
+				Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+			}
+		},
+			Entry("without a spec", nil, ""),
+			Entry("with a supported charset", &obs.SanitizeFilterSpec{Fields: []obs.FieldPath{".message"}, Charset: "Shift_JIS"}, ""),
+			Entry("with an invalid field path", &obs.SanitizeFilterSpec{Fields: []obs.FieldPath{"message"}}, ".*must start with a '.'.*"),
+			Entry("with an unsupported charset", &obs.SanitizeFilterSpec{Charset: "EBCDIC"}, `.*"EBCDIC" is not a supported charset.*`),
+		)
+	})
+	Context("#validateRedactFilter", func() {
+		DescribeTable("redact filter spec", func(redact *obs.RedactFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:   myRedact,
+				Type:   obs.FilterTypeRedact,
+				Redact: redact,
+			}
+			if errMsg == "" {
+				Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, ".*is valid"))
+			} else {
+				Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+			}
+		},
+			Entry("with valid fields and patterns", &obs.RedactFilterSpec{Fields: []obs.FieldPath{".message", ".structured.card"}, Patterns: []string{`\b(?:\d[ -]?){13,16}\b`}}, ""),
+			Entry("without a spec", nil, ".*redact filter must have at least one pattern.*"),
+			Entry("without patterns", &obs.RedactFilterSpec{Fields: []obs.FieldPath{".message"}}, ".*redact filter must have at least one pattern.*"),
+			Entry("with an invalid field path", &obs.RedactFilterSpec{Fields: []obs.FieldPath{"message"}, Patterns: []string{"secret"}}, ".*must start with a '.'.*"),
+			Entry("with an invalid pattern", &obs.RedactFilterSpec{Patterns: []string{"(["}}, ".*must be a valid regular expression.*"),
+			Entry("with a pattern containing a single quote", &obs.RedactFilterSpec{Patterns: []string{"it's"}}, ".*must be a valid regular expression without single quotes.*"),
+		)
+	})
+	Context("#validateTimestampFilter", func() {
+		formats := []string{"%Y-%m-%d %H:%M:%S"}
+		DescribeTable("timestamp filter spec", func(timestamp *obs.TimestampFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:      myTimestamp,
+				Type:      obs.FilterTypeTimestamp,
+				Timestamp: timestamp,
+			}
+			if errMsg == "" {
+				Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, ".*is valid"))
+			} else {
+				Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+			}
+		},
+			Entry("with a pattern and namespace time zones", &obs.TimestampFilterSpec{
+				Pattern:            `^(?P<timestamp>\S+ \S+) `,
+				Formats:            formats,
+				NamespaceTimeZones: []obs.NamespaceTimeZone{{Namespace: "legacy", TimeZone: "Europe/Berlin"}},
+			}, ""),
+			Entry("without formats", &obs.TimestampFilterSpec{}, "timestamp filter must have at least one format"),
+			Entry("with an invalid field path", &obs.TimestampFilterSpec{Field: "message", Formats: formats}, ".*must start with a '.'.*"),
+			Entry("with an invalid pattern", &obs.TimestampFilterSpec{Pattern: "([", Formats: formats}, ".*must be a valid regular expression.*"),
+			Entry("with a pattern containing a single quote", &obs.TimestampFilterSpec{Pattern: `^(?P<timestamp>\S+)'`, Formats: formats}, ".*must be a valid regular expression without single quotes.*"),
+			Entry("with a pattern without a timestamp group", &obs.TimestampFilterSpec{Pattern: `^(\S+) `, Formats: formats}, ".*must have a capture group named timestamp.*"),
+			Entry("with an invalid namespace time zone", &obs.TimestampFilterSpec{
+				Formats:            formats,
+				NamespaceTimeZones: []obs.NamespaceTimeZone{{Namespace: "legacy", TimeZone: "Mars/Olympus"}},
+			}, `.*"Mars/Olympus" is not a valid time zone.*`),
+		)
+	})
+	Context("#validateClockSkewFilter", func() {
+		hour, zero := time.Duration(3600), time.Duration(0)
+		DescribeTable("clock skew filter spec", func(clockSkew *obs.ClockSkewFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:      myClockSkew,
+				Type:      obs.FilterTypeClockSkew,
+				ClockSkew: clockSkew,
+			}
+			if errMsg == "" {
+				Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, ".*is valid"))
+			} else {
+				Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+			}
+		},
+			Entry("without a spec", nil, ""),
+			Entry("with bounds", &obs.ClockSkewFilterSpec{MaxPast: &hour, MaxFuture: &hour}, ""),
+			Entry("with a zero maxPast", &obs.ClockSkewFilterSpec{MaxPast: &zero}, ".*maxPast must be at least one second.*"),
+			Entry("with a zero maxFuture", &obs.ClockSkewFilterSpec{MaxFuture: &zero}, ".*maxFuture must be at least one second.*"),
+		)
+	})
+	Context("#validateLogMetricsFilter", func() {
+		DescribeTable("log metrics filter spec", func(logMetrics *obs.LogMetricsFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name:       myLogMetrics,
+				Type:       obs.FilterTypeLogMetrics,
+				LogMetrics: logMetrics,
+			}
+			if errMsg == "" {
+				Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, ".*is valid"))
+			} else {
+				Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+			}
+		},
+			Entry("with metrics", &obs.LogMetricsFilterSpec{Metrics: []obs.LogMetric{
+				{Name: "oom_errors_total", Pattern: "(?i)out of memory"},
+				{Name: "panics_total", Field: ".level", Pattern: "^panic$"},
+			}}, ""),
+			Entry("without metrics", &obs.LogMetricsFilterSpec{}, "log metrics filter must have at least one metric"),
+			Entry("with a duplicate metric", &obs.LogMetricsFilterSpec{Metrics: []obs.LogMetric{
+				{Name: "errors_total", Pattern: "error"},
+				{Name: "errors_total", Pattern: "fatal"},
+			}}, `.*metric "errors_total" is defined more than once.*`),
+			Entry("with an invalid field path", &obs.LogMetricsFilterSpec{Metrics: []obs.LogMetric{{Name: "errors_total", Field: "level", Pattern: "error"}}}, ".*must start with a '.'.*"),
+			Entry("with an invalid pattern", &obs.LogMetricsFilterSpec{Metrics: []obs.LogMetric{{Name: "errors_total", Pattern: "(["}}}, ".*must be a valid regular expression.*"),
+			Entry("with a pattern with single quotes", &obs.LogMetricsFilterSpec{Metrics: []obs.LogMetric{{Name: "errors_total", Pattern: "can't"}}}, ".*without single quotes.*"),
+		)
+	})
+	Context("#validateNamespaceParsers", func() {
+		var filter = obs.FilterSpec{
+			Name:             "namespaceParsersFilter",
+			Type:             obs.FilterTypeNamespaceParsers,
+			NamespaceParsers: &obs.NamespaceParsersFilterSpec{ConfigMapName: "parsers"},
+		}
+		DescribeTable("parsers of the configmap", func(data map[string]string, errMsg string) {
+			configMaps := map[string]*corev1.ConfigMap{
+				"parsers": runtime.NewConfigMap("openshift-logging", "parsers", data),
+			}
+			messages := validateNamespaceParsers(filter, configMaps)
+			if errMsg == "" {
+				Expect(messages).To(BeEmpty())
+			} else {
+				Expect(messages).To(ContainElement(MatchRegexp(errMsg)))
+			}
+		},
+			Entry("with valid parsers", map[string]string{
+				"nginx":  `{namespace: web, type: regex, pattern: '^(?P<client>\S+) (?P<request>.*)$'}`,
+				"events": `{namespace: billing, type: jsonPointer, pattern: /event}`,
+			}, ""),
+			Entry("with an invalid definition", map[string]string{"nginx": `{namespace: web, type: xpath, pattern: x}`}, "must have a type of"),
+			Entry("with an invalid regular expression", map[string]string{"nginx": `{namespace: web, type: regex, pattern: '(['}`}, "must have a valid regular expression"),
+			Entry("with an invalid JSON pointer", map[string]string{"events": `{namespace: billing, type: jsonPointer, pattern: event}`}, "must have a JSON pointer starting with a '/'"),
+			Entry("with a grok pattern of built-in patterns", map[string]string{"app": `{namespace: web, type: grok, pattern: '%{TIMESTAMP_ISO8601:time} %{LOGLEVEL:level} %{GREEDYDATA:msg}'}`}, ""),
+			Entry("with a grok pattern with an unclosed reference", map[string]string{"app": `{namespace: web, type: grok, pattern: '%{WORD:method %{GREEDYDATA:msg}'}`}, "must have a grok pattern with valid references"),
+			Entry("with a grok pattern referencing an undefined pattern", map[string]string{"app": `{namespace: web, type: grok, pattern: '%{nginx_access}'}`}, `must only reference built-in grok patterns: pattern "nginx_access" is not defined`),
+			Entry("with an invalid namespace", map[string]string{"nginx": `{namespace: Web_Apps, type: regex, pattern: '^(?P<client>\S+)'}`}, "must have a valid namespace"),
+		)
+		It("should fail when the configmap is not found", func() {
+			Expect(validateNamespaceParsers(filter, map[string]*corev1.ConfigMap{})).To(ConsistOf("configmap[parsers] not found"))
+		})
+	})
+	Context("#validateGrokFilter", func() {
+		DescribeTable("grok filter spec", func(grok *obs.GrokFilterSpec, errMsg string) {
+			spec := obs.FilterSpec{
+				Name: myGrok,
+				Type: obs.FilterTypeGrok,
+				Grok: grok,
+			}
+			if errMsg == "" {
+				Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, ".*is valid"))
+			} else {
+				Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, errMsg))
+			}
+		},
+			Entry("with library and custom patterns", &obs.GrokFilterSpec{
+				Patterns:       []string{"%{nginx_access}", "%{my_prefix} %{GREEDYDATA:msg}"},
+				CustomPatterns: map[string]string{"my_prefix": "%{TIMESTAMP_ISO8601:time} %{LOGLEVEL:level}"},
+			}, ""),
+			Entry("without patterns", &obs.GrokFilterSpec{}, "grok filter must have at least one pattern"),
+			Entry("with an invalid field path", &obs.GrokFilterSpec{Field: "raw", Patterns: []string{"%{postgres}"}}, ".*must start with a '.'.*"),
+			Entry("with an invalid custom pattern name", &obs.GrokFilterSpec{
+				Patterns:       []string{"%{WORD}"},
+				CustomPatterns: map[string]string{"MyPrefix": "%{WORD}"},
+			}, `.*custom pattern name "MyPrefix" must be lower case.*`),
+			Entry("with a custom pattern replacing the library", &obs.GrokFilterSpec{
+				Patterns:       []string{"%{postgres}"},
+				CustomPatterns: map[string]string{"postgres": "%{WORD}"},
+			}, `.*custom pattern "postgres" must not replace the pattern of the library.*`),
+			Entry("with an undefined pattern", &obs.GrokFilterSpec{Patterns: []string{"%{my_prefix} %{GREEDYDATA:msg}"}}, `.*pattern "my_prefix" is not defined.*`),
+			Entry("with a cycle of custom patterns", &obs.GrokFilterSpec{
+				Patterns:       []string{"%{first}"},
+				CustomPatterns: map[string]string{"first": "%{second}", "second": "%{WORD} %{first}"},
+			}, `.*custom pattern "first" must not reference itself.*`),
+		)
+	})
+	Context("#validateCELFilter", func() {
+		It("should pass validation for a supported expression", func() {
+			spec := obs.FilterSpec{
+				Name: "celFilter",
+				Type: obs.FilterTypeCEL,
+				CEL:  &obs.CELFilterSpec{Expression: `record.kubernetes.namespace_name.startsWith("team-a") && record.level == "error"`},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, ".*is valid"))
+		})
+		It("should fail validation for an expression which does not compile", func() {
+			spec := obs.FilterSpec{
+				Name: "celFilter",
+				Type: obs.FilterTypeCEL,
+				CEL:  &obs.CELFilterSpec{Expression: `level == "error"`},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `.*invalid expression: undeclared reference "level".*`))
+		})
+	})
+	Context("#validateRollupFilter", func() {
+		It("should pass validation for valid fields and sums", func() {
+			spec := obs.FilterSpec{
+				Name: "rollupFilter",
+				Type: obs.FilterTypeRollup,
+				Rollup: &obs.RollupFilterSpec{
+					GroupBy: []obs.FieldPath{".kubernetes.namespace_name", ".structured.status"},
+					Sums:    []obs.RollupSum{{Name: "bytes", Field: ".structured.bytes"}},
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, ".*is valid"))
+		})
+		It("should fail validation for a window shorter than a second", func() {
+			window := time.Duration(0)
+			spec := obs.FilterSpec{
+				Name:   "rollupFilter",
+				Type:   obs.FilterTypeRollup,
+				Rollup: &obs.RollupFilterSpec{Window: &window},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `.*window 0 must be at least 1 second.*`))
+		})
+		It("should fail validation for a sum named after another field of the summary records", func() {
+			spec := obs.FilterSpec{
+				Name: "rollupFilter",
+				Type: obs.FilterTypeRollup,
+				Rollup: &obs.RollupFilterSpec{
+					GroupBy: []obs.FieldPath{".kubernetes.namespace_name"},
+					Sums:    []obs.RollupSum{{Name: "kubernetes", Field: ".structured.bytes"}},
+				},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `.*sum "kubernetes" must not be named after another field.*`))
+		})
+	})
+	Context("#validateVRLFilter", func() {
+		It("should pass validation for a source without syntax errors", func() {
+			spec := obs.FilterSpec{
+				Name: "vrlFilter",
+				Type: obs.FilterTypeVRL,
+				VRL:  &obs.VRLFilterSpec{Source: `.structured = parse_json(.message) ?? {}`},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, ".*is valid"))
+		})
+		It("should fail validation for a source with syntax errors", func() {
+			spec := obs.FilterSpec{
+				Name: "vrlFilter",
+				Type: obs.FilterTypeVRL,
+				VRL:  &obs.VRLFilterSpec{Source: `if exists(.a) { .b = "c" `},
+			}
+			Expect(ValidateFilter(spec)).To(MatchCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `.*invalid source: unclosed '{'.*`))
+		})
+	})
 })
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"regexp"
 	"strings"
@@ -11,6 +12,9 @@ import (
 
 var (
 	globRE = regexp.MustCompile(`^[a-zA-Z0-9\*\-]*$`)
+
+	// minMergedSize is the size at which the container runtime splits log lines into partial lines
+	minMergedSize = resource.MustParse("16Ki")
 )
 
 func ValidateApplication(spec obs.InputSpec) (conditions []metav1.Condition) {
@@ -23,24 +27,31 @@ func ValidateApplication(spec obs.InputSpec) (conditions []metav1.Condition) {
 			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonMissingSpec, fmt.Sprintf("%s has nil application spec", spec.Name)),
 		}
 	}
+	if tuning := spec.Application.Tuning; tuning != nil && tuning.PartialLines != nil && tuning.PartialLines.MaxMergedSize != nil {
+		if tuning.PartialLines.MaxMergedSize.Cmp(minMergedSize) < 0 {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("application.tuning.partialLines.maxMergedSize must be at least %s", minMergedSize.String())),
+			}
+		}
+	}
 	var messages []string
 	if spec.Application.Excludes != nil {
 		for i, ex := range spec.Application.Excludes {
 			if !globRE.MatchString(ex.Namespace) {
-				messages = append(messages, fmt.Sprintf("excludes[%d].namespace", i))
+				messages = append(messages, fmt.Sprintf("application.excludes[%d].namespace %q", i, ex.Namespace))
 			}
 			if !globRE.MatchString(ex.Container) {
-				messages = append(messages, fmt.Sprintf("excludes[%d].container", i))
+				messages = append(messages, fmt.Sprintf("application.excludes[%d].container %q", i, ex.Container))
 			}
 		}
 	}
 	if spec.Application.Includes != nil {
 		for i, in := range spec.Application.Includes {
 			if !globRE.MatchString(in.Namespace) {
-				messages = append(messages, fmt.Sprintf("includes[%d].namespace", i))
+				messages = append(messages, fmt.Sprintf("application.includes[%d].namespace %q", i, in.Namespace))
 			}
 			if !globRE.MatchString(in.Container) {
-				messages = append(messages, fmt.Sprintf("includes[%d].container", i))
+				messages = append(messages, fmt.Sprintf("application.includes[%d].container %q", i, in.Container))
 			}
 		}
 	}
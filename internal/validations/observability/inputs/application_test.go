@@ -4,7 +4,9 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 var _ = Describe("#ValidateApplication", func() {
@@ -116,4 +118,19 @@ var _ = Describe("#ValidateApplication", func() {
 			Expect(ValidateApplication(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
 		})
 	})
+
+	Context("of partial lines tuning", func() {
+		It("should fail when the max merged size is smaller than a partial line", func() {
+			input.Application.Tuning = &obs.ContainerInputTuningSpec{
+				PartialLines: &obs.PartialLinesSpec{MaxMergedSize: utils.GetPtr(resource.MustParse("8Ki"))},
+			}
+			Expect(ValidateApplication(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `maxMergedSize must be at least 16Ki`))
+		})
+		It("should pass when the max merged size is larger than a partial line", func() {
+			input.Application.Tuning = &obs.ContainerInputTuningSpec{
+				PartialLines: &obs.PartialLinesSpec{MaxMergedSize: utils.GetPtr(resource.MustParse("1Mi"))},
+			}
+			Expect(ValidateApplication(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+	})
 })
@@ -0,0 +1,61 @@
+package inputs
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/set"
+)
+
+// ValidateComposite validates a composite input references existing inputs without forming a cycle
+func ValidateComposite(spec obs.InputSpec, inputs map[string]obs.InputSpec) []metav1.Condition {
+	if spec.Type != obs.InputTypeComposite {
+		return nil
+	}
+	if spec.Composite == nil {
+		return []metav1.Condition{
+			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonMissingSpec, fmt.Sprintf("%s has nil composite spec", spec.Name)),
+		}
+	}
+	if len(spec.Composite.InputRefs) == 0 {
+		return []metav1.Condition{
+			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s must reference at least one input", spec.Name)),
+		}
+	}
+	for _, ref := range spec.Composite.InputRefs {
+		if _, found := inputs[ref]; !found && !ReservedInputTypes.Has(ref) {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s references undefined input %q", spec.Name, ref)),
+			}
+		}
+	}
+	if formsCycle(spec.Name, inputs, set.New[string]()) {
+		return []metav1.Condition{
+			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s references itself through its composite inputs", spec.Name)),
+		}
+	}
+	return []metav1.Condition{
+		NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)),
+	}
+}
+
+// formsCycle evaluates if the composite input of the given name is reachable from its own input references
+func formsCycle(name string, inputs map[string]obs.InputSpec, visiting set.Set[string]) bool {
+	input, found := inputs[name]
+	if !found || input.Type != obs.InputTypeComposite || input.Composite == nil {
+		return false
+	}
+	if visiting.Has(name) {
+		return true
+	}
+	visiting.Insert(name)
+	defer visiting.Delete(name)
+	for _, ref := range input.Composite.InputRefs {
+		if formsCycle(ref, inputs, visiting) {
+			return true
+		}
+	}
+	return false
+}
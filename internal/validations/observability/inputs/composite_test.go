@@ -0,0 +1,51 @@
+package inputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("#ValidateComposite", func() {
+
+	var (
+		input              obs.InputSpec
+		inputs             map[string]obs.InputSpec
+		expConditionTypeRE = obs.ConditionTypeValidInputPrefix + "-.*"
+	)
+	BeforeEach(func() {
+		input = obs.InputSpec{
+			Name: "tenant-a",
+			Type: obs.InputTypeComposite,
+			Composite: &obs.Composite{
+				InputRefs: []string{"a-apps", string(obs.InputTypeAudit)},
+			},
+		}
+		inputs = map[string]obs.InputSpec{
+			"a-apps":   {Name: "a-apps", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+			"tenant-a": input,
+		}
+	})
+	It("should skip the validation when not a composite type", func() {
+		input.Type = obs.InputTypeApplication
+		Expect(ValidateComposite(input, inputs)).To(BeEmpty())
+	})
+	It("should pass for a composite input of existing and reserved inputs", func() {
+		Expect(ValidateComposite(input, inputs)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+	})
+	It("should fail when a composite type but has no composite spec", func() {
+		input.Composite = nil
+		Expect(ValidateComposite(input, inputs)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonMissingSpec, "tenant-a has nil composite spec"))
+	})
+	It("should fail when referencing an undefined input", func() {
+		input.Composite.InputRefs = []string{"b-apps"}
+		Expect(ValidateComposite(input, inputs)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `references undefined input "b-apps"`))
+	})
+	It("should fail when the composite inputs form a cycle", func() {
+		input.Composite.InputRefs = []string{"everything"}
+		inputs["tenant-a"] = input
+		inputs["everything"] = obs.InputSpec{Name: "everything", Type: obs.InputTypeComposite, Composite: &obs.Composite{InputRefs: []string{"a-apps", "tenant-a"}}}
+		Expect(ValidateComposite(input, inputs)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "references itself"))
+	})
+})
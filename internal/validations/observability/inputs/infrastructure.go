@@ -5,6 +5,7 @@ import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	. "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"strings"
 )
 
 func ValidateInfrastructure(spec obs.InputSpec) []metav1.Condition {
@@ -26,3 +27,24 @@ func ValidateInfrastructure(spec obs.InputSpec) []metav1.Condition {
 		NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)),
 	}
 }
+
+// ValidateInfrastructureNamespaces fails the application and infrastructure inputs when the namespaces classified as
+// infrastructure are not valid globs.  A bare "*" is rejected because it classifies every namespace as infrastructure
+func ValidateInfrastructureNamespaces(spec obs.InputSpec, namespaces []string) []metav1.Condition {
+	if spec.Type != obs.InputTypeApplication && spec.Type != obs.InputTypeInfrastructure {
+		return nil
+	}
+	var messages []string
+	for i, ns := range namespaces {
+		if ns == "" || ns == "*" || !globRE.MatchString(ns) {
+			messages = append(messages, fmt.Sprintf("infrastructureNamespaces[%d] %q", i, ns))
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return []metav1.Condition{
+		NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure,
+			fmt.Sprintf("infrastructure namespaces must be non-empty globs other than \"*\" matching %q for: %s", globRE, strings.Join(messages, ","))),
+	}
+}
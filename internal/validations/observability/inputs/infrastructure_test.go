@@ -2,6 +2,7 @@ package inputs
 
 import (
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
@@ -42,3 +43,38 @@ var _ = Describe("#ValidateInfrastructure", func() {
 		Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "must define at least one valid source"))
 	})
 })
+
+var _ = Describe("#ValidateInfrastructureNamespaces", func() {
+
+	var (
+		expConditionTypeRE = obs.ConditionTypeValidInputPrefix + "-.*"
+		app                = obs.InputSpec{Name: "myapp", Type: obs.InputTypeApplication, Application: &obs.Application{}}
+		infra              = obs.InputSpec{Name: "myinfra", Type: obs.InputTypeInfrastructure, Infrastructure: &obs.Infrastructure{}}
+		audit              = obs.InputSpec{Name: "myaudit", Type: obs.InputTypeAudit, Audit: &obs.Audit{}}
+	)
+
+	It("should skip the validation when not an application or infrastructure type", func() {
+		Expect(ValidateInfrastructureNamespaces(audit, []string{"*"})).To(BeEmpty())
+	})
+
+	DescribeTable("should pass valid globs", func(namespaces ...string) {
+		Expect(ValidateInfrastructureNamespaces(app, namespaces)).To(BeEmpty())
+		Expect(ValidateInfrastructureNamespaces(infra, namespaces)).To(BeEmpty())
+	},
+		Entry("when there are none"),
+		Entry("with a namespace", "vendor-agent"),
+		Entry("with a glob", "platform-*"),
+	)
+
+	DescribeTable("should fail invalid globs", func(namespace string) {
+		namespaces := []string{"vendor-agent", namespace}
+		Expect(ValidateInfrastructureNamespaces(app, namespaces)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `infrastructureNamespaces\[1\]`))
+		Expect(ValidateInfrastructureNamespaces(infra, namespaces)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `infrastructureNamespaces\[1\]`))
+	},
+		Entry("when empty", ""),
+		Entry("when matching every namespace", "*"),
+		Entry("when containing a quote", `platform-"*`),
+		Entry("when containing a comma", "platform-a,platform-b"),
+		Entry("when containing a brace", "platform-{a,b}"),
+	)
+})
@@ -11,6 +11,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/validations/observability/common"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"net"
 	"os"
 	"strings"
 )
@@ -40,8 +41,27 @@ func ValidateReceiver(spec obs.InputSpec, secrets map[string]*corev1.Secret, con
 			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s does not specify a format", spec.Name)),
 		}
 	}
-	if spec.Receiver.TLS != nil {
-		tlsSpec := obs.TLSSpec(*spec.Receiver.TLS)
+	if spec.Receiver.Expose != nil && spec.Receiver.Expose.Type == obs.ReceiverExposeTypeRoute && spec.Receiver.Type != obs.ReceiverTypeHTTP {
+		return []metav1.Condition{
+			NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s can only be exposed by a Route for an HTTP receiver", spec.Name)),
+		}
+	}
+	if clientAuth := spec.Receiver.ClientAuth; clientAuth != nil && len(clientAuth.AllowedCIDRs) > 0 {
+		if spec.Receiver.Expose != nil && spec.Receiver.Expose.Type == obs.ReceiverExposeTypeRoute {
+			return []metav1.Condition{
+				NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s can not restrict the addresses of clients when exposed by a Route", spec.Name)),
+			}
+		}
+		for _, cidr := range clientAuth.AllowedCIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return []metav1.Condition{
+					NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s has an invalid allowed CIDR %q", spec.Name, cidr)),
+				}
+			}
+		}
+	}
+	if spec.Receiver.TLS != nil || spec.Receiver.ClientAuth != nil {
+		tlsSpec := ReceiverTLS(*spec.Receiver)
 		keys := ValueReferences(tlsSpec)
 		skipKeys := extractSecretKeysAsSet(context)
 		keys = removeGeneratedSecrets(keys, skipKeys)
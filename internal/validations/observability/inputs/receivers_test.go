@@ -62,6 +62,37 @@ var _ = Describe("#ValidateReceiver", func() {
 			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
 			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
 		})
+		It("should fail when a syslog receiver is exposed by a route", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSyslog
+			spec.Receiver.Expose = &obs.ReceiverExposeSpec{Type: obs.ReceiverExposeTypeRoute}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "myreceiver can only be exposed by a Route for an HTTP receiver"))
+		})
+		It("should fail when the allowed CIDRs of a receiver are not valid", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSyslog
+			spec.Receiver.ClientAuth = &obs.ReceiverClientAuthSpec{AllowedCIDRs: []string{"10.0.0.0/16", "10.0.0.1"}}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `myreceiver has an invalid allowed CIDR "10.0.0.1"`))
+		})
+		It("should fail when a receiver restricts client addresses and is exposed by a route", func() {
+			spec.Receiver.Type = obs.ReceiverTypeHTTP
+			spec.Receiver.HTTP = &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatKubeAPIAudit}
+			spec.Receiver.Expose = &obs.ReceiverExposeSpec{Type: obs.ReceiverExposeTypeRoute}
+			spec.Receiver.ClientAuth = &obs.ReceiverClientAuthSpec{AllowedCIDRs: []string{"10.0.0.0/16"}}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "myreceiver can not restrict the addresses of clients when exposed by a Route"))
+		})
+		It("should fail validate the client CA if spec'd", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSyslog
+			spec.Receiver.ClientAuth = &obs.ReceiverClientAuthSpec{
+				CA: &obs.ValueReference{
+					Key:           "ca.crt",
+					ConfigMapName: "immissing",
+				},
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(Not(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, "")))
+		})
 		It("should fail validate secrets if spec'd", func() {
 			spec.Receiver.Type = obs.ReceiverTypeSyslog
 			spec.Receiver.TLS = &obs.InputTLSSpec{
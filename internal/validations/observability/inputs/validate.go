@@ -4,12 +4,14 @@ import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/common"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Validate(context internalcontext.ForwarderContext) {
 	results := []metav1.Condition{}
-	for _, i := range context.Forwarder.Spec.Inputs {
+	inputs := internalobs.Inputs(context.Forwarder.Spec.Inputs).Map()
+	for index, i := range context.Forwarder.Spec.Inputs {
 		var conditions []metav1.Condition
 		switch i.Type {
 		case obs.InputTypeApplication:
@@ -20,8 +22,15 @@ func Validate(context internalcontext.ForwarderContext) {
 			conditions = ValidateAudit(i)
 		case obs.InputTypeReceiver:
 			conditions = ValidateReceiver(i, context.Secrets, context.ConfigMaps, context.AdditionalContext)
+		case obs.InputTypeComposite:
+			conditions = ValidateComposite(i, inputs)
+		}
+		if failed := ValidateInfrastructureNamespaces(i, context.Forwarder.Spec.InfrastructureNamespaces); len(failed) > 0 {
+			conditions = failed
+		}
+		for _, condition := range conditions {
+			results = append(results, common.WithFieldPath(common.FieldPath("inputs", index), condition))
 		}
-		results = append(results, conditions...)
 	}
 	// Set condition
 	for _, condition := range results {
@@ -0,0 +1,27 @@
+package outputs
+
+import (
+	"fmt"
+
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ValidateAggregatorQueue verifies the persistent queue of the aggregator is large enough for the queues of the
+// outputs which share it
+func ValidateAggregatorQueue(context internalcontext.ForwarderContext) []string {
+	spec := context.Forwarder.Spec
+	if spec.Collector == nil || spec.Collector.Aggregator == nil || spec.Collector.Aggregator.PersistentQueue == nil {
+		return nil
+	}
+	outputs := internalobs.Outputs(spec.Outputs)
+	sinks := len(outputs) - len(outputs.Duplicates())
+	minSize := output.MinQueueVolumeSize(sinks)
+	if size := spec.Collector.Aggregator.PersistentQueue.Size; size.Value() < minSize {
+		return []string{fmt.Sprintf("the persistent queue of the aggregator must be at least %s to queue the logs of %d outputs, not %s",
+			resource.NewQuantity(minSize, resource.BinarySI), sinks, size.String())}
+	}
+	return nil
+}
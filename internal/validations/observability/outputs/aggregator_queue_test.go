@@ -0,0 +1,50 @@
+package outputs
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("validating the persistent queue of the aggregator", func() {
+	Context("#ValidateAggregatorQueue", func() {
+
+		newContext := func(size string, hosts ...string) internalcontext.ForwarderContext {
+			forwarder := &obs.ClusterLogForwarder{}
+			for i, host := range hosts {
+				forwarder.Spec.Outputs = append(forwarder.Spec.Outputs, obs.OutputSpec{
+					Name: fmt.Sprintf("output-%d", i),
+					Type: obs.OutputTypeHTTP,
+					HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "http://" + host + ":8080"}},
+				})
+			}
+			if size != "" {
+				forwarder.Spec.Collector = &obs.CollectorSpec{
+					Aggregator: &obs.AggregatorSpec{
+						PersistentQueue: &obs.AggregatorPersistentQueueSpec{Size: resource.MustParse(size)},
+					},
+				}
+			}
+			return internalcontext.ForwarderContext{Forwarder: forwarder}
+		}
+
+		DescribeTable("should verify the volume fits the queues of the outputs", func(context internalcontext.ForwarderContext, valid bool) {
+			if valid {
+				Expect(ValidateAggregatorQueue(context)).To(BeEmpty())
+			} else {
+				Expect(ValidateAggregatorQueue(context)).To(ConsistOf(ContainSubstring("must be at least")))
+			}
+		},
+			Entry("without a persistent queue", newContext("", "a", "b"), true),
+			Entry("with a volume large enough for the queues", newContext("1Gi", "a", "b", "c"), true),
+			Entry("with a volume smaller than the queues and their headroom", newContext("768Mi", "a", "b", "c"), false),
+			Entry("with a volume shared by outputs with the same sink", newContext("300Mi", "a", "a"), true),
+			Entry("with a volume shared by outputs with different sinks", newContext("300Mi", "a", "b"), false),
+		)
+	})
+})
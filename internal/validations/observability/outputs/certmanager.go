@@ -0,0 +1,21 @@
+package outputs
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+// UnresolvedCertManagerCertificatesOpt is the option key for the reasons, by output name, the cert-manager
+// Certificates referenced by the outputs could not be resolved
+const UnresolvedCertManagerCertificatesOpt = "unresolvedCertManagerCertificates"
+
+// ValidateCertManagerCertificate fails an output whose cert-manager Certificate could not be resolved, e.g. because
+// its secret has not been issued yet
+func ValidateCertManagerCertificate(spec obs.OutputSpec, context internalcontext.ForwarderContext) []string {
+	unresolved, _ := utils.GetOption(context.AdditionalContext, UnresolvedCertManagerCertificatesOpt, map[string]string{})
+	if message, found := unresolved[spec.Name]; found {
+		return []string{message}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+var _ = Describe("validating the cert-manager certificates of outputs", func() {
+	Context("#ValidateCertManagerCertificate", func() {
+
+		var (
+			spec    obs.OutputSpec
+			context internalcontext.ForwarderContext
+		)
+
+		BeforeEach(func() {
+			spec = obs.OutputSpec{
+				Name: "output",
+				Type: obs.OutputTypeHTTP,
+				TLS: &obs.OutputTLSSpec{
+					CertManagerCertificate: "mycert",
+				},
+			}
+			context = internalcontext.ForwarderContext{
+				AdditionalContext: utils.Options{},
+			}
+		})
+
+		It("should pass an output whose certificate was resolved", func() {
+			Expect(ValidateCertManagerCertificate(spec, context)).To(BeEmpty())
+		})
+
+		It("should fail only the output whose certificate could not be resolved", func() {
+			context.AdditionalContext[UnresolvedCertManagerCertificatesOpt] = map[string]string{
+				"other": `secret "mycert-tls" for certificate "mycert" has not been issued`,
+			}
+			Expect(ValidateCertManagerCertificate(spec, context)).To(BeEmpty())
+			spec.Name = "other"
+			Expect(ValidateCertManagerCertificate(spec, context)).To(ConsistOf(ContainSubstring("has not been issued")))
+		})
+	})
+})
@@ -0,0 +1,26 @@
+package outputs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/http"
+)
+
+// ValidateHTTPEnvelope verifies the envelope of an http output is a JSON object when the records are substituted
+func ValidateHTTPEnvelope(spec obs.OutputSpec) (results []string) {
+	if spec.HTTP == nil || spec.HTTP.Envelope == "" {
+		return results
+	}
+	envelope := spec.HTTP.Envelope
+	if count := strings.Count(envelope, http.EnvelopeRecords); count != 1 {
+		return append(results, fmt.Sprintf("http.envelope must contain the %s placeholder exactly once", http.EnvelopeRecords))
+	}
+	object := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(strings.Replace(envelope, http.EnvelopeRecords, "{},{}", 1)), &object); err != nil {
+		results = append(results, fmt.Sprintf("http.envelope must be a JSON object with the %s placeholder in an array: %v", http.EnvelopeRecords, err))
+	}
+	return results
+}
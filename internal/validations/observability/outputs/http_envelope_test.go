@@ -0,0 +1,37 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("validating the envelope of http outputs", func() {
+	Context("#ValidateHTTPEnvelope", func() {
+
+		DescribeTable("should verify the envelope is a JSON object wrapping the records", func(envelope string, valid bool) {
+			spec := obs.OutputSpec{
+				Name: "output",
+				Type: obs.OutputTypeHTTP,
+				HTTP: &obs.HTTP{
+					URLSpec:  obs.URLSpec{URL: "https://logs.example.com"},
+					Envelope: envelope,
+				},
+			}
+			if valid {
+				Expect(ValidateHTTPEnvelope(spec)).To(BeEmpty())
+			} else {
+				Expect(ValidateHTTPEnvelope(spec)).ToNot(BeEmpty())
+			}
+		},
+			Entry("with no envelope", "", true),
+			Entry("with the records in an array", `{"streams":[{records}]}`, true),
+			Entry("with the records in a nested array", `{"source":"openshift","data":{"events":[{records}]}}`, true),
+			Entry("without the placeholder", `{"streams":[]}`, false),
+			Entry("with the placeholder twice", `{"a":[{records}],"b":[{records}]}`, false),
+			Entry("with the records outside of an array", `{"streams":{records}}`, false),
+			Entry("with an array envelope", `[{records}]`, false),
+		)
+	})
+})
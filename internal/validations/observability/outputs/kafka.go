@@ -0,0 +1,53 @@
+package outputs
+
+import (
+	"fmt"
+	"regexp"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
+)
+
+const (
+	// kafkaTopicMaxLength is the maximum length of a Kafka topic name
+	kafkaTopicMaxLength = 249
+)
+
+var (
+	kafkaTopicCharsRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]*$`)
+	fallbackRegex        = regexp.MustCompile(`\|\|"([^"]*)"$`)
+)
+
+// ValidateKafkaTopic verifies a topic name or template can only resolve to legal Kafka topic names.
+// The static parts and fallback values of a template are evaluated since dynamic values are unknown
+// until records are forwarded
+func ValidateKafkaTopic(spec obs.OutputSpec) (results []string) {
+	if spec.Kafka == nil || spec.Kafka.Topic == "" {
+		return results
+	}
+	topic := spec.Kafka.Topic
+	static := commontemplate.PathRegex.ReplaceAllString(topic, "")
+	if !kafkaTopicCharsRegex.MatchString(static) {
+		results = append(results, fmt.Sprintf("kafka.topic %q may only contain alphanumeric characters, dots, dashes and underscores", topic))
+	}
+	if len(static) > kafkaTopicMaxLength {
+		results = append(results, fmt.Sprintf("kafka.topic %q exceeds the maximum length of %d characters", topic, kafkaTopicMaxLength))
+	}
+	if topic == "." || topic == ".." {
+		results = append(results, fmt.Sprintf("kafka.topic %q is not a valid topic name", topic))
+	}
+	matches := commontemplate.PathRegex.FindAllStringSubmatch(topic, -1)
+	for _, match := range matches {
+		fallback := fallbackRegex.FindStringSubmatch(match[1])
+		if fallback == nil {
+			continue
+		}
+		if !kafkaTopicCharsRegex.MatchString(fallback[1]) {
+			results = append(results, fmt.Sprintf("kafka.topic fallback value %q may only contain alphanumeric characters, dots, dashes and underscores", fallback[1]))
+		}
+		if fallback[1] == "" && static == "" && len(matches) == 1 {
+			results = append(results, fmt.Sprintf("kafka.topic %q may resolve to an empty topic name", topic))
+		}
+	}
+	return results
+}
@@ -0,0 +1,36 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("validating Kafka outputs", func() {
+	Context("#ValidateKafkaTopic", func() {
+
+		DescribeTable("should evaluate the static parts and fallback values of a topic", func(topic string, valid bool) {
+			spec := obs.OutputSpec{
+				Name: "output",
+				Type: obs.OutputTypeKafka,
+				Kafka: &obs.Kafka{
+					Topic: topic,
+				},
+			}
+			if valid {
+				Expect(ValidateKafkaTopic(spec)).To(BeEmpty())
+			} else {
+				Expect(ValidateKafkaTopic(spec)).ToNot(BeEmpty())
+			}
+		},
+			Entry("with no topic", "", true),
+			Entry("with a static topic", "app-logs_1.0", true),
+			Entry("with a namespace template", `logs.{.kubernetes.namespace_name||"unknown"}`, true),
+			Entry("with a slash in the static part", `logs/{.kubernetes.namespace_name||"unknown"}`, false),
+			Entry("with a slash in the fallback value", `logs.{.kubernetes.namespace_name||"un/known"}`, false),
+			Entry("with a template that may resolve to an empty topic", `{.kubernetes.namespace_name||""}`, false),
+			Entry("with the reserved name '.'", ".", false),
+		)
+	})
+})
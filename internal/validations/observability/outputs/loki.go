@@ -0,0 +1,43 @@
+package outputs
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"k8s.io/utils/set"
+)
+
+const (
+	// lokiMaxLabels is the maximum number of labels of a stream accepted by Loki by default (max_label_names_per_series)
+	lokiMaxLabels = 15
+	// lokiHostLabelKey is the record key of the label the collector adds to every stream
+	lokiHostLabelKey = "kubernetes.host"
+)
+
+var (
+	// lokiUnboundedLabelKeys are the record keys whose values are unique to nearly every record and would create a
+	// stream per record if used as labels
+	lokiUnboundedLabelKeys = set.New(
+		"@timestamp",
+		"kubernetes.pod_ip",
+		"message",
+		"timestamp",
+	)
+)
+
+// ValidateLokiLabelKeys verifies the label keys of a loki output bound the number of streams created in Loki
+func ValidateLokiLabelKeys(spec obs.OutputSpec) (results []string) {
+	if spec.Loki == nil || len(spec.Loki.LabelKeys) == 0 {
+		return results
+	}
+	keys := set.New(spec.Loki.LabelKeys...)
+	if labels := keys.Union(set.New(lokiHostLabelKey)).Len(); labels > lokiMaxLabels {
+		results = append(results, fmt.Sprintf("loki.labelKeys results in %d labels including %q, the maximum is %d", labels, lokiHostLabelKey, lokiMaxLabels))
+	}
+	for _, key := range keys.SortedList() {
+		if lokiUnboundedLabelKeys.Has(key) {
+			results = append(results, fmt.Sprintf("loki.labelKeys %q has unbounded values and may not be a label", key))
+		}
+	}
+	return results
+}
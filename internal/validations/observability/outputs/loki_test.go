@@ -0,0 +1,47 @@
+package outputs
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("validating Loki outputs", func() {
+	Context("#ValidateLokiLabelKeys", func() {
+
+		manyKeys := func(n int) (keys []string) {
+			for i := 0; i < n; i++ {
+				keys = append(keys, fmt.Sprintf("kubernetes.labels.key%d", i))
+			}
+			return keys
+		}
+
+		DescribeTable("should verify the label keys bound the number of streams", func(keys []string, valid bool) {
+			spec := obs.OutputSpec{
+				Name: "output",
+				Type: obs.OutputTypeLoki,
+				Loki: &obs.Loki{
+					URLSpec:   obs.URLSpec{URL: "https://loki.example.com:3100"},
+					LabelKeys: keys,
+				},
+			}
+			if valid {
+				Expect(ValidateLokiLabelKeys(spec)).To(BeEmpty())
+			} else {
+				Expect(ValidateLokiLabelKeys(spec)).ToNot(BeEmpty())
+			}
+		},
+			Entry("with the default keys", nil, true),
+			Entry("with metadata keys", []string{"log_type", "kubernetes.namespace_name", "kubernetes.labels.app"}, true),
+			Entry("with the maximum number of keys", manyKeys(14), true),
+			Entry("with the maximum number of keys including the host", append(manyKeys(14), "kubernetes.host"), true),
+			Entry("with too many keys", manyKeys(15), false),
+			Entry("with the message", []string{"log_type", "message"}, false),
+			Entry("with the timestamp", []string{"@timestamp"}, false),
+			Entry("with the pod IP", []string{"kubernetes.pod_ip"}, false),
+		)
+	})
+})
@@ -0,0 +1,13 @@
+package outputs
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+// ValidateMaxRecordSize verifies the max record size leaves room for a message
+func ValidateMaxRecordSize(spec obs.OutputSpec) (results []string) {
+	if spec.MaxRecordSize != nil && spec.MaxRecordSize.Value() <= 0 {
+		results = append(results, "maxRecordSize must be greater than zero")
+	}
+	return results
+}
@@ -0,0 +1,33 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("validating the max record size of outputs", func() {
+	Context("#ValidateMaxRecordSize", func() {
+
+		DescribeTable("should verify the size is greater than zero", func(size *resource.Quantity, valid bool) {
+			spec := obs.OutputSpec{
+				Name:          "output",
+				Type:          obs.OutputTypeHTTP,
+				MaxRecordSize: size,
+			}
+			if valid {
+				Expect(ValidateMaxRecordSize(spec)).To(BeEmpty())
+			} else {
+				Expect(ValidateMaxRecordSize(spec)).ToNot(BeEmpty())
+			}
+		},
+			Entry("with no size", nil, true),
+			Entry("with a size in bytes", resource.NewQuantity(1024, resource.BinarySI), true),
+			Entry("with a size with a suffix", func() *resource.Quantity { q := resource.MustParse("1Mi"); return &q }(), true),
+			Entry("with a zero size", resource.NewQuantity(0, resource.BinarySI), false),
+			Entry("with a negative size", resource.NewQuantity(-1, resource.BinarySI), false),
+		)
+	})
+})
@@ -0,0 +1,48 @@
+package outputs
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var (
+	siemFieldKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+	// siemFieldValueRegex matches the template syntax of the output fields which support per-event values
+	siemFieldValueRegex = regexp.MustCompile(`^(([a-zA-Z0-9-_.\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`)
+)
+
+// ValidateSIEMFormat verifies the fields of a SIEM event format can be encoded in the events
+func ValidateSIEMFormat(spec obs.OutputSpec) (results []string) {
+	var format *obs.SIEMFormat
+	var path string
+	switch {
+	case spec.Syslog != nil && spec.Syslog.Format != nil:
+		format, path = spec.Syslog.Format, "syslog.format"
+	case spec.HTTP != nil && spec.HTTP.Format != nil:
+		format, path = spec.HTTP.Format, "http.format"
+	default:
+		return results
+	}
+	if format.Type == obs.SIEMFormatLEEF && format.Name != "" {
+		results = append(results, fmt.Sprintf("%s.name is not supported for type %s", path, format.Type))
+	}
+	keys := make([]string, 0, len(format.Fields))
+	for key := range format.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !siemFieldKeyRegex.MatchString(key) {
+			results = append(results, fmt.Sprintf("%s.fields key %q must only contain alphanumeric characters and underscores", path, key))
+		} else if format.Type == obs.SIEMFormatLEEF && key == "sev" {
+			results = append(results, fmt.Sprintf("%s.fields key %q is reserved for the severity", path, key))
+		}
+		if !siemFieldValueRegex.MatchString(format.Fields[key]) {
+			results = append(results, fmt.Sprintf("%s.fields value of key %q is not a valid template", path, key))
+		}
+	}
+	return results
+}
@@ -0,0 +1,37 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("validating SIEM formatted outputs", func() {
+	Context("#ValidateSIEMFormat", func() {
+
+		DescribeTable("should verify the fields can be encoded in the events", func(format *obs.SIEMFormat, valid bool) {
+			spec := obs.OutputSpec{
+				Name: "output",
+				Type: obs.OutputTypeSyslog,
+				Syslog: &obs.Syslog{
+					URL:    "tcp://logserver:514",
+					Format: format,
+				},
+			}
+			if valid {
+				Expect(ValidateSIEMFormat(spec)).To(BeEmpty())
+			} else {
+				Expect(ValidateSIEMFormat(spec)).ToNot(BeEmpty())
+			}
+		},
+			Entry("with no format", nil, true),
+			Entry("with the default fields", &obs.SIEMFormat{Type: obs.SIEMFormatCEF}, true),
+			Entry("with templated fields", &obs.SIEMFormat{Type: obs.SIEMFormatCEF, Fields: map[string]string{"cs1": `{.kubernetes.namespace_name||"none"}`}}, true),
+			Entry("with a key which is not alphanumeric", &obs.SIEMFormat{Type: obs.SIEMFormatCEF, Fields: map[string]string{"cs 1": "value"}}, false),
+			Entry("with a value which is not a valid template", &obs.SIEMFormat{Type: obs.SIEMFormatCEF, Fields: map[string]string{"cs1": `{.kubernetes.namespace_name}`}}, false),
+			Entry("with the reserved severity key for LEEF", &obs.SIEMFormat{Type: obs.SIEMFormatLEEF, Fields: map[string]string{"sev": "5"}}, false),
+			Entry("with a name for LEEF", &obs.SIEMFormat{Type: obs.SIEMFormatLEEF, Name: "event"}, false),
+		)
+	})
+})
@@ -0,0 +1,63 @@
+package outputs
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+)
+
+// ValidateSyslogTuning verifies the socket tuning is supported by the transport of the syslog output
+func ValidateSyslogTuning(spec obs.OutputSpec) (results []string) {
+	if spec.Syslog == nil || spec.Syslog.Tuning == nil {
+		return results
+	}
+	tuning := spec.Syslog.Tuning
+	u, err := url.Parse(spec.Syslog.URL)
+	if err != nil {
+		return results
+	}
+	scheme := strings.ToLower(u.Scheme)
+	if tuning.KeepAlive != nil && scheme != "tcp" && scheme != "tls" {
+		results = append(results, fmt.Sprintf("syslog.tuning.keepAlive is not supported for URL scheme %q", scheme))
+	}
+	if tuning.KeepAlive != nil && *tuning.KeepAlive < 1 {
+		results = append(results, "syslog.tuning.keepAlive must be at least 1 second")
+	}
+	if tuning.SendBufferSize != nil && scheme == internalobs.SchemeUnix {
+		results = append(results, fmt.Sprintf("syslog.tuning.sendBufferSize is not supported for URL scheme %q", scheme))
+	}
+	if tuning.SendBufferSize != nil && tuning.SendBufferSize.Sign() <= 0 {
+		results = append(results, "syslog.tuning.sendBufferSize must be greater than zero")
+	}
+	return results
+}
+
+// ValidateSyslogSocket verifies a syslog output writing to a unix domain socket names an absolute path in a directory
+// which may be mounted into the collector and is forwarded by collectors running on every node
+func ValidateSyslogSocket(spec obs.OutputSpec, context internalcontext.ForwarderContext) (results []string) {
+	if spec.Syslog == nil {
+		return results
+	}
+	u, err := url.Parse(spec.Syslog.URL)
+	if err != nil || strings.ToLower(u.Scheme) != internalobs.SchemeUnix {
+		return results
+	}
+	if u.Host != "" || !path.IsAbs(u.Path) || strings.HasSuffix(u.Path, "/") {
+		results = append(results, fmt.Sprintf("syslog.url %q must be of the form unix:///path/to/socket", spec.Syslog.URL))
+	} else if err = internalobs.ValidateSocketDir(path.Dir(u.Path)); err != nil {
+		results = append(results, err.Error())
+	}
+	if spec.TLS != nil {
+		results = append(results, "tls is not supported for a unix domain socket")
+	}
+	forwarder := context.Forwarder
+	if forwarder != nil && (internalobs.DeployAsDeployment(*forwarder) || (forwarder.Spec.Collector != nil && forwarder.Spec.Collector.Aggregator != nil)) {
+		results = append(results, "a unix domain socket is only supported when the collector is deployed to every node without an aggregator")
+	}
+	return results
+}
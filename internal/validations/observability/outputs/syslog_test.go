@@ -0,0 +1,90 @@
+package outputs
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("validating Syslog outputs", func() {
+	Context("#ValidateSyslogTuning", func() {
+
+		DescribeTable("should verify the tuning is supported by the transport", func(url string, tuning *obs.SyslogTuningSpec, valid bool) {
+			spec := obs.OutputSpec{
+				Name: "output",
+				Type: obs.OutputTypeSyslog,
+				Syslog: &obs.Syslog{
+					URL:    url,
+					Tuning: tuning,
+				},
+			}
+			if valid {
+				Expect(ValidateSyslogTuning(spec)).To(BeEmpty())
+			} else {
+				Expect(ValidateSyslogTuning(spec)).ToNot(BeEmpty())
+			}
+		},
+			Entry("with no tuning", "udp://logserver:514", nil, true),
+			Entry("with keepalive for TCP", "tcp://logserver:514", &obs.SyslogTuningSpec{KeepAlive: utils.GetPtr(time.Duration(60))}, true),
+			Entry("with keepalive for TLS", "tls://logserver:6514", &obs.SyslogTuningSpec{KeepAlive: utils.GetPtr(time.Duration(60))}, true),
+			Entry("with keepalive for UDP", "udp://logserver:514", &obs.SyslogTuningSpec{KeepAlive: utils.GetPtr(time.Duration(60))}, false),
+			Entry("with keepalive of zero seconds", "tcp://logserver:514", &obs.SyslogTuningSpec{KeepAlive: utils.GetPtr(time.Duration(0))}, false),
+			Entry("with a send buffer for UDP", "udp://logserver:514", &obs.SyslogTuningSpec{SendBufferSize: utils.GetPtr(resource.MustParse("64Ki"))}, true),
+			Entry("with a zero send buffer", "tcp://logserver:514", &obs.SyslogTuningSpec{SendBufferSize: utils.GetPtr(resource.MustParse("0"))}, false),
+			Entry("with a send buffer for a unix domain socket", "unix:///var/run/siem/syslog.sock", &obs.SyslogTuningSpec{SendBufferSize: utils.GetPtr(resource.MustParse("64Ki"))}, false),
+		)
+	})
+
+	Context("#ValidateSyslogSocket", func() {
+
+		var forwarder *obs.ClusterLogForwarder
+
+		BeforeEach(func() {
+			forwarder = &obs.ClusterLogForwarder{}
+		})
+
+		DescribeTable("should verify the unix domain socket", func(url string, tls *obs.OutputTLSSpec, valid bool) {
+			spec := obs.OutputSpec{
+				Name:   "output",
+				Type:   obs.OutputTypeSyslog,
+				TLS:    tls,
+				Syslog: &obs.Syslog{URL: url},
+			}
+			context := internalcontext.ForwarderContext{Forwarder: forwarder}
+			if valid {
+				Expect(ValidateSyslogSocket(spec, context)).To(BeEmpty())
+			} else {
+				Expect(ValidateSyslogSocket(spec, context)).ToNot(BeEmpty())
+			}
+		},
+			Entry("with a network address", "tcp://logserver:514", nil, true),
+			Entry("with an absolute path", "unix:///var/run/siem/syslog.sock", nil, true),
+			Entry("with a host", "unix://localhost/var/run/siem/syslog.sock", nil, false),
+			Entry("with a directory", "unix:///var/run/siem/", nil, false),
+			Entry("with TLS", "unix:///var/run/siem/syslog.sock", &obs.OutputTLSSpec{InsecureSkipVerify: true}, false),
+			Entry("in the root directory", "unix:///syslog.sock", nil, false),
+			Entry("in /tmp", "unix:///tmp/syslog.sock", nil, false),
+			Entry("in /var/log", "unix:///var/log/syslog.sock", nil, false),
+			Entry("directly in /var/run", "unix:///var/run/syslog.sock", nil, false),
+			Entry("escaping /var/run", "unix:///var/run/../log/syslog.sock", nil, false),
+			Entry("in the collector secrets", "unix:///var/run/ocp-collector/secrets/syslog.sock", nil, false),
+			Entry("in the service account secrets", "unix:///var/run/secrets/kubernetes.io/syslog.sock", nil, false),
+		)
+
+		It("should fail when the collector forwards through an aggregator", func() {
+			forwarder.Spec.Collector = &obs.CollectorSpec{Aggregator: &obs.AggregatorSpec{}}
+			spec := obs.OutputSpec{
+				Name:   "output",
+				Type:   obs.OutputTypeSyslog,
+				Syslog: &obs.Syslog{URL: "unix:///var/run/siem/syslog.sock"},
+			}
+			Expect(ValidateSyslogSocket(spec, internalcontext.ForwarderContext{Forwarder: forwarder})).To(ContainElement(ContainSubstring("without an aggregator")))
+		})
+	})
+})
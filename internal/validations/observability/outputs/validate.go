@@ -10,30 +10,52 @@ import (
 )
 
 func Validate(context internalcontext.ForwarderContext) {
-	for _, out := range context.Forwarder.Spec.Outputs {
-		messages := []string{}
+	duplicates := internalobs.Outputs(context.Forwarder.Spec.Outputs).Duplicates()
+	queueMessages := ValidateAggregatorQueue(context)
+	for i, out := range context.Forwarder.Spec.Outputs {
+		messages := validateSecretKeys(out)
+		messages = append(messages, queueMessages...)
+		messages = append(messages, validateSecretProviderClass(out)...)
+		messages = append(messages, ValidateMaxRecordSize(out)...)
 		configs := internalobs.SecretReferencesAsValueReferences(out)
 		if out.TLS != nil {
 			messages = append(messages, validateURLAccordingToTLS(out)...)
+			messages = append(messages, ValidateCertManagerCertificate(out, context)...)
 			configs = append(configs, internalobs.ValueReferences(out.TLS.TLSSpec)...)
 		}
+		configs = withoutSecretProviderClass(out, configs)
 		messages = append(messages, common.ValidateValueReference(configs, context.Secrets, context.ConfigMaps)...)
 		// Validate by output type
 		switch out.Type {
 		case obs.OutputTypeCloudwatch:
 			messages = append(messages, ValidateCloudWatchAuth(out, context)...)
+		case obs.OutputTypeKafka:
+			messages = append(messages, ValidateKafkaTopic(out)...)
+		case obs.OutputTypeLoki:
+			messages = append(messages, ValidateLokiLabelKeys(out)...)
 		case obs.OutputTypeHTTP:
 			messages = append(messages, validateHttpContentTypeHeaders(out)...)
+			messages = append(messages, ValidateSIEMFormat(out)...)
+			messages = append(messages, ValidateHTTPEnvelope(out)...)
+		case obs.OutputTypeSyslog:
+			messages = append(messages, ValidateSyslogTuning(out)...)
+			messages = append(messages, ValidateSyslogSocket(out, context)...)
+			messages = append(messages, ValidateSIEMFormat(out)...)
 		case obs.OutputTypeOTLP:
 			messages = append(messages, ValidateOtlpAnnotation(context)...)
 		}
 		// Set condition
 		if len(messages) > 0 {
+			messages = common.PrefixMessages(common.FieldPath("outputs", i), messages)
 			internalobs.SetCondition(&context.Forwarder.Status.Outputs,
 				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidOutputPrefix, out.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")))
 		} else {
+			message := fmt.Sprintf("output %q is valid", out.Name)
+			if first, found := duplicates[out.Name]; found {
+				message = fmt.Sprintf("output %q is valid and shares a sink with the identical output %q", out.Name, first)
+			}
 			internalobs.SetCondition(&context.Forwarder.Status.Outputs,
-				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidOutputPrefix, out.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("output %q is valid", out.Name)))
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidOutputPrefix, out.Name, true, obs.ReasonValidationSuccess, message))
 		}
 	}
 }
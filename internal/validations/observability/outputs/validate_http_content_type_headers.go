@@ -14,16 +14,22 @@ var validContentTypes = map[string]string{
 }
 
 // validateHttpContentTypeHeaders will validate Content-Type header in Http Output
-// valid content-type are: "application/json" and "application/x-ndjson"
+// valid content-type are: "application/json" and "application/x-ndjson", or "text/plain" for events of a SIEM format
 // was introduced in https://github.com/openshift/cluster-logging-operator/pull/1924
 // for https://issues.redhat.com/browse/LOG-3784
 func validateHttpContentTypeHeaders(output obs.OutputSpec) (results []string) {
+	if output.Type == obs.OutputTypeHTTP && output.HTTP != nil && output.HTTP.Format != nil {
+		if contentType, found := output.HTTP.Headers["Content-Type"]; found && !strings.EqualFold(contentType, "text/plain") {
+			results = append(results, fmt.Sprintf("http.headers.Content-Type %q is not a valid content type for formatted events, supported types: [text/plain]", contentType))
+		}
+		return results
+	}
 	if output.Type == obs.OutputTypeHTTP && output.HTTP != nil {
 		if contentType, found := output.HTTP.Headers["Content-Type"]; found && validContentTypes[strings.ToLower(contentType)] == "" {
 			validKeys := reflect.ValueOf(validContentTypes).MapKeys()
 			log.V(3).Info("validateHttpContentTypeHeaders failed", "reason", "not valid content type set in headers",
 				"content type", contentType, "supported types: ", validKeys)
-			results = append(results, fmt.Sprintf("not valid content type set in headers: %s , supported types: %s", contentType, validKeys))
+			results = append(results, fmt.Sprintf("http.headers.Content-Type %q is not a valid content type, supported types: %s", contentType, validKeys))
 		}
 	}
 	return results
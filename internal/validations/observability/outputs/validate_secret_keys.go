@@ -0,0 +1,64 @@
+package outputs
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+// validateSecretKeys verifies the secret keys required together by the authentication and TLS settings
+// of an output are all specified. Messages identify the missing key by its path in the output spec
+func validateSecretKeys(spec obs.OutputSpec) (results []string) {
+	if spec.TLS != nil {
+		results = append(results, requireTogether("tls.certificate", spec.TLS.Certificate != nil, "tls.key", spec.TLS.Key != nil)...)
+		if spec.TLS.KeyPassphrase != nil && spec.TLS.Key == nil {
+			results = append(results, "tls.keyPassphrase is specified but tls.key is missing")
+		}
+	}
+	switch spec.Type {
+	case obs.OutputTypeElasticsearch:
+		if spec.Elasticsearch != nil {
+			results = append(results, validateHTTPAuthKeys("elasticsearch.authentication", spec.Elasticsearch.Authentication)...)
+		}
+	case obs.OutputTypeHTTP:
+		if spec.HTTP != nil {
+			results = append(results, validateHTTPAuthKeys("http.authentication", spec.HTTP.Authentication)...)
+		}
+	case obs.OutputTypeLoki:
+		if spec.Loki != nil {
+			results = append(results, validateHTTPAuthKeys("loki.authentication", spec.Loki.Authentication)...)
+		}
+	case obs.OutputTypeOTLP:
+		if spec.OTLP != nil {
+			results = append(results, validateHTTPAuthKeys("otlp.authentication", spec.OTLP.Authentication)...)
+		}
+	case obs.OutputTypeKafka:
+		if spec.Kafka != nil && spec.Kafka.Authentication != nil {
+			sasl := spec.Kafka.Authentication.SASL
+			if sasl == nil {
+				results = append(results, "kafka.authentication is specified but kafka.authentication.sasl is missing")
+			} else {
+				results = append(results, requireTogether("kafka.authentication.sasl.username", sasl.Username != nil, "kafka.authentication.sasl.password", sasl.Password != nil)...)
+			}
+		}
+	}
+	return results
+}
+
+func validateHTTPAuthKeys(path string, auth *obs.HTTPAuthentication) []string {
+	if auth == nil {
+		return nil
+	}
+	return requireTogether(path+".username", auth.Username != nil, path+".password", auth.Password != nil)
+}
+
+// requireTogether returns a message identifying the missing key when only one of a pair of keys is specified
+func requireTogether(first string, hasFirst bool, second string, hasSecond bool) []string {
+	switch {
+	case hasFirst && !hasSecond:
+		return []string{fmt.Sprintf("%s is specified but %s is missing", first, second)}
+	case !hasFirst && hasSecond:
+		return []string{fmt.Sprintf("%s is specified but %s is missing", second, first)}
+	}
+	return nil
+}
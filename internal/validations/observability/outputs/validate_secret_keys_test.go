@@ -0,0 +1,68 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+)
+
+var _ = Describe("#validateSecretKeys", func() {
+
+	var (
+		secretRef = func(key string) *obs.SecretReference {
+			return &obs.SecretReference{SecretName: "foo", Key: key}
+		}
+	)
+
+	DescribeTable("should identify the missing key", func(spec obs.OutputSpec, message string) {
+		Expect(validateSecretKeys(spec)).To(ConsistOf(message))
+	},
+		Entry("when the TLS key is missing", obs.OutputSpec{
+			Type: obs.OutputTypeSyslog,
+			TLS: &obs.OutputTLSSpec{TLSSpec: obs.TLSSpec{
+				Certificate: &obs.ValueReference{SecretName: "foo", Key: constants.ClientCertKey},
+			}},
+		}, "tls.certificate is specified but tls.key is missing"),
+		Entry("when the TLS certificate is missing", obs.OutputSpec{
+			Type: obs.OutputTypeSyslog,
+			TLS: &obs.OutputTLSSpec{TLSSpec: obs.TLSSpec{
+				Key: secretRef(constants.ClientPrivateKey),
+			}},
+		}, "tls.key is specified but tls.certificate is missing"),
+		Entry("when the elasticsearch password is missing", obs.OutputSpec{
+			Type: obs.OutputTypeElasticsearch,
+			Elasticsearch: &obs.Elasticsearch{
+				Authentication: &obs.HTTPAuthentication{Username: secretRef(constants.ClientUsername)},
+			},
+		}, "elasticsearch.authentication.username is specified but elasticsearch.authentication.password is missing"),
+		Entry("when the kafka SASL username is missing", obs.OutputSpec{
+			Type: obs.OutputTypeKafka,
+			Kafka: &obs.Kafka{
+				Authentication: &obs.KafkaAuthentication{SASL: &obs.SASLAuthentication{Password: secretRef(constants.ClientPassword)}},
+			},
+		}, "kafka.authentication.sasl.password is specified but kafka.authentication.sasl.username is missing"),
+		Entry("when the kafka SASL spec is missing", obs.OutputSpec{
+			Type:  obs.OutputTypeKafka,
+			Kafka: &obs.Kafka{Authentication: &obs.KafkaAuthentication{}},
+		}, "kafka.authentication is specified but kafka.authentication.sasl is missing"),
+	)
+
+	It("should pass when all keys are specified together", func() {
+		spec := obs.OutputSpec{
+			Type: obs.OutputTypeHTTP,
+			TLS: &obs.OutputTLSSpec{TLSSpec: obs.TLSSpec{
+				Certificate: &obs.ValueReference{SecretName: "foo", Key: constants.ClientCertKey},
+				Key:         secretRef(constants.ClientPrivateKey),
+			}},
+			HTTP: &obs.HTTP{
+				Authentication: &obs.HTTPAuthentication{
+					Username: secretRef(constants.ClientUsername),
+					Password: secretRef(constants.ClientPassword),
+				},
+			},
+		}
+		Expect(validateSecretKeys(spec)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,45 @@
+package outputs
+
+import (
+	"fmt"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+// validateSecretProviderClass validates values which are read by the operator instead of the collector are not
+// referenced from a SecretProviderClass, since objects mounted by the Secrets Store CSI driver are only visible
+// to the collector
+func validateSecretProviderClass(output obs.OutputSpec) (results []string) {
+	if output.SecretProviderClass == "" {
+		return results
+	}
+	check := func(field string, ref *obs.SecretReference) {
+		if ref != nil && ref.SecretName == output.SecretProviderClass {
+			results = append(results, fmt.Sprintf("%s can not be read from SecretProviderClass %q", field, output.SecretProviderClass))
+		}
+	}
+	if output.TLS != nil {
+		check("tls.keyPassphrase", output.TLS.KeyPassphrase)
+	}
+	switch {
+	case output.Type == obs.OutputTypeAzureMonitor && output.AzureMonitor != nil && output.AzureMonitor.Authentication != nil:
+		check("azureMonitor.authentication.sharedKey", output.AzureMonitor.Authentication.SharedKey)
+	case output.Type == obs.OutputTypeCloudwatch && output.Cloudwatch != nil && output.Cloudwatch.Authentication != nil && output.Cloudwatch.Authentication.IAMRole != nil:
+		check("cloudwatch.authentication.iamRole.roleARN", &output.Cloudwatch.Authentication.IAMRole.RoleARN)
+	}
+	return results
+}
+
+// withoutSecretProviderClass removes the references to objects mounted by the SecretProviderClass of an output
+// since their values can not be verified by the operator
+func withoutSecretProviderClass(output obs.OutputSpec, configs []*obs.ValueReference) []*obs.ValueReference {
+	if output.SecretProviderClass == "" {
+		return configs
+	}
+	results := []*obs.ValueReference{}
+	for _, c := range configs {
+		if c.SecretName != output.SecretProviderClass {
+			results = append(results, c)
+		}
+	}
+	return results
+}
@@ -0,0 +1,67 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("[internal][validations][observability][outputs] ClusterLogForwarder: Output SecretProviderClass", func() {
+
+	const spcName = "vault-creds"
+
+	var spec obs.OutputSpec
+
+	BeforeEach(func() {
+		spec = obs.OutputSpec{
+			Name:                "myoutput",
+			Type:                obs.OutputTypeHTTP,
+			SecretProviderClass: spcName,
+			HTTP: &obs.HTTP{
+				Authentication: &obs.HTTPAuthentication{
+					Username: &obs.SecretReference{Key: "username", SecretName: spcName},
+					Password: &obs.SecretReference{Key: "password", SecretName: spcName},
+				},
+			},
+			TLS: &obs.OutputTLSSpec{
+				TLSSpec: obs.TLSSpec{
+					Key:           &obs.SecretReference{Key: "tls.key", SecretName: spcName},
+					KeyPassphrase: &obs.SecretReference{Key: "passphrase", SecretName: "mysecret"},
+				},
+			},
+		}
+	})
+
+	Context("#validateSecretProviderClass", func() {
+		It("should pass when values read by the collector are referenced from the SecretProviderClass", func() {
+			Expect(validateSecretProviderClass(spec)).To(BeEmpty())
+		})
+		It("should fail when the key passphrase is referenced from the SecretProviderClass", func() {
+			spec.TLS.KeyPassphrase.SecretName = spcName
+			Expect(validateSecretProviderClass(spec)).To(ConsistOf(`tls.keyPassphrase can not be read from SecretProviderClass "vault-creds"`))
+		})
+		It("should fail when the cloudwatch role is referenced from the SecretProviderClass", func() {
+			spec.Type = obs.OutputTypeCloudwatch
+			spec.TLS = nil
+			spec.Cloudwatch = &obs.Cloudwatch{
+				Authentication: &obs.CloudwatchAuthentication{
+					Type: obs.CloudwatchAuthTypeIAMRole,
+					IAMRole: &obs.CloudwatchIAMRole{
+						RoleARN: obs.SecretReference{Key: "role_arn", SecretName: spcName},
+					},
+				},
+			}
+			Expect(validateSecretProviderClass(spec)).To(ConsistOf(`cloudwatch.authentication.iamRole.roleARN can not be read from SecretProviderClass "vault-creds"`))
+		})
+	})
+
+	Context("#withoutSecretProviderClass", func() {
+		It("should only remove references to the SecretProviderClass", func() {
+			configs := []*obs.ValueReference{
+				{Key: "password", SecretName: spcName},
+				{Key: "passphrase", SecretName: "mysecret"},
+			}
+			Expect(withoutSecretProviderClass(spec, configs)).To(Equal([]*obs.ValueReference{configs[1]}))
+		})
+	})
+})
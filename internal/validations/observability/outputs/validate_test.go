@@ -137,6 +137,14 @@ var _ = Describe("Validating multiple CloudWatch outputs auth", func() {
 				[]obs.OutputSpec{createIAMRoleSpec("output1", foo), createAccessKeySpec("output2", bar), createIAMRoleSpec("output3", bar)},
 				[]string{"is valid", "is valid", ErrVariousRoleARNAuth},
 			),
+			Entry("should reference the failed output by its field path",
+				[]obs.OutputSpec{createIAMRoleSpec("output1", foo), createIAMRoleSpec("output2", bar)},
+				[]string{"is valid", "spec.outputs[1]: " + ErrVariousRoleARNAuth},
+			),
+			Entry("should identify outputs which share a sink with an identical output",
+				[]obs.OutputSpec{createAccessKeySpec("output1", foo), createAccessKeySpec("output2", foo)},
+				[]string{`output "output1" is valid`, `output "output2" is valid and shares a sink with the identical output "output1"`},
+			),
 		)
 	})
 })
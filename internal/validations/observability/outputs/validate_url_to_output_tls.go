@@ -34,10 +34,10 @@ func validateURLAccordingToTLS(output obs.OutputSpec) (results []string) {
 	if specURL != "" && output.TLS != nil {
 		u, _ := url.Parse(specURL)
 		scheme := strings.ToLower(u.Scheme)
-		if !url.IsTLSScheme(scheme) && (output.TLS.InsecureSkipVerify || output.TLS.TLSSecurityProfile != nil) {
+		if !url.IsTLSScheme(scheme) && (output.TLS.InsecureSkipVerify || output.TLS.TLSSecurityProfile != nil || output.TLS.ServerName != "") {
 			log.V(3).Info("validateURLAccordingToTLS failed", "reason", "URL not secure but output has TLS configuration parameters",
 				"output URL", specURL, "output Name", output.Name)
-			results = append(results, fmt.Sprintf("URL scheme not secure: %v, but output has TLS configuration parameters", scheme))
+			results = append(results, fmt.Sprintf("%s.url %q scheme not secure: %v, but output has TLS configuration parameters", output.Type, specURL, scheme))
 		}
 	}
 	return results
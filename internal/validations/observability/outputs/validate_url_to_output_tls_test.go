@@ -31,6 +31,18 @@ var _ = Describe("[internal][validations][observability][outputs] ClusterLogForw
 			}
 			Expect(validateURLAccordingToTLS(spec)).To(Not(BeEmpty()))
 		})
+		It("should fail validation when not secure URL and tls.serverName is set", func() {
+			spec.Type = obs.OutputTypeHTTP
+			spec.HTTP = &obs.HTTP{
+				URLSpec: obs.URLSpec{
+					URL: "http://local.svc:8080",
+				},
+			}
+			spec.TLS = &obs.OutputTLSSpec{
+				ServerName: "logstore.example.com",
+			}
+			Expect(validateURLAccordingToTLS(spec)).To(Not(BeEmpty()))
+		})
 		It("should pass validation when not secure URL and no TLS config", func() {
 			spec.Syslog = &obs.Syslog{
 				URL: "tcp://local.svc:514",
@@ -5,6 +5,7 @@ import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/common"
 	"strings"
 )
 
@@ -12,19 +13,27 @@ func Validate(context internalcontext.ForwarderContext) {
 	inputs := internalobs.Inputs(context.Forwarder.Spec.Inputs).Map()
 	outputs := internalobs.Outputs(context.Forwarder.Spec.Outputs).Map()
 	filters := internalobs.FilterMap(context.Forwarder.Spec)
-	var messages []string
-	for _, pipelineSpec := range context.Forwarder.Spec.Pipelines {
+	duplicates := internalobs.Pipelines(context.Forwarder.Spec.Pipelines).Duplicates()
+	for i, pipelineSpec := range context.Forwarder.Spec.Pipelines {
+		var messages []string
 		refMessages := validateRef(pipelineSpec, inputs, outputs, filters)
 		if len(refMessages) > 0 {
 			messages = append(messages, fmt.Sprintf("refs not found: %s", strings.Join(refMessages, ",")))
 		}
+		messages = append(messages, validateTemplate(pipelineSpec, context.Forwarder.Spec.PipelineTemplates)...)
 		messages = append(messages, verifyHostNameNotFilteredForGCL(pipelineSpec, outputs, filters)...)
+		messages = append(messages, validatePolicies(pipelineSpec, context.Forwarder.Spec.Policies, context.Forwarder.Spec.InfrastructureNamespaces, inputs, outputs)...)
 		if len(messages) > 0 {
+			messages = common.PrefixMessages(common.FieldPath("pipelines", i), messages)
 			internalobs.SetCondition(&context.Forwarder.Status.Pipelines,
 				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidPipelinePrefix, pipelineSpec.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")))
 		} else {
+			message := fmt.Sprintf("pipeline %q is valid", pipelineSpec.Name)
+			if first, found := duplicates[pipelineSpec.Name]; found {
+				message = fmt.Sprintf("pipeline %q is valid and is merged with the identical pipeline %q", pipelineSpec.Name, first)
+			}
 			internalobs.SetCondition(&context.Forwarder.Status.Pipelines,
-				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidPipelinePrefix, pipelineSpec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("pipeline %q is valid", pipelineSpec.Name)))
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidPipelinePrefix, pipelineSpec.Name, true, obs.ReasonValidationSuccess, message))
 		}
 	}
 
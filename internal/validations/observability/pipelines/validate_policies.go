@@ -0,0 +1,133 @@
+package pipelines
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"k8s.io/utils/set"
+)
+
+// validatePolicies verifies a pipeline does not forward logs selected by a policy to an output with a tag denied by that policy
+// where extraInfraNamespaces are the namespaces classified as infrastructure in addition to the defaults
+func validatePolicies(pipeline obs.PipelineSpec, policies []obs.PolicySpec, extraInfraNamespaces []string, inputs map[string]obs.InputSpec, outputs map[string]obs.OutputSpec) (results []string) {
+	for _, policy := range policies {
+		denied := set.New[string](policy.DenyOutputTags...)
+		for _, outputRef := range pipeline.OutputRefs {
+			output, found := outputs[outputRef]
+			if !found || !denied.HasAny(output.Tags...) {
+				continue
+			}
+			for _, inputRef := range pipeline.InputRefs {
+				if input, found := inputs[inputRef]; found && selectsInput(policy.Sources, input, extraInfraNamespaces) {
+					results = append(results, fmt.Sprintf("policy %q forbids forwarding input %q to output %q", policy.Name, inputRef, outputRef))
+				}
+			}
+		}
+	}
+	return results
+}
+
+// selectsInput evaluates if an input may collect logs selected by the policy sources
+func selectsInput(sources obs.PolicySources, input obs.InputSpec, extraInfraNamespaces []string) bool {
+	for _, t := range sources.Types {
+		if t == input.Type {
+			return true
+		}
+	}
+	for _, ns := range sources.Namespaces {
+		for _, inputNS := range inputNamespaces(input, extraInfraNamespaces) {
+			if globsOverlap(ns, inputNS) && !excludesNamespace(input, ns, extraInfraNamespaces) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// inputNamespaces returns the namespace globs of the container logs collected by an input
+func inputNamespaces(input obs.InputSpec, extraInfraNamespaces []string) (namespaces []string) {
+	switch input.Type {
+	case obs.InputTypeApplication:
+		if input.Application == nil || len(input.Application.Includes) == 0 {
+			return []string{"*"}
+		}
+		for _, include := range input.Application.Includes {
+			if include.Namespace == "" {
+				namespaces = append(namespaces, "*")
+			} else {
+				namespaces = append(namespaces, include.Namespace)
+			}
+		}
+	case obs.InputTypeInfrastructure:
+		if input.Infrastructure != nil {
+			for _, source := range input.Infrastructure.Sources {
+				if source == obs.InfrastructureSourceContainer {
+					return append(append([]string{}, internalobs.InfrastructureNamespaces...), extraInfraNamespaces...)
+				}
+			}
+		}
+	}
+	return namespaces
+}
+
+// excludesNamespace evaluates if an application input excludes all containers of the namespaces matching a glob
+func excludesNamespace(input obs.InputSpec, ns string, extraInfraNamespaces []string) bool {
+	if input.Type != obs.InputTypeApplication {
+		return false
+	}
+	for _, exclude := range implicitExcludes(input, extraInfraNamespaces) {
+		if matched, _ := path.Match(exclude, ns); matched {
+			return true
+		}
+	}
+	if input.Application == nil {
+		return false
+	}
+	for _, exclude := range input.Application.Excludes {
+		if exclude.Container != "" && exclude.Container != "*" {
+			continue
+		}
+		if matched, _ := path.Match(exclude.Namespace, ns); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// implicitExcludes returns the infrastructure namespace globs excluded by an application input because none of its
+// includes explicitly name a namespace matching them
+func implicitExcludes(input obs.InputSpec, extraInfraNamespaces []string) (excludes []string) {
+	for _, infraNS := range append(append([]string{}, internalobs.InfrastructureNamespaces...), extraInfraNamespaces...) {
+		included := false
+		if input.Application != nil {
+			for _, include := range input.Application.Includes {
+				if matched, _ := path.Match(infraNS, include.Namespace); matched {
+					included = true
+					break
+				}
+			}
+		}
+		if !included {
+			excludes = append(excludes, infraNS)
+		}
+	}
+	return excludes
+}
+
+// globsOverlap evaluates if two namespace globs may match the same namespace
+func globsOverlap(a, b string) bool {
+	if matched, _ := path.Match(a, b); matched {
+		return true
+	}
+	if matched, _ := path.Match(b, a); matched {
+		return true
+	}
+	if strings.HasSuffix(a, "*") && strings.HasSuffix(b, "*") {
+		prefixA, prefixB := strings.TrimSuffix(a, "*"), strings.TrimSuffix(b, "*")
+		return strings.HasPrefix(prefixA, prefixB) || strings.HasPrefix(prefixB, prefixA)
+	}
+	return false
+}
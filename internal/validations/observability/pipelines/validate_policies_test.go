@@ -0,0 +1,101 @@
+package pipelines
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("Pipeline validation #validatePolicies", func() {
+
+	var (
+		policies = []obs.PolicySpec{
+			{
+				Name:           "eu-residency",
+				Sources:        obs.PolicySources{Types: []obs.InputType{obs.InputTypeAudit}, Namespaces: []string{"payments-*"}},
+				DenyOutputTags: []string{"external"},
+			},
+		}
+		outputMap = map[string]obs.OutputSpec{
+			"external": {Name: "external", Tags: []string{"external", "region-us"}},
+			"internal": {Name: "internal", Tags: []string{"region-eu"}},
+		}
+		inputMap = map[string]obs.InputSpec{
+			"audit":       {Name: "audit", Type: obs.InputTypeAudit, Audit: &obs.Audit{}},
+			"application": {Name: "application", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+			"frontend": {Name: "frontend", Type: obs.InputTypeApplication, Application: &obs.Application{
+				Includes: []obs.NamespaceContainerSpec{{Namespace: "frontend-*"}},
+			}},
+			"payments": {Name: "payments", Type: obs.InputTypeApplication, Application: &obs.Application{
+				Includes: []obs.NamespaceContainerSpec{{Namespace: "payments-eu"}},
+			}},
+			"no-payments": {Name: "no-payments", Type: obs.InputTypeApplication, Application: &obs.Application{
+				Excludes: []obs.NamespaceContainerSpec{{Namespace: "payments-*"}},
+			}},
+			"infrastructure": {Name: "infrastructure", Type: obs.InputTypeInfrastructure, Infrastructure: &obs.Infrastructure{
+				Sources: obs.InfrastructureSources,
+			}},
+		}
+	)
+
+	DescribeTable("should fail", func(input, output string) {
+		pipelineSpec := obs.PipelineSpec{Name: "mypipeline", InputRefs: []string{input}, OutputRefs: []string{output}}
+		Expect(validatePolicies(pipelineSpec, policies, nil, inputMap, outputMap)).To(ContainElement(MatchRegexp(`policy "eu-residency" forbids forwarding input .* to output "external"`)))
+	},
+		Entry("when a denied log type is forwarded to a denied output", "audit", "external"),
+		Entry("when all application namespaces are forwarded to a denied output", "application", "external"),
+		Entry("when a selected namespace is forwarded to a denied output", "payments", "external"),
+	)
+
+	DescribeTable("should pass", func(input, output string) {
+		pipelineSpec := obs.PipelineSpec{Name: "mypipeline", InputRefs: []string{input}, OutputRefs: []string{output}}
+		Expect(validatePolicies(pipelineSpec, policies, nil, inputMap, outputMap)).To(BeEmpty())
+	},
+		Entry("when selected logs are forwarded to an allowed output", "audit", "internal"),
+		Entry("when the input namespaces do not overlap", "frontend", "external"),
+		Entry("when the input excludes the selected namespaces", "no-payments", "external"),
+		Entry("when infrastructure namespaces do not overlap", "infrastructure", "external"),
+	)
+
+	It("should fail when a selected namespace is classified as infrastructure and forwarded to a denied output", func() {
+		pipelineSpec := obs.PipelineSpec{Name: "mypipeline", InputRefs: []string{"infrastructure"}, OutputRefs: []string{"external"}}
+		Expect(validatePolicies(pipelineSpec, policies, []string{"payments-*"}, inputMap, outputMap)).To(ContainElement(MatchRegexp(`policy "eu-residency" forbids forwarding input "infrastructure" to output "external"`)))
+	})
+
+	It("should pass when a selected namespace is classified as infrastructure and excluded by an application input", func() {
+		pipelineSpec := obs.PipelineSpec{Name: "mypipeline", InputRefs: []string{"application"}, OutputRefs: []string{"external"}}
+		Expect(validatePolicies(pipelineSpec, policies, []string{"payments-*"}, inputMap, outputMap)).To(BeEmpty())
+	})
+
+	Context("with a policy selecting infrastructure namespaces", func() {
+
+		infraPolicies := []obs.PolicySpec{
+			{
+				Name:           "platform",
+				Sources:        obs.PolicySources{Namespaces: []string{"openshift-*", "kube*"}},
+				DenyOutputTags: []string{"external"},
+			},
+		}
+
+		It("should pass for an application input which implicitly excludes the infrastructure namespaces", func() {
+			pipelineSpec := obs.PipelineSpec{Name: "mypipeline", InputRefs: []string{"application"}, OutputRefs: []string{"external"}}
+			Expect(validatePolicies(pipelineSpec, infraPolicies, nil, inputMap, outputMap)).To(BeEmpty())
+		})
+
+		It("should fail for an application input which explicitly includes an infrastructure namespace", func() {
+			inputs := map[string]obs.InputSpec{
+				"monitoring": {Name: "monitoring", Type: obs.InputTypeApplication, Application: &obs.Application{
+					Includes: []obs.NamespaceContainerSpec{{Namespace: "openshift-monitoring"}},
+				}},
+			}
+			pipelineSpec := obs.PipelineSpec{Name: "mypipeline", InputRefs: []string{"monitoring"}, OutputRefs: []string{"external"}}
+			Expect(validatePolicies(pipelineSpec, infraPolicies, nil, inputs, outputMap)).To(ContainElement(MatchRegexp(`policy "platform" forbids forwarding input "monitoring" to output "external"`)))
+		})
+
+		It("should fail for an infrastructure input", func() {
+			pipelineSpec := obs.PipelineSpec{Name: "mypipeline", InputRefs: []string{"infrastructure"}, OutputRefs: []string{"external"}}
+			Expect(validatePolicies(pipelineSpec, infraPolicies, nil, inputMap, outputMap)).ToNot(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,28 @@
+package pipelines
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+// validateTemplate verifies a pipeline references a defined template and forwards to at least one output once
+// the template is applied
+func validateTemplate(pipeline obs.PipelineSpec, templates []obs.PipelineTemplateSpec) (results []string) {
+	if pipeline.TemplateRef != "" {
+		found := false
+		for _, t := range templates {
+			if t.Name == pipeline.TemplateRef {
+				found = true
+				break
+			}
+		}
+		if !found {
+			results = append(results, fmt.Sprintf("template %q not found", pipeline.TemplateRef))
+		}
+	}
+	if len(pipeline.OutputRefs) == 0 {
+		results = append(results, "must reference at least one output")
+	}
+	return results
+}
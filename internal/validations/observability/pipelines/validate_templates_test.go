@@ -0,0 +1,29 @@
+package pipelines
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("Pipeline validation #validateTemplate", func() {
+
+	var (
+		templates = []obs.PipelineTemplateSpec{
+			{Name: "compliant", OutputRefs: []string{"archive"}},
+		}
+	)
+
+	It("should pass when the pipeline references a defined template", func() {
+		pipelineSpec := obs.PipelineSpec{Name: "mypipeline", InputRefs: []string{"application"}, OutputRefs: []string{"archive"}, TemplateRef: "compliant"}
+		Expect(validateTemplate(pipelineSpec, templates)).To(BeEmpty())
+	})
+	It("should fail when the pipeline references an undefined template", func() {
+		pipelineSpec := obs.PipelineSpec{Name: "mypipeline", InputRefs: []string{"application"}, TemplateRef: "missing"}
+		Expect(validateTemplate(pipelineSpec, templates)).To(ConsistOf(`template "missing" not found`, "must reference at least one output"))
+	})
+	It("should fail when the pipeline does not reference an output", func() {
+		pipelineSpec := obs.PipelineSpec{Name: "mypipeline", InputRefs: []string{"application"}}
+		Expect(validateTemplate(pipelineSpec, templates)).To(ConsistOf("must reference at least one output"))
+	})
+})
@@ -5,6 +5,8 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
 )
 
 var _ = Describe("Pipeline validation #validateRef", func() {
@@ -49,3 +51,22 @@ var _ = Describe("Pipeline validation #validateRef", func() {
 	})
 
 })
+
+var _ = Describe("Pipeline validation #Validate", func() {
+
+	It("should not carry the failures of a pipeline over to the following pipelines", func() {
+		forwarder := &obs.ClusterLogForwarder{
+			Spec: obs.ClusterLogForwarderSpec{
+				Inputs:  []obs.InputSpec{{Name: "anInput", Type: obs.InputTypeApplication}},
+				Outputs: []obs.OutputSpec{{Name: "anOutput", Type: obs.OutputTypeHTTP}},
+				Pipelines: []obs.PipelineSpec{
+					{Name: "invalid", InputRefs: []string{"anInput"}, OutputRefs: []string{"missing"}},
+					{Name: "valid", InputRefs: []string{"anInput"}, OutputRefs: []string{"anOutput"}},
+				},
+			},
+		}
+		Validate(internalcontext.ForwarderContext{Forwarder: forwarder})
+		Expect(forwarder.Status.Pipelines).To(HaveCondition(obs.ConditionTypeValidPipelinePrefix+"-invalid", false, obs.ReasonValidationFailure, "missing"))
+		Expect(forwarder.Status.Pipelines).To(HaveCondition(obs.ConditionTypeValidPipelinePrefix+"-valid", true, obs.ReasonValidationSuccess, ""))
+	})
+})
@@ -0,0 +1,56 @@
+package observability
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/common"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// Validator validates the spec of a forwarder and sets the conditions of the inputs, outputs, filters and
+// pipelines which are invalid
+type Validator func(internalcontext.ForwarderContext)
+
+// OutputRule returns the messages describing how an output violates a rule, or none when the output complies
+type OutputRule func(obs.OutputSpec) []string
+
+// registeredValidators are evaluated after the built-in validations
+var registeredValidators []Validator
+
+// RegisterValidator adds a validator to the validations of every forwarder.  Distributions of the operator
+// register validators to enforce their own rules, and must do so before the manager is started (e.g. from an init
+// function).  A validator must not mark an item valid which is already invalid
+func RegisterValidator(validator Validator) {
+	registeredValidators = append(registeredValidators, validator)
+}
+
+// RegisterOutputRule adds a rule which every output must comply with (e.g. all outputs must use TLS).  The output is
+// invalid when the rule returns any message, and the messages are added to the validation condition of the output
+func RegisterOutputRule(name string, rule OutputRule) {
+	RegisterValidator(func(context internalcontext.ForwarderContext) {
+		for i, out := range context.Forwarder.Spec.Outputs {
+			messages := rule(out)
+			if len(messages) == 0 {
+				continue
+			}
+			messages = common.PrefixMessages(common.FieldPath("outputs", i), messages)
+			message := fmt.Sprintf("violates rule %q: %s", name, strings.Join(messages, ","))
+			conditionType := fmt.Sprintf("%s-%s", obs.ConditionTypeValidOutputPrefix, out.Name)
+			if cond := meta.FindStatusCondition(context.Forwarder.Status.Outputs, conditionType); cond != nil && cond.Status == obs.ConditionFalse {
+				message = cond.Message + "," + message
+			}
+			internalobs.SetCondition(&context.Forwarder.Status.Outputs,
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidOutputPrefix, out.Name, false, obs.ReasonValidationFailure, message))
+		}
+	})
+}
+
+func validateRegistered(context internalcontext.ForwarderContext) {
+	for _, validate := range registeredValidators {
+		validate(context)
+	}
+}
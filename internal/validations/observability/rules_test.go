@@ -0,0 +1,63 @@
+package observability
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("#RegisterOutputRule", func() {
+
+	var (
+		context    internalcontext.ForwarderContext
+		requireTLS = func(out obs.OutputSpec) []string {
+			if out.TLS == nil {
+				return []string{"output must use TLS"}
+			}
+			return nil
+		}
+		condition = func(name string) *metav1.Condition {
+			return meta.FindStatusCondition(context.Forwarder.Status.Outputs, obs.ConditionTypeValidOutputPrefix+"-"+name)
+		}
+	)
+
+	BeforeEach(func() {
+		context = internalcontext.ForwarderContext{
+			Forwarder: &obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					Outputs: []obs.OutputSpec{
+						{Name: "secure", TLS: &obs.OutputTLSSpec{}},
+						{Name: "plain"},
+					},
+				},
+			},
+		}
+		for _, out := range context.Forwarder.Spec.Outputs {
+			internalobs.SetCondition(&context.Forwarder.Status.Outputs,
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidOutputPrefix, out.Name, true, obs.ReasonValidationSuccess, ""))
+		}
+		RegisterOutputRule("require-tls", requireTLS)
+	})
+
+	AfterEach(func() {
+		registeredValidators = nil
+	})
+
+	It("should invalidate the outputs which violate the rule", func() {
+		validateRegistered(context)
+		Expect(condition("secure").Status).To(Equal(obs.ConditionTrue))
+		Expect(condition("plain").Status).To(Equal(obs.ConditionFalse))
+		Expect(condition("plain").Message).To(Equal(`violates rule "require-tls": spec.outputs[1]: output must use TLS`))
+	})
+
+	It("should retain the messages of the built-in validations", func() {
+		internalobs.SetCondition(&context.Forwarder.Status.Outputs,
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidOutputPrefix, "plain", false, obs.ReasonValidationFailure, "spec.outputs[1]: invalid URL"))
+		validateRegistered(context)
+		Expect(condition("plain").Message).To(Equal(`spec.outputs[1]: invalid URL,violates rule "require-tls": spec.outputs[1]: output must use TLS`))
+	})
+})
@@ -11,9 +11,8 @@ import (
 )
 
 var (
-	clfValidators = []func(internalcontext.ForwarderContext){
+	specValidators = []func(internalcontext.ForwarderContext){
 		validateAnnotations,
-		ValidatePermissions,
 		inputs.Validate,
 		outputs.Validate,
 		filters.Validate,
@@ -23,9 +22,17 @@ var (
 
 // ValidateClusterLogForwarder validates the forwarder spec that can not be accomplished using api attributes and returns a set of conditions that apply to the spec
 func ValidateClusterLogForwarder(context internalcontext.ForwarderContext) {
-	for _, validate := range clfValidators {
+	ValidatePermissions(context)
+	ValidateClusterLogForwarderSpec(context)
+}
+
+// ValidateClusterLogForwarderSpec validates the forwarder spec like ValidateClusterLogForwarder without validating the
+// permissions of its serviceAccount
+func ValidateClusterLogForwarderSpec(context internalcontext.ForwarderContext) {
+	for _, validate := range specValidators {
 		validate(context)
 	}
+	validateRegistered(context)
 }
 
 func MustUndeployCollector(conditions []metav1.Condition) bool {
@@ -8,7 +8,6 @@ import (
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	utilsjson "github.com/openshift/cluster-logging-operator/internal/utils/json"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"regexp"
 	"strings"
 
 	log "github.com/ViaQ/logerr/v2/log/static"
@@ -26,8 +25,6 @@ const (
 	allNamespaces = ""
 )
 
-var infraNamespaces = regexp.MustCompile(`^default|openshift.*|kube.*$`)
-
 // ValidatePermissions validates the serviceAccount for the CLF has the needed permissions to collect the desired inputs
 func ValidatePermissions(context internalcontext.ForwarderContext) {
 	clf := context.Forwarder
@@ -129,7 +126,7 @@ func gatherPipelineInputs(clf obs.ClusterLogForwarder) (sets.String, bool) {
 				// Check if infra namespaces are spec'd
 				if input.Application != nil && len(input.Application.Includes) > 0 {
 					for _, in := range input.Application.Includes {
-						if infraNamespaces.MatchString(in.Namespace) {
+						if internalobs.IsInfrastructureNamespace(in.Namespace) {
 							inputTypes.Insert(string(obs.InputTypeInfrastructure))
 						}
 					}
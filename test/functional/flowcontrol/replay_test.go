@@ -0,0 +1,48 @@
+package flowcontrol
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	obsruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][FlowControl] Replay max age", func() {
+	var (
+		f *functional.CollectorFunctionalFramework
+	)
+
+	BeforeEach(func() {
+		f = functional.NewCollectorFunctionalFramework()
+		obsruntime.NewClusterLogForwarderBuilder(f.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			ToElasticSearchOutput(func(output *obs.OutputSpec) {
+				output.Elasticsearch.Tuning = &obs.ElasticsearchTuningSpec{
+					BaseOutputTuningSpec: obs.BaseOutputTuningSpec{
+						Replay: &obs.ReplaySpec{MaxAge: utils.GetPtr(time.Duration(3600))},
+					},
+				}
+			})
+		Expect(f.Deploy()).To(BeNil())
+	})
+
+	AfterEach(func() {
+		f.Cleanup()
+	})
+
+	It("should drop the normalized records older than the max age", func() {
+		old := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now().Add(-2*time.Hour)), "old message")
+		Expect(f.WriteMessagesToApplicationLog(old, 1)).To(Succeed())
+		recent := functional.NewFullCRIOLogMessage(functional.CRIOTime(time.Now()), "recent message")
+		Expect(f.WriteMessagesToApplicationLog(recent, 1)).To(Succeed())
+
+		logs, err := f.ReadApplicationLogsFrom(string(obs.OutputTypeElasticsearch))
+		Expect(err).To(BeNil(), "Error fetching logs from %s: %v", obs.OutputTypeElasticsearch, err)
+		Expect(logs).To(HaveLen(1), "Exp. only the recent record to be forwarded")
+		Expect(logs[0].Message).To(Equal("recent message"))
+	})
+})
@@ -121,6 +121,12 @@ var _ = Describe("[Functional][LogForwarding][Normalization] message format test
 				AuditLinux: types.AuditLinux{
 					Type:     "DAEMON_START",
 					RecordID: "*",
+					Op:       "start",
+					AUID:     "4294967295",
+					PID:      "1396",
+					UID:      "0",
+					Session:  "4294967295",
+					Result:   "*",
 				},
 				Timestamp:        testTime,
 				PipelineMetadata: functional.TemplateForAnyPipelineMetadata,
@@ -173,8 +179,21 @@ var _ = Describe("[Functional][LogForwarding][Normalization] message format test
 			// Compare to expected template
 			outputTestLog := logs[0]
 			Expect(outputTestLog).To(FitLogFormatTemplate(outputLogTemplate))
+			Expect(outputTestLog.OVN).To(Equal(&types.OVN{
+				ACL: types.OVNACL{
+					Name:      "NP:verify-audit-logging:Ingress",
+					Verdict:   "drop",
+					Severity:  "alert",
+					Direction: "to-lport",
+					Protocol:  "tcp",
+					SrcIP:     "10.128.2.1",
+					DstIP:     "10.128.2.23",
+					SrcPort:   "40376",
+					DstPort:   "8080",
+				},
+			}), "Expected the ACL to be parsed without modifying the level")
 			results := strings.Join(raw, " ")
-			Expect(results).To(MatchRegexp("name=verify-audit-logging_deny-all"), "Message should contain the audit log: %v", raw)
+			Expect(results).To(MatchRegexp(`name="NP:verify-audit-logging:Ingress"`), "Message should contain the audit log: %v", raw)
 		})
 
 		AfterEach(func() {
@@ -0,0 +1,55 @@
+package normalization
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	"github.com/openshift/cluster-logging-operator/test/helpers/types"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("[Functional][Normalization] truncation of messages larger than the max record size", func() {
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	BeforeEach(func() {
+		framework = functional.NewCollectorFunctionalFramework()
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			ToHttpOutput(func(output *obs.OutputSpec) {
+				size := resource.MustParse("9")
+				output.MaxRecordSize = &size
+			})
+		Expect(framework.Deploy()).To(BeNil())
+	})
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	It("should not split the multibyte characters of a truncated message", func() {
+		// each character is 2 bytes so the 9th byte is the first byte of the 5th character
+		msg := functional.NewCRIOLogMessage(functional.CRIOTime(time.Now()), strings.Repeat("é", 10), false)
+		Expect(framework.WriteMessagesToApplicationLog(msg, 1)).To(Succeed())
+
+		raw, err := framework.ReadRawApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		logs := []struct {
+			Message   string `json:"message"`
+			Truncated bool   `json:"truncated"`
+		}{}
+		Expect(types.ParseLogsFrom(utils.ToJsonLogs(raw), &logs, false)).To(Succeed())
+		Expect(logs).To(HaveLen(1))
+		Expect(utf8.ValidString(logs[0].Message)).To(BeTrue(), "Expected a valid UTF-8 message")
+		Expect(logs[0].Message).To(Equal("éééé"))
+		Expect(logs[0].Truncated).To(BeTrue())
+	})
+})
@@ -349,6 +349,24 @@ type LinuxAuditLog struct {
 type AuditLinux struct {
 	Type     string `json:"type,omitempty"`
 	RecordID string `json:"record_id,omitempty"`
+	Syscall  string `json:"syscall,omitempty"`
+	Success  string `json:"success,omitempty"`
+	Exit     string `json:"exit,omitempty"`
+	PID      string `json:"pid,omitempty"`
+	PPID     string `json:"ppid,omitempty"`
+	UID      string `json:"uid,omitempty"`
+	AUID     string `json:"auid,omitempty"`
+	EUID     string `json:"euid,omitempty"`
+	GID      string `json:"gid,omitempty"`
+	Session  string `json:"ses,omitempty"`
+	Comm     string `json:"comm,omitempty"`
+	Exe      string `json:"exe,omitempty"`
+	Key      string `json:"key,omitempty"`
+	Op       string `json:"op,omitempty"`
+	Account  string `json:"acct,omitempty"`
+	Result   string `json:"res,omitempty"`
+	Terminal string `json:"terminal,omitempty"`
+	Address  string `json:"addr,omitempty"`
 }
 
 // OVN Audit log
@@ -364,6 +382,24 @@ type OVNAuditLog struct {
 	Kubernetes       Kubernetes       `json:"kubernetes"`
 	Openshift        OpenshiftMeta    `json:"openshift"`
 	Level            string           `json:"level,omitempty"`
+	OVN              *OVN             `json:"ovn,omitempty"`
+}
+
+// OVN is the parsed ACL of an OVN audit log
+type OVN struct {
+	ACL OVNACL `json:"acl"`
+}
+
+type OVNACL struct {
+	Name      string `json:"name,omitempty"`
+	Verdict   string `json:"verdict,omitempty"`
+	Severity  string `json:"severity,omitempty"`
+	Direction string `json:"direction,omitempty"`
+	Protocol  string `json:"protocol,omitempty"`
+	SrcIP     string `json:"src_ip,omitempty"`
+	DstIP     string `json:"dst_ip,omitempty"`
+	SrcPort   string `json:"src_port,omitempty"`
+	DstPort   string `json:"dst_port,omitempty"`
 }
 
 // AuditLogCommon is common to k8s and openshift auditlogs